@@ -10,6 +10,8 @@ import (
 	"github.com/celenium-io/astria-indexer/cmd/api/bus"
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
+	"github.com/celenium-io/astria-indexer/cmd/api/ratelimit"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/grafana/pyroscope-go"
@@ -25,8 +27,13 @@ type App struct {
 	dispatcher    *bus.Dispatcher
 	constantCache *cache.ConstantsCache
 	ttlCache      cache.ICache
+	invalidator   *cache.Invalidator
+	finality      *cache.Finality
+	liveness      *liveness.Monitor
+	limiter       *ratelimit.Limiter
 	prscp         *pyroscope.Profiler
 	constants     storage.IConstant
+	state         storage.IState
 }
 
 func newApp(
@@ -38,8 +45,13 @@ func newApp(
 	dispatcher *bus.Dispatcher,
 	constantCache *cache.ConstantsCache,
 	ttlCache cache.ICache,
+	invalidator *cache.Invalidator,
+	finality *cache.Finality,
+	monitor *liveness.Monitor,
+	limiter *ratelimit.Limiter,
 	prscp *pyroscope.Profiler,
 	constants storage.IConstant,
+	state storage.IState,
 ) *App {
 	app := &App{
 		e:             e,
@@ -48,17 +60,32 @@ func newApp(
 		dispatcher:    dispatcher,
 		constantCache: constantCache,
 		ttlCache:      ttlCache,
+		invalidator:   invalidator,
+		finality:      finality,
+		liveness:      monitor,
+		limiter:       limiter,
 		prscp:         prscp,
 		constants:     constants,
+		state:         state,
 	}
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			dispatcher.Start(ctx)
 			wsManager.Start(ctx)
+			invalidator.Start(ctx)
+			if limiter != nil {
+				limiter.Start(ctx)
+			}
 			if err := constantCache.Start(ctx, app.constants); err != nil {
 				return errors.Wrap(err, "start constant cache")
 			}
+			if err := finality.Start(ctx, app.state, cfg.Indexer.Name); err != nil {
+				return errors.Wrap(err, "start finality")
+			}
+			if err := monitor.Start(ctx, app.state, cfg.Indexer.Name); err != nil {
+				return errors.Wrap(err, "start liveness monitor")
+			}
 
 			if err := app.e.Start(cfg.ApiConfig.Bind); err != nil && errors.Is(err, http.ErrServerClosed) {
 				return errors.Wrap(err, "shutting down the server")
@@ -71,6 +98,21 @@ func newApp(
 					return errors.Wrap(err, "closing websocket manager")
 				}
 			}
+			if app.invalidator != nil {
+				if err := app.invalidator.Close(); err != nil {
+					return errors.Wrap(err, "closing cache invalidator")
+				}
+			}
+			if app.finality != nil {
+				if err := app.finality.Close(); err != nil {
+					return errors.Wrap(err, "closing finality")
+				}
+			}
+			if app.liveness != nil {
+				if err := app.liveness.Close(); err != nil {
+					return errors.Wrap(err, "closing liveness monitor")
+				}
+			}
 			if app.ttlCache != nil {
 				if err := app.ttlCache.Close(); err != nil {
 					return errors.Wrap(err, "closing cache")
@@ -90,6 +132,11 @@ func newApp(
 			if err := app.e.Shutdown(ctx); err != nil {
 				return errors.Wrap(err, "closing server")
 			}
+			if app.limiter != nil {
+				if err := app.limiter.Close(); err != nil {
+					return errors.Wrap(err, "closing rate limiter")
+				}
+			}
 
 			if app.prscp != nil {
 				if err := app.prscp.Stop(); err != nil {
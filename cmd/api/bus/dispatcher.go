@@ -55,7 +55,7 @@ func (d *Dispatcher) Observe(channels ...string) *Observer {
 }
 
 func (d *Dispatcher) Start(ctx context.Context) {
-	if err := d.listener.Subscribe(ctx, storage.ChannelHead, storage.ChannelBlock); err != nil {
+	if err := d.listener.Subscribe(ctx, storage.ChannelHead, storage.ChannelBlock, storage.ChannelConstant, storage.ChannelRollback); err != nil {
 		log.Err(err).Msg("subscribe on postgres notifications")
 		return
 	}
@@ -110,6 +110,8 @@ func (d *Dispatcher) handleNotification(ctx context.Context, notification *pq.No
 		return d.handleHead(notification.Extra)
 	case storage.ChannelConstant:
 		return d.handleConstant(notification.Extra)
+	case storage.ChannelRollback:
+		return d.handleRollback(notification.Extra)
 	default:
 		return errors.Errorf("unknown channel name: %s", notification.Channel)
 	}
@@ -155,3 +157,17 @@ func (d *Dispatcher) handleConstant(msg string) error {
 	d.mx.RUnlock()
 	return nil
 }
+
+func (d *Dispatcher) handleRollback(msg string) error {
+	var event storage.RollbackEvent
+	if err := json.Unmarshal([]byte(msg), &event); err != nil {
+		return err
+	}
+
+	d.mx.RLock()
+	for i := range d.observers {
+		d.observers[i].notifyRollbacks(&event)
+	}
+	d.mx.RUnlock()
+	return nil
+}
@@ -12,10 +12,12 @@ type Observer struct {
 	blocks    chan *storage.Block
 	head      chan *storage.State
 	constants chan *storage.Constant
+	rollbacks chan *storage.RollbackEvent
 
 	listenHead      bool
 	listenBlocks    bool
 	listenConstants bool
+	listenRollbacks bool
 
 	g workerpool.Group
 }
@@ -29,6 +31,7 @@ func NewObserver(channels ...string) *Observer {
 		blocks:    make(chan *storage.Block, 1024),
 		head:      make(chan *storage.State, 1024),
 		constants: make(chan *storage.Constant, 1024),
+		rollbacks: make(chan *storage.RollbackEvent, 1024),
 		g:         workerpool.NewGroup(),
 	}
 
@@ -40,6 +43,8 @@ func NewObserver(channels ...string) *Observer {
 			observer.listenHead = true
 		case storage.ChannelConstant:
 			observer.listenConstants = true
+		case storage.ChannelRollback:
+			observer.listenRollbacks = true
 		}
 	}
 
@@ -51,6 +56,7 @@ func (observer Observer) Close() error {
 	close(observer.blocks)
 	close(observer.head)
 	close(observer.constants)
+	close(observer.rollbacks)
 	return nil
 }
 
@@ -69,6 +75,11 @@ func (observer Observer) notifyConstants(constant *storage.Constant) {
 		observer.constants <- constant
 	}
 }
+func (observer Observer) notifyRollbacks(event *storage.RollbackEvent) {
+	if observer.listenRollbacks {
+		observer.rollbacks <- event
+	}
+}
 
 func (observer Observer) Blocks() <-chan *storage.Block {
 	return observer.blocks
@@ -81,3 +92,7 @@ func (observer Observer) Head() <-chan *storage.State {
 func (observer Observer) Constants() <-chan *storage.Constant {
 	return observer.constants
 }
+
+func (observer Observer) Rollbacks() <-chan *storage.RollbackEvent {
+	return observer.rollbacks
+}
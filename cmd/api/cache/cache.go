@@ -39,3 +39,24 @@ func NewStatMiddlewareCache(ttlCache ICache) echo.MiddlewareFunc {
 		return diff
 	})
 }
+
+// NewHeadMiddlewareCache - caches responses which depend on the last indexed block until the next block is indexed
+func NewHeadMiddlewareCache(ttlCache ICache) echo.MiddlewareFunc {
+	return withTags(Middleware(ttlCache, nil, nil), TagMiddleware(TagHead))
+}
+
+// NewAddressMiddlewareCache - caches responses of the address passed in the path parameter until the address is involved in a new block
+func NewAddressMiddlewareCache(ttlCache ICache, param string) echo.MiddlewareFunc {
+	return withTags(Middleware(ttlCache, nil, nil), AddressMiddleware(param))
+}
+
+// NewRollupMiddlewareCache - caches responses of the rollup passed in the path parameter until the rollup is involved in a new block
+func NewRollupMiddlewareCache(ttlCache ICache, param string) echo.MiddlewareFunc {
+	return withTags(Middleware(ttlCache, nil, nil), RollupMiddleware(param))
+}
+
+func withTags(cacheMiddleware, tagMiddleware echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return cacheMiddleware(tagMiddleware(next))
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// Cache-Control values. Finalized resources don't change, so CDN and clients can keep them for a day. Head-dependent and unsafe resources have to be revalidated on every request.
+const (
+	CacheControlImmutable  = "public, max-age=86400, immutable"
+	CacheControlRevalidate = "public, no-cache"
+	CacheControlNoStore    = "no-store"
+)
+
+// ImmutableETag - returns entity tag of the finalized resource identified by its hash and height
+func ImmutableETag(hash []byte, height pkgTypes.Level) string {
+	return fmt.Sprintf(`W/"%s-%d"`, hex.EncodeToString(hash), height)
+}
+
+// HeadETag - returns entity tag of the resource which depends on the last indexed height
+func HeadETag(height pkgTypes.Level) string {
+	return fmt.Sprintf(`W/"head-%d"`, height)
+}
+
+// SetImmutable - sets validators and caching headers of the finalized resource. Unsafe resources must be revalidated.
+func (f *Finality) SetImmutable(c echo.Context, hash []byte, height pkgTypes.Level, modified time.Time) {
+	header := c.Response().Header()
+	if !f.IsFinalized(height) {
+		header.Set(echo.HeaderCacheControl, CacheControlNoStore)
+		return
+	}
+	header.Set(echo.HeaderCacheControl, CacheControlImmutable)
+	header.Set(headerETag, ImmutableETag(hash, height))
+	if !modified.IsZero() {
+		header.Set(echo.HeaderLastModified, modified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// HeadConditionalMiddleware - validates head-dependent responses by the last indexed height. Request is answered with 304 Not Modified without handling if the client has the response for the current head.
+func (f *Finality) HeadConditionalMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			head := f.Head()
+			if head == 0 {
+				return next(c)
+			}
+
+			etag := HeadETag(head)
+			header := c.Response().Header()
+			header.Set(headerETag, etag)
+			header.Set(echo.HeaderCacheControl, CacheControlRevalidate)
+
+			if matchETag(c.Request().Header.Get(headerIfNoneMatch), etag) {
+				return c.NoContent(http.StatusNotModified)
+			}
+			return next(c)
+		}
+	}
+}
+
+// ConditionalMiddleware - answers conditional requests with 304 Not Modified if validators of the response match ones passed in If-None-Match or If-Modified-Since headers.
+// Validators are taken from response headers, so responses replayed by the cache middleware are validated too.
+func ConditionalMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			response := c.Response()
+			writer := response.Writer
+			buf := newBufferedWriter(writer)
+			response.Writer = buf
+
+			err := next(c)
+			response.Writer = writer
+			if err != nil {
+				return err
+			}
+
+			header := writer.Header()
+			if isCachingSkipped(c) && header.Get(echo.HeaderCacheControl) == "" {
+				header.Set(echo.HeaderCacheControl, CacheControlNoStore)
+			}
+
+			if buf.status == http.StatusOK && isNotModified(c.Request(), header) {
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+				writer.WriteHeader(http.StatusNotModified)
+				response.Status = http.StatusNotModified
+				return nil
+			}
+			return buf.flush()
+		}
+	}
+}
+
+func isNotModified(req *http.Request, header http.Header) bool {
+	if ifNoneMatch := req.Header.Get(headerIfNoneMatch); ifNoneMatch != "" {
+		return matchETag(ifNoneMatch, header.Get(headerETag))
+	}
+
+	ifModifiedSince := req.Header.Get(echo.HeaderIfModifiedSince)
+	lastModified := header.Get(echo.HeaderLastModified)
+	if ifModifiedSince == "" || lastModified == "" {
+		return false
+	}
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(lastModified)
+	if err != nil {
+		return false
+	}
+	return !modified.After(since)
+}
+
+// matchETag - weak comparison of the entity tag with the list from If-None-Match header
+func matchETag(ifNoneMatch, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" || strings.TrimPrefix(value, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter - holds response until validators are checked. Headers are shared with the underlying writer.
+type bufferedWriter struct {
+	http.ResponseWriter
+
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedWriter(w http.ResponseWriter) *bufferedWriter {
+	return &bufferedWriter{
+		ResponseWriter: w,
+	}
+}
+
+func (w *bufferedWriter) WriteHeader(statusCode int) {
+	if w.status == 0 {
+		w.status = statusCode
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) flush() error {
+	if w.status == 0 {
+		return nil
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.body.Bytes())
+	return err
+}
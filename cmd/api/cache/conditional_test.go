@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+func TestMatchETag(t *testing.T) {
+	tests := []struct {
+		ifNoneMatch string
+		etag        string
+		want        bool
+	}{
+		{ifNoneMatch: `W/"aa-1"`, etag: `W/"aa-1"`, want: true},
+		{ifNoneMatch: `"aa-1"`, etag: `W/"aa-1"`, want: true},
+		{ifNoneMatch: `"bb-1", W/"aa-1"`, etag: `W/"aa-1"`, want: true},
+		{ifNoneMatch: `*`, etag: `W/"aa-1"`, want: true},
+		{ifNoneMatch: `W/"aa-2"`, etag: `W/"aa-1"`, want: false},
+		{ifNoneMatch: `*`, etag: ``, want: false},
+	}
+	for _, tt := range tests {
+		if got := matchETag(tt.ifNoneMatch, tt.etag); got != tt.want {
+			t.Errorf("matchETag(%q, %q) = %v, expected %v", tt.ifNoneMatch, tt.etag, got, tt.want)
+		}
+	}
+}
+
+func TestConditionalMiddleware(t *testing.T) {
+	f := NewFinality(nil, 10)
+	f.SetHead(100)
+
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hash := []byte{0xaa, 0xbb}
+	calls := 0
+
+	ttlCache := newTaggedMapCache()
+	e := echo.New()
+	e.GET("/block/:height", func(c echo.Context) error {
+		calls++
+		f.SetImmutable(c, hash, 90, blockTime)
+		return c.String(http.StatusOK, "block")
+	}, ConditionalMiddleware(), Middleware(ttlCache, nil, nil), f.HeightMiddleware("height"))
+
+	etag := ImmutableETag(hash, 90)
+	tests := []struct {
+		name   string
+		header http.Header
+		status int
+	}{
+		{name: "no validators", header: http.Header{}, status: http.StatusOK},
+		{name: "matched etag", header: http.Header{headerIfNoneMatch: {etag}}, status: http.StatusNotModified},
+		{name: "other etag", header: http.Header{headerIfNoneMatch: {`W/"cc-90"`}}, status: http.StatusOK},
+		{name: "not modified since", header: http.Header{echo.HeaderIfModifiedSince: {blockTime.Add(time.Hour).Format(http.TimeFormat)}}, status: http.StatusNotModified},
+		{name: "modified since", header: http.Header{echo.HeaderIfModifiedSince: {blockTime.Add(-time.Hour).Format(http.TimeFormat)}}, status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/block/90", nil)
+		req.Header = tt.header
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		if rec.Code != tt.status {
+			t.Errorf("%s: status %d, expected %d", tt.name, rec.Code, tt.status)
+		}
+		if got := rec.Header().Get(headerETag); got != etag {
+			t.Errorf("%s: etag %s, expected %s", tt.name, got, etag)
+		}
+		if got := rec.Header().Get(echo.HeaderCacheControl); got != CacheControlImmutable {
+			t.Errorf("%s: cache control %s", tt.name, got)
+		}
+		if tt.status == http.StatusNotModified && rec.Body.Len() > 0 {
+			t.Errorf("%s: body is sent with not modified status", tt.name)
+		}
+		if tt.status == http.StatusOK && rec.Body.String() != "block" {
+			t.Errorf("%s: unexpected body %q", tt.name, rec.Body.String())
+		}
+	}
+
+	// responses after the first one are replayed from cache with their validators
+	if calls != 1 {
+		t.Errorf("handler is called %d times, expected 1", calls)
+	}
+}
+
+func TestConditionalMiddlewareUnsafe(t *testing.T) {
+	f := NewFinality(nil, 10)
+	f.SetHead(100)
+
+	e := echo.New()
+	e.GET("/block/:height", func(c echo.Context) error {
+		f.SetImmutable(c, []byte{0xaa}, 95, time.Now())
+		return c.String(http.StatusOK, "block")
+	}, ConditionalMiddleware(), f.HeightMiddleware("height"))
+
+	req := httptest.NewRequest(http.MethodGet, "/block/95", nil)
+	req.Header.Set(headerIfNoneMatch, "*")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status %d, expected %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get(headerETag); got != "" {
+		t.Errorf("unsafe block has etag %s", got)
+	}
+	if got := rec.Header().Get(echo.HeaderCacheControl); got != CacheControlNoStore {
+		t.Errorf("cache control %s, expected %s", got, CacheControlNoStore)
+	}
+}
+
+func TestHeadConditionalMiddleware(t *testing.T) {
+	f := NewFinality(nil, 10)
+	calls := 0
+
+	e := echo.New()
+	e.GET("/block", func(c echo.Context) error {
+		calls++
+		return c.String(http.StatusOK, "blocks")
+	}, f.HeadConditionalMiddleware())
+
+	request := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/block", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set(headerIfNoneMatch, ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// head is unknown
+	if rec := request(HeadETag(0)); rec.Code != http.StatusOK || rec.Header().Get(headerETag) != "" {
+		t.Errorf("unexpected response without head: %d %s", rec.Code, rec.Header().Get(headerETag))
+	}
+
+	f.SetHead(100)
+	rec := request("")
+	if rec.Code != http.StatusOK || rec.Header().Get(headerETag) != HeadETag(100) {
+		t.Errorf("unexpected response: %d %s", rec.Code, rec.Header().Get(headerETag))
+	}
+	if got := rec.Header().Get(echo.HeaderCacheControl); got != CacheControlRevalidate {
+		t.Errorf("cache control %s, expected %s", got, CacheControlRevalidate)
+	}
+
+	if rec := request(HeadETag(100)); rec.Code != http.StatusNotModified {
+		t.Errorf("status %d, expected %d", rec.Code, http.StatusNotModified)
+	}
+
+	f.SetHead(pkgTypes.Level(101))
+	if rec := request(HeadETag(100)); rec.Code != http.StatusOK {
+		t.Errorf("status %d after new block, expected %d", rec.Code, http.StatusOK)
+	}
+
+	if calls != 3 {
+		t.Errorf("handler is called %d times, expected 3", calls)
+	}
+}
@@ -5,6 +5,7 @@ package cache
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/bus"
@@ -82,6 +83,20 @@ func (c *ConstantsCache) Get(module types.ModuleName, name string) (string, bool
 	return "", false
 }
 
+// ByPrefix - returns copy of module constants which names start with the prefix
+func (c *ConstantsCache) ByPrefix(module types.ModuleName, prefix string) map[string]string {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	result := make(map[string]string)
+	for name, value := range c.data[string(module)] {
+		if strings.HasPrefix(name, prefix) {
+			result[name] = value
+		}
+	}
+	return result
+}
+
 func (c *ConstantsCache) Close() error {
 	c.wg.Wait()
 	return nil
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+const skipCachingKey = "cache_skip"
+
+// SkipCaching - marks response of the request as not cacheable. It's used for responses containing data from unsafe heights.
+func SkipCaching(c echo.Context) {
+	c.Set(skipCachingKey, true)
+}
+
+func isCachingSkipped(c echo.Context) bool {
+	skip, ok := c.Get(skipCachingKey).(bool)
+	return ok && skip
+}
+
+// Finality - tracks indexer head and computes confirmations of heights. Height is finalized if count of blocks above it is not less than confirmation depth.
+type Finality struct {
+	depth    uint64
+	head     *atomic.Uint64
+	observer *bus.Observer
+
+	wg *sync.WaitGroup
+}
+
+func NewFinality(observer *bus.Observer, depth uint64) *Finality {
+	return &Finality{
+		depth:    depth,
+		head:     new(atomic.Uint64),
+		observer: observer,
+		wg:       new(sync.WaitGroup),
+	}
+}
+
+func (f *Finality) Start(ctx context.Context, repo storage.IState, indexerName string) error {
+	state, err := repo.ByName(ctx, indexerName)
+	if err != nil {
+		if !repo.IsNoRows(err) {
+			return err
+		}
+	} else {
+		f.SetHead(state.LastHeight)
+	}
+
+	if f.observer == nil {
+		return nil
+	}
+
+	f.wg.Add(1)
+	go f.listen(ctx)
+	return nil
+}
+
+func (f *Finality) listen(ctx context.Context) {
+	defer f.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case state, ok := <-f.observer.Head():
+			if !ok {
+				return
+			}
+			f.SetHead(state.LastHeight)
+		case event, ok := <-f.observer.Rollbacks():
+			if !ok {
+				return
+			}
+			if event.FromHeight > 0 {
+				f.SetHead(event.FromHeight - 1)
+			}
+		}
+	}
+}
+
+// SetHead - sets the last indexed height
+func (f *Finality) SetHead(height pkgTypes.Level) {
+	f.head.Store(uint64(height))
+}
+
+// Head - returns the last indexed height
+func (f *Finality) Head() pkgTypes.Level {
+	return pkgTypes.Level(f.head.Load())
+}
+
+// Depth - returns confirmation depth
+func (f *Finality) Depth() uint64 {
+	return f.depth
+}
+
+// Confirmations - returns count of blocks indexed above the height. Heights above the head have no confirmations.
+func (f *Finality) Confirmations(height pkgTypes.Level) uint64 {
+	head := f.head.Load()
+	if uint64(height) > head {
+		return 0
+	}
+	return head - uint64(height)
+}
+
+// IsFinalized - returns true if the height is indexed and has enough confirmations
+func (f *Finality) IsFinalized(height pkgTypes.Level) bool {
+	head := f.head.Load()
+	if head == 0 || uint64(height) > head {
+		return false
+	}
+	return head-uint64(height) >= f.depth
+}
+
+// FinalizedHeight - returns the highest finalized height and false if there is no finalized height yet
+func (f *Finality) FinalizedHeight() (pkgTypes.Level, bool) {
+	head := f.head.Load()
+	if head == 0 || head <= f.depth {
+		return 0, false
+	}
+	return pkgTypes.Level(head - f.depth), true
+}
+
+// SkipUnsafe - marks response as not cacheable if the height is not finalized. Otherwise response is tagged with the height to be invalidated on rollback.
+func (f *Finality) SkipUnsafe(c echo.Context, height pkgTypes.Level) {
+	if !f.IsFinalized(height) {
+		SkipCaching(c)
+		return
+	}
+	Tag(c, HeightTag(height))
+}
+
+// HeightMiddleware - skips caching of responses for not finalized heights passed in the path parameter. Responses for finalized heights are marked as immutable for CDN and clients.
+func (f *Finality) HeightMiddleware(param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			height, err := strconv.ParseUint(c.Param(param), 10, 64)
+			if err != nil {
+				SkipCaching(c)
+			} else {
+				f.SkipUnsafe(c, pkgTypes.Level(height))
+				if f.IsFinalized(pkgTypes.Level(height)) {
+					c.Response().Header().Set(echo.HeaderCacheControl, CacheControlImmutable)
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+func (f *Finality) Close() error {
+	f.wg.Wait()
+	return nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+func TestFinality(t *testing.T) {
+	f := NewFinality(nil, 10)
+
+	if f.IsFinalized(1) {
+		t.Errorf("height is finalized without head")
+	}
+	if _, ok := f.FinalizedHeight(); ok {
+		t.Errorf("finalized height exists without head")
+	}
+
+	f.SetHead(100)
+
+	tests := []struct {
+		height        pkgTypes.Level
+		confirmations uint64
+		finalized     bool
+	}{
+		{height: 100, confirmations: 0, finalized: false},
+		{height: 91, confirmations: 9, finalized: false},
+		{height: 90, confirmations: 10, finalized: true},
+		{height: 80, confirmations: 20, finalized: true},
+		{height: 1, confirmations: 99, finalized: true},
+		{height: 101, confirmations: 0, finalized: false},
+	}
+	for _, tt := range tests {
+		if got := f.Confirmations(tt.height); got != tt.confirmations {
+			t.Errorf("height %d: confirmations %d, expected %d", tt.height, got, tt.confirmations)
+		}
+		if got := f.IsFinalized(tt.height); got != tt.finalized {
+			t.Errorf("height %d: finalized %v, expected %v", tt.height, got, tt.finalized)
+		}
+	}
+
+	height, ok := f.FinalizedHeight()
+	if !ok || height != 90 {
+		t.Errorf("finalized height %d, expected 90", height)
+	}
+
+	f.SetHead(10)
+	if _, ok := f.FinalizedHeight(); ok {
+		t.Errorf("finalized height exists when head equals depth")
+	}
+}
+
+func TestFinalityZeroDepth(t *testing.T) {
+	f := NewFinality(nil, 0)
+	f.SetHead(100)
+
+	if !f.IsFinalized(100) {
+		t.Errorf("head is not finalized with zero depth")
+	}
+	height, ok := f.FinalizedHeight()
+	if !ok || height != 100 {
+		t.Errorf("finalized height %d, expected 100", height)
+	}
+	if !f.IsFinalized(1) {
+		t.Errorf("height below head is not finalized with zero depth")
+	}
+	if got := f.Confirmations(1); got != 99 {
+		t.Errorf("confirmations %d, expected 99", got)
+	}
+	if got := f.Confirmations(100); got != 0 {
+		t.Errorf("confirmations %d, expected 0", got)
+	}
+}
+
+type mapCache map[string]string
+
+func (m mapCache) Get(_ context.Context, key string) (string, bool) {
+	data, ok := m[key]
+	return data, ok
+}
+
+func (m mapCache) Set(_ context.Context, key string, data string, _ ExpirationFunc) error {
+	m[key] = data
+	return nil
+}
+
+func (m mapCache) Clear(_ context.Context) error {
+	clear(m)
+	return nil
+}
+
+func (m mapCache) Close() error {
+	return nil
+}
+
+func TestMiddlewareSkipCaching(t *testing.T) {
+	ttlCache := make(mapCache)
+	mdlwr := Middleware(ttlCache, nil, nil)
+	e := echo.New()
+
+	for _, skip := range []bool{true, false} {
+		req := httptest.NewRequest(http.MethodGet, "/block/100", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := mdlwr(func(c echo.Context) error {
+			if skip {
+				SkipCaching(c)
+			}
+			return c.String(http.StatusOK, "block")
+		})(c)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, ok := ttlCache["block:100"]; ok == skip {
+			t.Errorf("skip=%v: unexpected cache state", skip)
+		}
+	}
+}
+
+func TestFinalityHeightMiddleware(t *testing.T) {
+	f := NewFinality(nil, 10)
+	f.SetHead(100)
+
+	ttlCache := newTaggedMapCache()
+	e := echo.New()
+	e.GET("/block/:height", func(c echo.Context) error {
+		return c.String(http.StatusOK, "block")
+	}, Middleware(ttlCache, nil, nil), f.HeightMiddleware("height"))
+
+	for _, height := range []string{"90", "91", "abc"} {
+		req := httptest.NewRequest(http.MethodGet, "/block/"+height, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+	}
+
+	if _, ok := ttlCache.data["block:90"]; !ok {
+		t.Errorf("finalized block is not cached")
+	}
+	if _, ok := ttlCache.data["block:91"]; ok {
+		t.Errorf("unsafe block is cached")
+	}
+	if _, ok := ttlCache.data["block:abc"]; ok {
+		t.Errorf("invalid height is cached")
+	}
+	if keys := ttlCache.tags[HeightTag(90)]; len(keys) != 1 || keys[0] != "block:90" {
+		t.Errorf("finalized block is not tagged with its height: %v", keys)
+	}
+}
@@ -14,6 +14,15 @@ type ICache interface {
 
 	Get(ctx context.Context, key string) (string, bool)
 	Set(ctx context.Context, key string, data string, f ExpirationFunc) error
+	Clear(ctx context.Context) error
+}
+
+// ITaggedCache - cache which links entries with tags and drops them on invalidation of any linked tag
+type ITaggedCache interface {
+	ICache
+
+	SetWithTags(ctx context.Context, key string, data string, f ExpirationFunc, tags ...string) error
+	Invalidate(ctx context.Context, tags ...string) error
 }
 
 type ExpirationFunc func() time.Duration
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// maxInvalidatedHeights - count of removed heights above which the whole cache is cleared on rollback instead of invalidation of the height tags
+const maxInvalidatedHeights = 1000
+
+// Invalidator - drops cached responses which are affected by indexed blocks and rollbacks.
+// If cache doesn't support tags, responses are cached only by expiration and the whole cache is cleared after rollback.
+type Invalidator struct {
+	cache     ICache
+	observer  *bus.Observer
+	addresses storage.IAddress
+	rollups   storage.IRollup
+
+	wg *sync.WaitGroup
+}
+
+func NewInvalidator(cache ICache, observer *bus.Observer, addresses storage.IAddress, rollups storage.IRollup) *Invalidator {
+	return &Invalidator{
+		cache:     cache,
+		observer:  observer,
+		addresses: addresses,
+		rollups:   rollups,
+		wg:        new(sync.WaitGroup),
+	}
+}
+
+func (i *Invalidator) Start(ctx context.Context) {
+	i.wg.Add(1)
+	go i.listen(ctx)
+}
+
+func (i *Invalidator) listen(ctx context.Context) {
+	defer i.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case block, ok := <-i.observer.Blocks():
+			if !ok {
+				return
+			}
+			if err := i.onBlock(ctx, block); err != nil {
+				log.Err(err).Uint64("height", uint64(block.Height)).Msg("invalidate cache after block")
+			}
+		case event, ok := <-i.observer.Rollbacks():
+			if !ok {
+				return
+			}
+			if err := i.onRollback(ctx, event); err != nil {
+				log.Err(err).Msg("invalidate cache after rollback")
+				continue
+			}
+			log.Info().
+				Uint64("from_height", uint64(event.FromHeight)).
+				Uint64("to_height", uint64(event.ToHeight)).
+				Msg("cache is invalidated after rollback")
+		}
+	}
+}
+
+func (i *Invalidator) onBlock(ctx context.Context, block *storage.Block) error {
+	tagged, ok := i.cache.(ITaggedCache)
+	if !ok || block == nil {
+		return nil
+	}
+
+	tags, err := i.blockTags(ctx, block)
+	if err != nil {
+		return err
+	}
+	return tagged.Invalidate(ctx, tags...)
+}
+
+// blockTags - returns tags of responses which become stale after the block: head-dependent responses and pages of addresses and rollups involved in the block
+func (i *Invalidator) blockTags(ctx context.Context, block *storage.Block) ([]string, error) {
+	tags := []string{TagHead}
+
+	addresses, err := i.addresses.ByHeight(ctx, block.Height)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive block addresses")
+	}
+	for j := range addresses {
+		tags = append(tags, AddressTag(addresses[j].Hash))
+	}
+
+	rollups, err := i.rollups.ByHeight(ctx, block.Height)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive block rollups")
+	}
+	for j := range rollups {
+		tags = append(tags, RollupTag(rollups[j].AstriaId))
+	}
+	return tags, nil
+}
+
+func (i *Invalidator) onRollback(ctx context.Context, event *storage.RollbackEvent) error {
+	if i.cache == nil || event == nil {
+		return nil
+	}
+
+	tagged, ok := i.cache.(ITaggedCache)
+	if !ok || event.ToHeight < event.FromHeight || event.ToHeight-event.FromHeight >= maxInvalidatedHeights {
+		return i.cache.Clear(ctx)
+	}
+
+	// data of removed blocks is already deleted, so all address and rollup pages are invalidated
+	tags := []string{TagHead, TagAddress, TagRollup}
+	for height := event.FromHeight; height <= event.ToHeight; height++ {
+		tags = append(tags, HeightTag(height))
+	}
+	return tagged.Invalidate(ctx, tags...)
+}
+
+func (i *Invalidator) Close() error {
+	i.wg.Wait()
+	return nil
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInvalidatorOnBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addresses := mock.NewMockIAddress(ctrl)
+	rollups := mock.NewMockIRollup(ctrl)
+
+	ttlCache := newTaggedMapCache()
+	ctx := context.Background()
+	for key, tag := range map[string]string{
+		"block":         TagHead,
+		"address:a":     AddressTag("a"),
+		"address:b":     AddressTag("b"),
+		"rollup:AQI=":   RollupTag([]byte{1, 2}),
+		"block:90":      HeightTag(90),
+		"stats:summary": "",
+	} {
+		if tag == "" {
+			_ = ttlCache.Set(ctx, key, "data", nil)
+			continue
+		}
+		_ = ttlCache.SetWithTags(ctx, key, "data", nil, tag)
+	}
+
+	addresses.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Address{{Hash: "a"}}, nil).
+		Times(1)
+	rollups.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Rollup{{AstriaId: []byte{1, 2}}}, nil).
+		Times(1)
+
+	invalidator := NewInvalidator(ttlCache, nil, addresses, rollups)
+	if err := invalidator.onBlock(ctx, &storage.Block{Height: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, key := range []string{"block", "address:a", "rollup:AQI="} {
+		if _, ok := ttlCache.data[key]; ok {
+			t.Errorf("%s is not invalidated", key)
+		}
+	}
+	for _, key := range []string{"address:b", "block:90", "stats:summary"} {
+		if _, ok := ttlCache.data[key]; !ok {
+			t.Errorf("%s is invalidated", key)
+		}
+	}
+}
+
+func TestInvalidatorOnRollback(t *testing.T) {
+	ttlCache := newTaggedMapCache()
+	ctx := context.Background()
+	_ = ttlCache.SetWithTags(ctx, "block:90", "data", nil, HeightTag(90))
+	_ = ttlCache.SetWithTags(ctx, "block:95", "data", nil, HeightTag(95))
+	_ = ttlCache.SetWithTags(ctx, "address:a", "data", nil, TagAddress, AddressTag("a"))
+	_ = ttlCache.Set(ctx, "stats:series", "data", nil)
+
+	invalidator := NewInvalidator(ttlCache, nil, nil, nil)
+	if err := invalidator.onRollback(ctx, &storage.RollbackEvent{FromHeight: 91, ToHeight: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, key := range []string{"block:95", "address:a"} {
+		if _, ok := ttlCache.data[key]; ok {
+			t.Errorf("%s is not invalidated", key)
+		}
+	}
+	for _, key := range []string{"block:90", "stats:series"} {
+		if _, ok := ttlCache.data[key]; !ok {
+			t.Errorf("%s is invalidated", key)
+		}
+	}
+
+	if err := invalidator.onRollback(ctx, &storage.RollbackEvent{FromHeight: 1, ToHeight: 1 + maxInvalidatedHeights}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ttlCache.data) != 0 {
+		t.Errorf("cache is not cleared after deep rollback")
+	}
+}
+
+func TestInvalidatorWithoutTagSupport(t *testing.T) {
+	ttlCache := make(mapCache)
+	ctx := context.Background()
+	_ = ttlCache.Set(ctx, "block:90", "data", nil)
+
+	invalidator := NewInvalidator(ttlCache, nil, nil, nil)
+	if err := invalidator.onBlock(ctx, &storage.Block{Height: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := ttlCache["block:90"]; !ok {
+		t.Errorf("cache is changed after block")
+	}
+
+	if err := invalidator.onRollback(ctx, &storage.RollbackEvent{FromHeight: 91, ToHeight: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ttlCache) != 0 {
+		t.Errorf("cache is not cleared after rollback")
+	}
+}
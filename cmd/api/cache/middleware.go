@@ -54,11 +54,14 @@ func (m *CacheMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 		if err := next(c); err != nil {
 			return err
 		}
-		return m.cacheResult(c.Request().Context(), key, recorder)
+		if isCachingSkipped(c) {
+			return nil
+		}
+		return m.cacheResult(c.Request().Context(), key, recorder, getTags(c))
 	}
 }
 
-func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *ResponseRecorder) error {
+func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *ResponseRecorder, tags []string) error {
 	result := r.Result()
 	if !m.isStatusCacheable(result) {
 		return nil
@@ -69,7 +72,16 @@ func (m *CacheMiddleware) cacheResult(ctx context.Context, key string, r *Respon
 		return errors.Wrap(err, "unable to read recorded response")
 	}
 
-	return m.cache.Set(ctx, key, data, m.expirationFunc)
+	if len(tags) == 0 {
+		return m.cache.Set(ctx, key, data, m.expirationFunc)
+	}
+
+	// tagged response can be cached only if it's possible to invalidate it
+	tagged, ok := m.cache.(ITaggedCache)
+	if !ok {
+		return nil
+	}
+	return tagged.SetWithTags(ctx, key, data, m.expirationFunc, tags...)
 }
 
 func (m *CacheMiddleware) isStatusCacheable(e *CacheEntry) bool {
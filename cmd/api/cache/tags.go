@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"encoding/base64"
+	"slices"
+	"strconv"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+const tagsKey = "cache_tags"
+
+// Tags of cached responses. Head tag marks responses which depend on the last indexed block. Address and rollup tags mark all responses of addresses and rollups pages.
+const (
+	TagHead    = "head"
+	TagAddress = "address"
+	TagRollup  = "rollup"
+)
+
+// HeightTag - returns tag of responses containing data of the height
+func HeightTag(height pkgTypes.Level) string {
+	return "height:" + strconv.FormatUint(uint64(height), 10)
+}
+
+// AddressTag - returns tag of responses containing data of the address
+func AddressTag(hash string) string {
+	return TagAddress + ":" + hash
+}
+
+// RollupTag - returns tag of responses containing data of the rollup. Rollup is identified by base64url encoded astria id as in the API paths.
+func RollupTag(astriaId []byte) string {
+	return rollupTag(base64.URLEncoding.EncodeToString(astriaId))
+}
+
+func rollupTag(hash string) string {
+	return TagRollup + ":" + hash
+}
+
+// Tag - marks response of the request with tags. Cached response is dropped when any of its tags is invalidated.
+func Tag(c echo.Context, tags ...string) {
+	current := getTags(c)
+	for i := range tags {
+		if !slices.Contains(current, tags[i]) {
+			current = append(current, tags[i])
+		}
+	}
+	c.Set(tagsKey, current)
+}
+
+func getTags(c echo.Context) []string {
+	tags, _ := c.Get(tagsKey).([]string)
+	return tags
+}
+
+// TagMiddleware - tags responses of the route with the static tags
+func TagMiddleware(tags ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			Tag(c, tags...)
+			return next(c)
+		}
+	}
+}
+
+// AddressMiddleware - tags responses of the route with the address passed in the path parameter
+func AddressMiddleware(param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			Tag(c, TagAddress, AddressTag(c.Param(param)))
+			return next(c)
+		}
+	}
+}
+
+// RollupMiddleware - tags responses of the route with the rollup passed in the path parameter
+func RollupMiddleware(param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			Tag(c, TagRollup, rollupTag(c.Param(param)))
+			return next(c)
+		}
+	}
+}
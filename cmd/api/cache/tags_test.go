@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type taggedMapCache struct {
+	data mapCache
+	tags map[string][]string
+}
+
+func newTaggedMapCache() *taggedMapCache {
+	return &taggedMapCache{
+		data: make(mapCache),
+		tags: make(map[string][]string),
+	}
+}
+
+func (m *taggedMapCache) Get(ctx context.Context, key string) (string, bool) {
+	return m.data.Get(ctx, key)
+}
+
+func (m *taggedMapCache) Set(ctx context.Context, key string, data string, f ExpirationFunc) error {
+	return m.data.Set(ctx, key, data, f)
+}
+
+func (m *taggedMapCache) SetWithTags(ctx context.Context, key string, data string, f ExpirationFunc, tags ...string) error {
+	for i := range tags {
+		m.tags[tags[i]] = append(m.tags[tags[i]], key)
+	}
+	return m.data.Set(ctx, key, data, f)
+}
+
+func (m *taggedMapCache) Invalidate(_ context.Context, tags ...string) error {
+	for i := range tags {
+		for _, key := range m.tags[tags[i]] {
+			delete(m.data, key)
+		}
+		delete(m.tags, tags[i])
+	}
+	return nil
+}
+
+func (m *taggedMapCache) Clear(ctx context.Context) error {
+	clear(m.tags)
+	return m.data.Clear(ctx)
+}
+
+func (m *taggedMapCache) Close() error {
+	return nil
+}
+
+func TestTag(t *testing.T) {
+	e := echo.New()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	Tag(c, TagHead, HeightTag(100))
+	Tag(c, HeightTag(100), AddressTag("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p"))
+
+	expected := []string{"head", "height:100", "address:astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p"}
+	if tags := getTags(c); !slices.Equal(tags, expected) {
+		t.Errorf("tags %v, expected %v", tags, expected)
+	}
+}
+
+func TestRollupTag(t *testing.T) {
+	tag := RollupTag([]byte{0xfb, 0xff})
+	if tag != "rollup:-_8=" {
+		t.Errorf("unexpected rollup tag: %s", tag)
+	}
+}
+
+func TestMiddlewareTaggedResponses(t *testing.T) {
+	ttlCache := newTaggedMapCache()
+	e := echo.New()
+	handler := func(c echo.Context) error {
+		return c.String(http.StatusOK, "response")
+	}
+	e.GET("/block", handler, NewHeadMiddlewareCache(ttlCache))
+	e.GET("/address/:hash", handler, NewAddressMiddlewareCache(ttlCache, "hash"))
+	e.GET("/rollup/:hash", handler, NewRollupMiddlewareCache(ttlCache, "hash"))
+
+	for _, path := range []string{"/block", "/address/astria1lm45", "/rollup/-_8="} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	tests := map[string][]string{
+		TagHead:                       {"block"},
+		TagAddress:                    {"address:astria1lm45"},
+		AddressTag("astria1lm45"):     {"address:astria1lm45"},
+		TagRollup:                     {"rollup:-_8="},
+		RollupTag([]byte{0xfb, 0xff}): {"rollup:-_8="},
+	}
+	for tag, keys := range tests {
+		if got := ttlCache.tags[tag]; !slices.Equal(got, keys) {
+			t.Errorf("tag %s: keys %v, expected %v", tag, got, keys)
+		}
+	}
+
+	if err := ttlCache.Invalidate(context.Background(), AddressTag("astria1lm45")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := ttlCache.data["address:astria1lm45"]; ok {
+		t.Errorf("invalidated response is cached")
+	}
+	if _, ok := ttlCache.data["block"]; !ok {
+		t.Errorf("not invalidated response is dropped")
+	}
+}
+
+func TestMiddlewareTaggedResponsesWithoutTagSupport(t *testing.T) {
+	ttlCache := make(mapCache)
+	e := echo.New()
+	e.GET("/block", func(c echo.Context) error {
+		return c.String(http.StatusOK, "response")
+	}, NewHeadMiddlewareCache(ttlCache))
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/block", nil))
+
+	if _, ok := ttlCache["block"]; ok {
+		t.Errorf("tagged response is cached without ability to invalidate it")
+	}
+}
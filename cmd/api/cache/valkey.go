@@ -11,7 +11,14 @@ import (
 	valkey "github.com/valkey-io/valkey-go"
 )
 
-var _ ICache = (*ValKey)(nil)
+var _ ITaggedCache = (*ValKey)(nil)
+
+// Cached responses and tags live in their own key namespace, so Clear does not remove other data of the database, e.g. counters of rate limiter.
+const (
+	cachePrefix    = "cache:"
+	tagPrefix      = cachePrefix + "tag:"
+	clearBatchSize = 1000
+)
 
 type ValKey struct {
 	client     valkey.Client
@@ -36,7 +43,7 @@ func NewValKey(url string, ttl time.Duration) (*ValKey, error) {
 
 func (c *ValKey) Get(ctx context.Context, key string) (data string, found bool) {
 	val, err := c.client.Do(
-		ctx, c.client.B().Get().Key(key).Build(),
+		ctx, c.client.B().Get().Key(cachePrefix+key).Build(),
 	).ToString()
 	return val, err == nil
 }
@@ -49,10 +56,105 @@ func (c *ValKey) Set(ctx context.Context, key string, data string, expirationFun
 
 	return c.client.Do(
 		ctx,
-		c.client.B().Set().Key(key).Value(data).ExSeconds(expiredAt).Build(),
+		c.client.B().Set().Key(cachePrefix+key).Value(data).ExSeconds(expiredAt).Build(),
 	).Error()
 }
 
+// SetWithTags - saves data by the key and adds the key to sets of the tags. Tag set lives not less than any of its keys.
+func (c *ValKey) SetWithTags(ctx context.Context, key string, data string, expirationFunc ExpirationFunc, tags ...string) error {
+	expiredAt := c.ttlSeconds
+	if expirationFunc != nil {
+		expiredAt = int64(expirationFunc().Seconds())
+	}
+	tagExpiredAt := max(expiredAt, c.ttlSeconds)
+	key = cachePrefix + key
+
+	cmds := make(valkey.Commands, 0, len(tags)*2+1)
+	for i := range tags {
+		tagKey := tagPrefix + tags[i]
+		cmds = append(cmds,
+			c.client.B().Sadd().Key(tagKey).Member(key).Build(),
+			c.client.B().Expire().Key(tagKey).Seconds(tagExpiredAt).Build(),
+		)
+	}
+	cmds = append(cmds, c.client.B().Set().Key(key).Value(data).ExSeconds(expiredAt).Build())
+
+	for _, result := range c.client.DoMulti(ctx, cmds...) {
+		if err := result.Error(); err != nil {
+			return errors.Wrap(err, "set with tags")
+		}
+	}
+	return nil
+}
+
+// Invalidate - removes all keys linked with the tags
+func (c *ValKey) Invalidate(ctx context.Context, tags ...string) error {
+	for i := range tags {
+		tagKey := tagPrefix + tags[i]
+		keys, err := c.client.Do(
+			ctx,
+			c.client.B().Smembers().Key(tagKey).Build(),
+		).AsStrSlice()
+		if err != nil {
+			return errors.Wrapf(err, "receive keys of tag %s", tags[i])
+		}
+
+		keys = append(keys, tagKey)
+		if err := c.client.Do(
+			ctx,
+			c.client.B().Del().Key(keys...).Build(),
+		).Error(); err != nil {
+			return errors.Wrapf(err, "invalidate tag %s", tags[i])
+		}
+	}
+	return nil
+}
+
+// Increment - increments counter by the key and sets its expiration if counter was created. Returns value of the counter after increment. Counters are kept outside of the cache namespace and survive Clear.
+func (c *ValKey) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	results := c.client.DoMulti(
+		ctx,
+		c.client.B().Incr().Key(key).Build(),
+		c.client.B().Expire().Key(key).Seconds(int64(ttl.Seconds())).Nx().Build(),
+	)
+	value, err := results[0].AsInt64()
+	if err != nil {
+		return 0, errors.Wrap(err, "increment")
+	}
+	if err := results[1].Error(); err != nil {
+		return 0, errors.Wrap(err, "expire")
+	}
+	return value, nil
+}
+
+// Clear - removes all cached responses. Keys are scanned by the cache namespace and unlinked by batches.
+func (c *ValKey) Clear(ctx context.Context) error {
+	var cursor uint64
+	for {
+		entry, err := c.client.Do(
+			ctx,
+			c.client.B().Scan().Cursor(cursor).Match(cachePrefix+"*").Count(clearBatchSize).Build(),
+		).AsScanEntry()
+		if err != nil {
+			return errors.Wrap(err, "scan cached keys")
+		}
+
+		if len(entry.Elements) > 0 {
+			if err := c.client.Do(
+				ctx,
+				c.client.B().Unlink().Key(entry.Elements...).Build(),
+			).Error(); err != nil {
+				return errors.Wrap(err, "unlink cached keys")
+			}
+		}
+
+		if entry.Cursor == 0 {
+			return nil
+		}
+		cursor = entry.Cursor
+	}
+}
+
 func (c *ValKey) Close() error {
 	c.client.Close()
 	return nil
@@ -4,6 +4,8 @@
 package main
 
 import (
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
+	"github.com/celenium-io/astria-indexer/cmd/api/ratelimit"
 	"github.com/celenium-io/astria-indexer/internal/profiler"
 	indexerConfig "github.com/celenium-io/astria-indexer/pkg/indexer/config"
 	"github.com/dipdup-net/go-lib/config"
@@ -27,6 +29,10 @@ type ApiConfig struct {
 	SentryDsn      string  `validate:"omitempty"              yaml:"sentry_dsn"`
 	Websocket      bool    `validate:"omitempty"              yaml:"websocket"`
 	Cache          string  `validate:"omitempty,url"          yaml:"cache"`
+
+	ConfirmationDepth uint64           `validate:"omitempty,min=0" yaml:"confirmation_depth"`
+	Liveness          liveness.Config  `validate:"omitempty"       yaml:"liveness"`
+	ApiKeys           ratelimit.Config `validate:"omitempty"       yaml:"api_keys"`
 }
 
 func indexerName(cfg *Config) string {
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/decode"
+)
+
+type txDecoder struct{}
+
+func newTxDecoder() handler.TxDecoder {
+	return txDecoder{}
+}
+
+func (txDecoder) Decode(raw []byte) (handler.DecodedTx, error) {
+	ctx := decode.NewContext(map[string]string{}, time.Now().UTC())
+	tx, err := decode.RawTx(raw, &ctx)
+	if err != nil {
+		return handler.DecodedTx{}, err
+	}
+
+	return handler.DecodedTx{
+		Signer:         tx.Signer.Hash,
+		Nonce:          tx.UnsignedTx.GetParams().GetNonce(),
+		ChainId:        tx.UnsignedTx.GetParams().GetChainId(),
+		ActionTypes:    tx.ActionTypes,
+		Actions:        tx.Actions,
+		SignatureValid: decode.VerifySignature(tx.Tx),
+	}, nil
+}
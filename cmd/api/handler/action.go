@@ -13,17 +13,20 @@ import (
 )
 
 type ActionHandler struct {
-	actions storage.IAction
-	cache   cache.ICache
+	actions  storage.IAction
+	cache    cache.ICache
+	finality *cache.Finality
 }
 
 func NewActionHandler(
 	actions storage.IAction,
 	cache cache.ICache,
+	finality *cache.Finality,
 ) *ActionHandler {
 	return &ActionHandler{
-		actions: actions,
-		cache:   cache,
+		actions:  actions,
+		cache:    cache,
+		finality: finality,
 	}
 }
 
@@ -59,5 +62,9 @@ func (handler *ActionHandler) Get(c echo.Context) error {
 	if err != nil {
 		return handleError(c, err, handler.actions)
 	}
-	return c.JSON(http.StatusOK, responses.NewActionWithTx(action))
+	handler.finality.SkipUnsafe(c, action.Height)
+
+	response := responses.NewActionWithTx(action)
+	response.SetFinality(handler.finality)
+	return c.JSON(http.StatusOK, response)
 }
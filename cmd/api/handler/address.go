@@ -17,7 +17,9 @@ import (
 )
 
 type AddressHandler struct {
+	cache         cache.ICache
 	constantCache *cache.ConstantsCache
+	finality      *cache.Finality
 	address       storage.IAddress
 	txs           storage.ITx
 	actions       storage.IAction
@@ -31,7 +33,9 @@ type AddressHandler struct {
 }
 
 func NewAddressHandler(
+	ttlCache cache.ICache,
 	constantCache *cache.ConstantsCache,
+	finality *cache.Finality,
 	address storage.IAddress,
 	txs storage.ITx,
 	actions storage.IAction,
@@ -44,7 +48,9 @@ func NewAddressHandler(
 	indexerName string,
 ) *AddressHandler {
 	return &AddressHandler{
+		cache:         ttlCache,
 		constantCache: constantCache,
+		finality:      finality,
 		address:       address,
 		txs:           txs,
 		actions:       actions,
@@ -65,7 +71,8 @@ func (handler *AddressHandler) InitRoutes(srvr *echo.Group) {
 	{
 		addressesGroup.GET("", handler.List)
 		addressesGroup.GET("/count", handler.Count)
-		addressGroup := addressesGroup.Group("/:hash")
+		addressesGroup.POST("/batch", handler.Batch)
+		addressGroup := addressesGroup.Group("/:hash", cache.NewAddressMiddlewareCache(handler.cache, "hash"))
 		{
 			addressGroup.GET("", handler.Get)
 			addressGroup.GET("/txs", handler.Transactions)
@@ -127,6 +134,8 @@ type listAddressRequest struct {
 	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
 	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
 	Asset  string `query:"asset"  validate:"omitempty"`
+
+	Finalized bool `query:"finalized" validate:"omitempty"`
 }
 
 func (p *listAddressRequest) SetDefault() {
@@ -148,6 +157,7 @@ func (p *listAddressRequest) SetDefault() {
 //	@Param			offset		query	integer	false	"Offset"							mininum(1)
 //	@Param			sort		query	string	false	"Sort order"						Enums(asc, desc)
 //	@Param			asset		query	string	false	"Required balance asset"
+//	@Param			finalized	query	boolean	false	"If true hide addresses first seen in not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Address
 //	@Failure		400	{object}	Error
@@ -166,6 +176,13 @@ func (handler *AddressHandler) List(c echo.Context) error {
 		Sort:   pgSort(req.Sort),
 		Asset:  req.Asset,
 	}
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Address{})
+		}
+		fltrs.MaxHeight = height
+	}
 
 	address, err := handler.address.ListWithBalance(c.Request().Context(), fltrs)
 	if err != nil {
@@ -191,6 +208,7 @@ type addressTxRequest struct {
 	Height      uint64      `query:"height"       validate:"omitempty,min=1"`
 	Status      StringArray `query:"status"       validate:"omitempty,dive,status"`
 	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
+	Finalized   bool        `query:"finalized"    validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
 	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
@@ -220,6 +238,7 @@ func (p *addressTxRequest) SetDefault() {
 //	@Param			from			query	integer					false	"Time from in unix timestamp"	minimum(1)
 //	@Param			to				query	integer					false	"Time to in unix timestamp"		minimum(1)
 //	@Param			height			query	integer					false	"Block number"					minimum(1)
+//	@Param			finalized		query	boolean					false	"If true hide transactions from not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Tx
 //	@Failure		400	{object}	Error
@@ -254,6 +273,13 @@ func (handler *AddressHandler) Transactions(c echo.Context) error {
 	for i := range req.ActionTypes {
 		fltrs.ActionTypes.SetType(types.ActionType(req.ActionTypes[i]))
 	}
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Tx{})
+		}
+		fltrs.MaxHeight = uint64(height)
+	}
 
 	txs, err := handler.txs.ByAddress(c.Request().Context(), address.Id, fltrs)
 	if err != nil {
@@ -262,6 +288,8 @@ func (handler *AddressHandler) Transactions(c echo.Context) error {
 	response := make([]responses.Tx, len(txs))
 	for i := range txs {
 		response[i] = responses.NewTx(txs[i])
+		response[i].SetFinality(handler.finality)
+		handler.finality.SkipUnsafe(c, response[i].Height)
 	}
 	return returnArray(c, response)
 }
@@ -272,6 +300,7 @@ type getAddressMessages struct {
 	Offset      uint64      `query:"offset"       validate:"omitempty,min=0"`
 	Sort        string      `query:"sort"         validate:"omitempty,oneof=asc desc"`
 	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
+	Finalized   bool        `query:"finalized"    validate:"omitempty"`
 }
 
 func (p *getAddressMessages) SetDefault() {
@@ -312,6 +341,7 @@ func (p *getAddressMessages) ToFilters() storage.AddressActionsFilter {
 //	@Param			offset			query	integer					false	"Offset"								minimum(1)
 //	@Param			sort			query	string					false	"Sort order"							Enums(asc, desc)
 //	@Param			action_types	query	types.ActionType     	false	"Comma-separated action types list"
+//	@Param			finalized		query	boolean					false	"If true hide actions from not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Action
 //	@Failure		400	{object}	Error
@@ -331,6 +361,13 @@ func (handler *AddressHandler) Actions(c echo.Context) error {
 	}
 
 	filters := req.ToFilters()
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Action{})
+		}
+		filters.MaxHeight = height
+	}
 	actions, err := handler.actions.ByAddress(c.Request().Context(), address.Id, filters)
 	if err != nil {
 		return handleError(c, err, handler.address)
@@ -339,6 +376,8 @@ func (handler *AddressHandler) Actions(c echo.Context) error {
 	response := make([]responses.Action, len(actions))
 	for i := range actions {
 		response[i] = responses.NewAddressAction(actions[i])
+		response[i].SetFinality(handler.finality)
+		handler.finality.SkipUnsafe(c, response[i].Height)
 	}
 
 	return returnArray(c, response)
@@ -638,3 +677,64 @@ func (handler *AddressHandler) Celestials(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type addressBatchRequest struct {
+	Hashes []string `json:"hashes" validate:"required,min=1,max=100"`
+}
+
+// Batch godoc
+//
+//	@Summary		Get addresses by hashes
+//	@Description	Get up to 100 addresses by hashes in one request. Items of the response are returned in order of the requested hashes with error for invalid and unknown ones.
+//	@Description	Bridge info is not returned in the batch. Use address info endpoint to get it.
+//	@Tags			address
+//	@ID				get-addresses-batch
+//	@Param			request	body	addressBatchRequest	true	"Address hashes"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		responses.BatchItem[responses.Address]
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/batch [post]
+func (handler *AddressHandler) Batch(c echo.Context) error {
+	req, err := bindAndValidate[addressBatchRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hashes := make([]string, 0, len(req.Hashes))
+	for i := range req.Hashes {
+		if isAddress(req.Hashes[i]) {
+			hashes = append(hashes, req.Hashes[i])
+		}
+	}
+
+	addresses := make(map[string]storage.Address, len(hashes))
+	if len(hashes) > 0 {
+		found, err := handler.address.ByHashes(c.Request().Context(), hashes)
+		if err != nil {
+			return handleError(c, err, handler.address)
+		}
+		for i := range found {
+			addresses[found[i].Hash] = found[i]
+		}
+	}
+
+	sudoAddress, _ := handler.constantCache.Get(types.ModuleNameGeneric, "authority_sudo_address")
+	ibcSudoAddress, _ := handler.constantCache.Get(types.ModuleNameGeneric, "ibc_sudo_address")
+
+	response := make([]responses.BatchItem[responses.Address], len(req.Hashes))
+	for i, hash := range req.Hashes {
+		if !isAddress(hash) {
+			response[i] = responses.NewBatchError[responses.Address](hash, batchErrInvalidId)
+			continue
+		}
+		address, ok := addresses[hash]
+		if !ok {
+			response[i] = responses.NewBatchError[responses.Address](hash, batchErrNotFound)
+			continue
+		}
+		response[i] = responses.NewBatchItem(hash, responses.NewAddress(address, nil, sudoAddress, ibcSudoAddress))
+	}
+	return c.JSON(http.StatusOK, response)
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
@@ -60,7 +61,7 @@ func (s *AddressTestSuite) SetupSuite() {
 	s.celestials = celestialMock.NewMockICelestial(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	cc := cache.NewConstantsCache(nil)
-	s.handler = NewAddressHandler(cc, s.address, s.txs, s.actions, s.rollups, s.fees, s.bridge, s.deposits, s.celestials, s.state, testIndexerName)
+	s.handler = NewAddressHandler(nil, cc, newTestFinality(), s.address, s.txs, s.actions, s.rollups, s.fees, s.bridge, s.deposits, s.celestials, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -258,6 +259,35 @@ func (s *AddressTestSuite) TestList() {
 	s.Require().EqualValues("image", address.Celestials.ImageUrl)
 }
 
+func (s *AddressTestSuite) TestListFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address")
+
+	s.address.EXPECT().
+		ListWithBalance(gomock.Any(), storage.AddressListFilter{
+			Limit:     10,
+			Sort:      pgSort("asc"),
+			MaxHeight: 100,
+		}).
+		Return([]storage.Address{
+			testAddress,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var addresses []responses.Address
+	err := json.NewDecoder(rec.Body).Decode(&addresses)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 1)
+}
+
 func (s *AddressTestSuite) TestTransactions() {
 	q := make(url.Values)
 	q.Set("limit", "2")
@@ -308,6 +338,36 @@ func (s *AddressTestSuite) TestTransactions() {
 	s.Require().Equal(types.StatusSuccess, tx.Status)
 }
 
+func (s *AddressTestSuite) TestActionsFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/actions")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddressHash)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddress.Hash).
+		Return(testAddress, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), storage.AddressActionsFilter{
+			Limit:       10,
+			Sort:        pgSort("asc"),
+			ActionTypes: types.NewActionTypeMask(),
+			MaxHeight:   100,
+		}).
+		Return([]storage.AddressAction{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Actions(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
 func (s *AddressTestSuite) TestActions() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -604,3 +664,37 @@ func (s *AddressTestSuite) TestCelestials() {
 		s.Require().EqualValues("VERIFIED", celestials[i].Status)
 	}
 }
+
+func (s *AddressTestSuite) TestBatch() {
+	unknown := "astria1lhpxecq5ffhq68dgu9s8y2g5h53jqw5cvudrkk"
+	body := `{"hashes":["` + testAddressHash + `","invalid","` + unknown + `"]}`
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/batch")
+
+	s.address.EXPECT().
+		ByHashes(gomock.Any(), []string{testAddressHash, unknown}).
+		Return([]storage.Address{testAddress}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Batch(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.BatchItem[responses.Address]
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	s.Require().Equal(testAddressHash, items[0].Id)
+	s.Require().NotNil(items[0].Data)
+	s.Require().Equal(testAddressHash, items[0].Data.Hash)
+
+	s.Require().Equal("invalid identifier", items[1].Error)
+	s.Require().Nil(items[1].Data)
+
+	s.Require().Equal(unknown, items[2].Id)
+	s.Require().Equal("not found", items[2].Error)
+}
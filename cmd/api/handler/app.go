@@ -4,7 +4,9 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
@@ -33,6 +35,8 @@ func (handler *AppHandler) InitRoutes(srvr *echo.Group) {
 		app := apps.Group("/:slug")
 		{
 			app.GET("", handler.Get)
+			app.GET("/report", handler.Report)
+			app.GET("/report/series", handler.ReportSeries)
 		}
 	}
 }
@@ -109,7 +113,7 @@ type getAppRequest struct {
 // Get godoc
 //
 //	@Summary		Get application info
-//	@Description	Get application info
+//	@Description	Get application info with all rollups and bridge accounts of the application. Statistics are aggregated across all rollups.
 //	@Tags			applications
 //	@ID				get-application
 //	@Param			slug	path	string	true	"Slug"
@@ -125,10 +129,164 @@ func (handler AppHandler) Get(c echo.Context) error {
 		return badRequestError(c, err)
 	}
 
-	rollup, err := handler.apps.BySlug(c.Request().Context(), req.Slug)
+	ctx := c.Request().Context()
+	app, err := handler.apps.BySlug(ctx, req.Slug)
 	if err != nil {
 		return handleError(c, err, handler.apps)
 	}
 
-	return c.JSON(http.StatusOK, responses.NewAppWithStats(rollup))
+	rollups, err := handler.apps.Rollups(ctx, app.Id)
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+	bridges, err := handler.apps.Bridges(ctx, app.Id)
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+
+	response := responses.NewAppWithStats(app)
+	response.Rollups = make([]responses.AppRollup, len(rollups))
+	for i := range rollups {
+		response.Rollups[i] = responses.NewAppRollup(rollups[i])
+	}
+	response.Bridges = make([]responses.AppBridge, len(bridges))
+	for i := range bridges {
+		response.Bridges[i] = responses.NewAppBridge(bridges[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+const (
+	defaultReportPeriod = 30 * 24 * time.Hour
+	defaultReportGap    = 3600
+	reportGapsLimit     = 100
+)
+
+type appReportRequest struct {
+	Slug string `param:"slug" validate:"required"`
+	From int64  `query:"from" validate:"omitempty,min=1"`
+	To   int64  `query:"to"   validate:"omitempty,min=1"`
+	Gap  int64  `query:"gap"  validate:"omitempty,min=1"`
+}
+
+func (p *appReportRequest) SetDefault() {
+	if p.From == 0 {
+		p.From = time.Now().Add(-defaultReportPeriod).Unix()
+	}
+	if p.Gap == 0 {
+		p.Gap = defaultReportGap
+	}
+}
+
+// Report godoc
+//
+//	@Summary		Get application report
+//	@Description	Cost and efficiency report of rollup data submissions of the application for the period: bytes submitted, submissions count, fees paid per byte, average interval between submissions in seconds and its jitter (standard deviation), gaps longer than threshold and signers used.
+//	@Description	Report is aggregated across all rollups of the application. By default report is built for the last 30 days.
+//	@Tags			applications
+//	@ID				get-application-report
+//	@Param			slug	path	string	true	"Slug"
+//	@Param			from	query	integer	false	"Time from in unix timestamp"				mininum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"					mininum(1)
+//	@Param			gap		query	integer	false	"Gap threshold in seconds. Default: 3600"	mininum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.AppReport
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/app/{slug}/report [get]
+func (handler AppHandler) Report(c echo.Context) error {
+	req, err := bindAndValidate[appReportRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	rollupIds, err := handler.appRollupIds(c.Request().Context(), req.Slug)
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+
+	period := storage.NewSeriesRequest(req.From, req.To)
+	report, err := handler.apps.Report(c.Request().Context(), rollupIds, storage.AppReportFilter{
+		From:         period.From,
+		To:           period.To,
+		GapThreshold: time.Duration(req.Gap) * time.Second,
+		GapsLimit:    reportGapsLimit,
+	})
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+	return c.JSON(http.StatusOK, responses.NewAppReport(report))
+}
+
+type appReportSeriesRequest struct {
+	Slug string `param:"slug" validate:"required"`
+	From int64  `query:"from" validate:"omitempty,min=1"`
+	To   int64  `query:"to"   validate:"omitempty,min=1"`
+}
+
+func (p *appReportSeriesRequest) SetDefault() {
+	if p.From == 0 {
+		p.From = time.Now().Add(-defaultReportPeriod).Unix()
+	}
+}
+
+// ReportSeries godoc
+//
+//	@Summary		Get application report series
+//	@Description	Daily series of application report: bytes submitted, submissions count, fees paid per byte, average interval between submissions in seconds and its jitter.
+//	@Description	Series is aggregated across all rollups of the application. By default series is built for the last 30 days.
+//	@Tags			applications
+//	@ID				get-application-report-series
+//	@Param			slug	path	string	true	"Slug"
+//	@Param			from	query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to		query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.AppReportItem
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/app/{slug}/report/series [get]
+func (handler AppHandler) ReportSeries(c echo.Context) error {
+	req, err := bindAndValidate[appReportSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	rollupIds, err := handler.appRollupIds(c.Request().Context(), req.Slug)
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+
+	items, err := handler.apps.ReportSeries(c.Request().Context(), rollupIds, storage.NewSeriesRequest(req.From, req.To))
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+
+	response := make([]responses.AppReportItem, len(items))
+	for i := range items {
+		response[i] = responses.NewAppReportItem(items[i])
+	}
+	return returnArray(c, response)
+}
+
+// appRollupIds - returns ids of the primary and linked rollups of the application
+func (handler AppHandler) appRollupIds(ctx context.Context, slug string) ([]uint64, error) {
+	app, err := handler.apps.BySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups, err := handler.apps.Rollups(ctx, app.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(rollups))
+	for i := range rollups {
+		ids[i] = rollups[i].RollupId
+	}
+	return ids, nil
 }
@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
@@ -134,6 +135,30 @@ func (s *AppTestSuite) TestGet() {
 		Return(testAppWithStats, nil).
 		Times(1)
 
+	s.apps.EXPECT().
+		Rollups(gomock.Any(), uint64(1)).
+		Return([]storage.AppRollup{
+			{
+				AppId:    1,
+				RollupId: testRollup.Id,
+				Role:     storage.AppRolePrimary,
+				Rollup:   &testRollup,
+			},
+		}, nil).
+		Times(1)
+
+	s.apps.EXPECT().
+		Bridges(gomock.Any(), uint64(1)).
+		Return([]storage.AppBridge{
+			{
+				AppId:    1,
+				BridgeId: testAddress.Id,
+				Role:     "usdc",
+				Bridge:   &testAddress,
+			},
+		}, nil).
+		Times(1)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -148,4 +173,162 @@ func (s *AppTestSuite) TestGet() {
 	s.Require().EqualValues(1000, rollup.Size)
 	s.Require().EqualValues(testTime, rollup.LastAction)
 	s.Require().EqualValues(testTime, rollup.FirstAction)
+
+	s.Require().Len(rollup.Rollups, 1)
+	s.Require().EqualValues(testRollupHash, rollup.Rollups[0].Rollup)
+	s.Require().EqualValues(storage.AppRolePrimary, rollup.Rollups[0].Role)
+
+	s.Require().Len(rollup.Bridges, 1)
+	s.Require().NotNil(rollup.Bridges[0].Bridge)
+	s.Require().EqualValues(testAddressHash, rollup.Bridges[0].Bridge.Hash)
+	s.Require().EqualValues("usdc", rollup.Bridges[0].Role)
+}
+
+func (s *AppTestSuite) TestReport() {
+	q := make(url.Values)
+	q.Add("from", "1692892095")
+	q.Add("to", "1692992095")
+	q.Add("gap", "600")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/app/:slug/report")
+	c.SetParamNames("slug")
+	c.SetParamValues("test-app")
+
+	app := testAppWithStats
+	app.RollupId = 1
+
+	s.apps.EXPECT().
+		BySlug(gomock.Any(), "test-app").
+		Return(app, nil).
+		Times(1)
+
+	s.apps.EXPECT().
+		Rollups(gomock.Any(), app.Id).
+		Return([]storage.AppRollup{
+			{AppId: app.Id, RollupId: 1, Role: storage.AppRolePrimary},
+			{AppId: app.Id, RollupId: 2, Role: "testnet"},
+		}, nil).
+		Times(1)
+
+	s.apps.EXPECT().
+		Report(gomock.Any(), []uint64{1, 2}, storage.AppReportFilter{
+			From:         time.Unix(1692892095, 0).UTC(),
+			To:           time.Unix(1692992095, 0).UTC(),
+			GapThreshold: 10 * time.Minute,
+			GapsLimit:    100,
+		}).
+		Return(storage.AppReport{
+			Size:           1000,
+			ActionsCount:   10,
+			FirstTime:      testTime,
+			LastTime:       testTime,
+			AvgInterval:    12.5,
+			IntervalJitter: 1.5,
+			Fees: []storage.AppReportFee{
+				{
+					Asset:    "nria",
+					Amount:   "4000",
+					FeeCount: 10,
+				},
+			},
+			Gaps: []storage.AppReportGap{
+				{
+					FromTime:   testTime.Add(-time.Hour),
+					ToTime:     testTime,
+					FromHeight: 100,
+					ToHeight:   200,
+					Duration:   3600,
+				},
+			},
+			Signers: []storage.AppReportSigner{
+				{
+					SignerId:     testAddress.Id,
+					ActionsCount: 10,
+					Size:         1000,
+					Signer:       &testAddress,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Report(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var report responses.AppReport
+	err := json.NewDecoder(rec.Body).Decode(&report)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1000, report.Size)
+	s.Require().EqualValues(10, report.ActionsCount)
+	s.Require().EqualValues(12.5, report.AvgInterval)
+	s.Require().EqualValues(1.5, report.IntervalJitter)
+
+	s.Require().Len(report.Fees, 1)
+	s.Require().EqualValues("4000", report.Fees[0].Amount)
+	s.Require().EqualValues("4", report.Fees[0].FeePerByte)
+
+	s.Require().Len(report.Gaps, 1)
+	s.Require().EqualValues(100, report.Gaps[0].FromHeight)
+	s.Require().EqualValues(200, report.Gaps[0].ToHeight)
+	s.Require().EqualValues(3600, report.Gaps[0].Duration)
+
+	s.Require().Len(report.Signers, 1)
+	s.Require().NotNil(report.Signers[0].Signer)
+	s.Require().Equal(testAddress.Hash, report.Signers[0].Signer.Hash)
+}
+
+func (s *AppTestSuite) TestReportSeries() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/app/:slug/report/series")
+	c.SetParamNames("slug")
+	c.SetParamValues("test-app")
+
+	app := testAppWithStats
+	app.RollupId = 1
+
+	s.apps.EXPECT().
+		BySlug(gomock.Any(), "test-app").
+		Return(app, nil).
+		Times(1)
+
+	s.apps.EXPECT().
+		Rollups(gomock.Any(), app.Id).
+		Return([]storage.AppRollup{
+			{AppId: app.Id, RollupId: 1, Role: storage.AppRolePrimary},
+			{AppId: app.Id, RollupId: 2, Role: "testnet"},
+		}, nil).
+		Times(1)
+
+	s.apps.EXPECT().
+		ReportSeries(gomock.Any(), []uint64{1, 2}, gomock.Any()).
+		Return([]storage.AppReportItem{
+			{
+				Time:         testTime,
+				Size:         0,
+				ActionsCount: 0,
+				Fees: []storage.AppReportFee{
+					{
+						Asset:    "nria",
+						Amount:   "100",
+						FeeCount: 1,
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.ReportSeries(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var items []responses.AppReportItem
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().EqualValues(testTime, items[0].Time)
+	s.Require().Len(items[0].Fees, 1)
+	s.Require().EqualValues("0", items[0].Fees[0].FeePerByte)
 }
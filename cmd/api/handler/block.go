@@ -5,6 +5,7 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
@@ -22,6 +23,7 @@ type BlockHandler struct {
 	price       storage.IPrice
 	state       storage.IState
 	cache       cache.ICache
+	finality    *cache.Finality
 	indexerName string
 }
 
@@ -34,6 +36,7 @@ func NewBlockHandler(
 	price storage.IPrice,
 	state storage.IState,
 	cache cache.ICache,
+	finality *cache.Finality,
 	indexerName string,
 ) *BlockHandler {
 	return &BlockHandler{
@@ -45,6 +48,7 @@ func NewBlockHandler(
 		price:       price,
 		state:       state,
 		cache:       cache,
+		finality:    finality,
 		indexerName: indexerName,
 	}
 }
@@ -53,12 +57,15 @@ var _ Handler = (*BlockHandler)(nil)
 
 func (handler *BlockHandler) InitRoutes(srvr *echo.Group) {
 	middlewareCache := cache.NewDefaultMiddlewareCache(handler.cache)
+	headCache := cache.NewHeadMiddlewareCache(handler.cache)
+	headConditional := handler.finality.HeadConditionalMiddleware()
 
 	blockGroup := srvr.Group("/block")
 	{
-		blockGroup.GET("", handler.List)
-		blockGroup.GET("/count", handler.Count)
-		heightGroup := blockGroup.Group("/:height", middlewareCache)
+		blockGroup.GET("", handler.List, headConditional, headCache)
+		blockGroup.GET("/count", handler.Count, headConditional, headCache)
+		blockGroup.POST("/batch", handler.Batch)
+		heightGroup := blockGroup.Group("/:height", cache.ConditionalMiddleware(), middlewareCache, handler.finality.HeightMiddleware("height"))
 		{
 			heightGroup.GET("", handler.Get, middlewareCache)
 			heightGroup.GET("/actions", handler.GetActions, middlewareCache)
@@ -106,7 +113,11 @@ func (handler *BlockHandler) Get(c echo.Context) error {
 		return handleError(c, err, handler.block)
 	}
 
-	return c.JSON(http.StatusOK, responses.NewBlock(block))
+	handler.finality.SetImmutable(c, block.Hash, block.Height, block.Time)
+
+	response := responses.NewBlock(block)
+	response.SetFinality(handler.finality)
+	return c.JSON(http.StatusOK, response)
 }
 
 type blockListRequest struct {
@@ -114,6 +125,8 @@ type blockListRequest struct {
 	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
 	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
 	Stats  bool   `query:"stats"  validate:"omitempty"`
+
+	Finalized bool `query:"finalized" validate:"omitempty"`
 }
 
 func (p *blockListRequest) SetDefault() {
@@ -135,6 +148,7 @@ func (p *blockListRequest) SetDefault() {
 //	@Param			offset	query	integer	false	"Offset"						mininum(1)
 //	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
 //	@Param			stats	query	boolean	false	"Need join stats for block"
+//	@Param			finalized	query	boolean	false	"If true hide not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Block
 //	@Failure		400	{object}	Error
@@ -148,7 +162,19 @@ func (handler *BlockHandler) List(c echo.Context) error {
 	req.SetDefault()
 
 	var blocks []*storage.Block
-	if req.Stats {
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Block{})
+		}
+		blocks, err = handler.block.Filter(c.Request().Context(), storage.BlockListFilter{
+			Limit:     req.Limit,
+			Offset:    req.Offset,
+			Sort:      pgSort(req.Sort),
+			MaxHeight: height,
+			WithStats: req.Stats,
+		})
+	} else if req.Stats {
 		blocks, err = handler.block.ListWithStats(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
 	} else {
 		blocks, err = handler.block.List(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
@@ -161,6 +187,7 @@ func (handler *BlockHandler) List(c echo.Context) error {
 	response := make([]responses.Block, len(blocks))
 	for i := range blocks {
 		response[i] = responses.NewBlock(*blocks[i])
+		response[i].SetFinality(handler.finality)
 	}
 
 	return returnArray(c, response)
@@ -207,6 +234,7 @@ func (handler *BlockHandler) GetActions(c echo.Context) error {
 	response := make([]responses.Action, len(actions))
 	for i := range actions {
 		response[i] = responses.NewActionWithTx(actions[i])
+		response[i].SetFinality(handler.finality)
 	}
 
 	return returnArray(c, response)
@@ -341,6 +369,7 @@ func (handler *BlockHandler) GetTransactions(c echo.Context) error {
 	response := make([]responses.Tx, len(txs))
 	for i := range response {
 		response[i] = responses.NewTx(txs[i])
+		response[i].SetFinality(handler.finality)
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -378,3 +407,51 @@ func (handler *BlockHandler) GetPrices(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, response)
 }
+
+type blockBatchRequest struct {
+	Heights []types.Level `json:"heights" validate:"required,min=1,max=100"`
+	Stats   bool          `json:"stats"   validate:"omitempty"`
+}
+
+// Batch godoc
+//
+//	@Summary		Get blocks by heights
+//	@Description	Get up to 100 blocks by heights in one request. Items of the response are returned in order of the requested heights with error for unknown ones.
+//	@Tags			block
+//	@ID				get-blocks-batch
+//	@Param			request	body	blockBatchRequest	true	"Block heights"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		responses.BatchItem[responses.Block]
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/block/batch [post]
+func (handler *BlockHandler) Batch(c echo.Context) error {
+	req, err := bindAndValidate[blockBatchRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	found, err := handler.block.ByHeights(c.Request().Context(), req.Heights, req.Stats)
+	if err != nil {
+		return handleError(c, err, handler.block)
+	}
+	blocks := make(map[types.Level]storage.Block, len(found))
+	for i := range found {
+		blocks[found[i].Height] = found[i]
+	}
+
+	response := make([]responses.BatchItem[responses.Block], len(req.Heights))
+	for i, height := range req.Heights {
+		id := strconv.FormatUint(uint64(height), 10)
+		block, ok := blocks[height]
+		if !ok {
+			response[i] = responses.NewBatchError[responses.Block](id, batchErrNotFound)
+			continue
+		}
+		item := responses.NewBlock(block)
+		item.SetFinality(handler.finality)
+		response[i] = responses.NewBatchItem(id, item)
+	}
+	return c.JSON(http.StatusOK, response)
+}
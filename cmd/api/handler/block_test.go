@@ -12,12 +12,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	celestials "github.com/celenium-io/celestial-module/pkg/storage"
 	"github.com/shopspring/decimal"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
@@ -162,7 +164,7 @@ func (s *BlockTestSuite) SetupSuite() {
 	s.actions = mock.NewMockIAction(s.ctrl)
 	s.price = mock.NewMockIPrice(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.txs, s.actions, s.rollups, s.price, s.state, nil, testIndexerName)
+	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.txs, s.actions, s.rollups, s.price, s.state, nil, newTestFinality(), testIndexerName)
 }
 
 // TearDownSuite -
@@ -200,6 +202,12 @@ func (s *BlockTestSuite) TestGet() {
 	s.Require().Equal("000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F", block.Hash.String())
 	s.Require().Equal(testTime, block.Time)
 	s.Require().Nil(block.Stats)
+	s.Require().Nil(block.Confirmations)
+	s.Require().True(block.Finalized)
+
+	s.Require().Equal(`W/"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f-100"`, rec.Header().Get("ETag"))
+	s.Require().Equal(cache.CacheControlImmutable, rec.Header().Get(echo.HeaderCacheControl))
+	s.Require().Equal(testTime.UTC().Format(http.TimeFormat), rec.Header().Get(echo.HeaderLastModified))
 }
 
 func (s *BlockTestSuite) TestGetNoContent() {
@@ -359,6 +367,61 @@ func (s *BlockTestSuite) TestListWithStats() {
 	s.Require().Equal(testTime, blocks[0].Time)
 }
 
+func (s *BlockTestSuite) TestListFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+	q.Set("sort", "desc")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block")
+
+	s.blocks.EXPECT().
+		Filter(gomock.Any(), storage.BlockListFilter{
+			Limit:     10,
+			Sort:      pgSort(desc),
+			MaxHeight: 100,
+		}).
+		Return([]*storage.Block{
+			&testBlock,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var blocks []responses.Block
+	err := json.NewDecoder(rec.Body).Decode(&blocks)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 1)
+	s.Require().EqualValues(100, blocks[0].Height)
+	s.Require().Nil(blocks[0].Confirmations)
+	s.Require().True(blocks[0].Finalized)
+}
+
+func (s *BlockTestSuite) TestListFinalizedWithoutFinalizedBlocks() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block")
+
+	finality := cache.NewFinality(nil, testConfirmationDepth)
+	finality.SetHead(5)
+	handler := NewBlockHandler(s.blocks, s.blockStats, s.txs, s.actions, s.rollups, s.price, s.state, nil, finality, testIndexerName)
+
+	s.Require().NoError(handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var blocks []responses.Block
+	err := json.NewDecoder(rec.Body).Decode(&blocks)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 0)
+}
+
 func (s *BlockTestSuite) TestGetActions() {
 	q := make(url.Values)
 	q.Set("limit", "2")
@@ -570,3 +633,45 @@ func (s *BlockTestSuite) TestGetPrices() {
 	s.Require().EqualValues("100.01", price.Price)
 	s.Require().Equal(testTime, price.Time)
 }
+
+func (s *BlockTestSuite) TestBatch() {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"heights":[200,100],"stats":true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/batch")
+
+	s.blocks.EXPECT().
+		ByHeights(gomock.Any(), []pkgTypes.Level{200, 100}, true).
+		Return([]storage.Block{testBlockWithStats}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Batch(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.BatchItem[responses.Block]
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Require().Equal("200", items[0].Id)
+	s.Require().Nil(items[0].Data)
+	s.Require().Equal("not found", items[0].Error)
+
+	s.Require().Equal("100", items[1].Id)
+	s.Require().NotNil(items[1].Data)
+	s.Require().EqualValues(100, items[1].Data.Height)
+	s.Require().NotNil(items[1].Data.Stats)
+	s.Require().True(items[1].Data.Finalized)
+}
+
+func (s *BlockTestSuite) TestBatchEmpty() {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"heights":[]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/block/batch")
+
+	s.Require().NoError(s.handler.Batch(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
@@ -9,20 +9,25 @@ import (
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
 )
 
 type ConstantHandler struct {
 	constants storage.IConstant
+	history   storage.IConstantHistory
 	cache     cache.ICache
 }
 
 func NewConstantHandler(
 	constants storage.IConstant,
+	history storage.IConstantHistory,
 	cache cache.ICache,
 ) *ConstantHandler {
 	return &ConstantHandler{
 		constants: constants,
+		history:   history,
 		cache:     cache,
 	}
 }
@@ -33,28 +38,104 @@ func (handler *ConstantHandler) InitRoutes(srvr *echo.Group) {
 	middlewareCache := cache.NewDefaultMiddlewareCache(handler.cache)
 
 	srvr.GET("/constants", handler.Get)
+	srvr.GET("/constants/:module/:name/history", handler.History)
 	srvr.GET("/enums", handler.Enums, middlewareCache)
 }
 
+type getConstantsRequest struct {
+	Height uint64 `query:"height" validate:"omitempty,min=1"`
+}
+
 // Get godoc
 //
 //	@Summary		Get network constants
-//	@Description	Get network constants
+//	@Description	Get network constants. If height is passed, constants are returned as they were at that height.
 //	@Tags			general
 //	@ID				get-constants
+//	@Param			height	query	integer	false	"Block height"	mininum(1)
 //	@Produce		json
 //	@Success		200	{object}	responses.Constants
 //	@Success		204
+//	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/v1/constants [get]
 func (handler *ConstantHandler) Get(c echo.Context) error {
-	consts, err := handler.constants.All(c.Request().Context())
+	req, err := bindAndValidate[getConstantsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	var consts []storage.Constant
+	if req.Height > 0 {
+		consts, err = handler.constants.AtHeight(c.Request().Context(), pkgTypes.Level(req.Height))
+	} else {
+		consts, err = handler.constants.All(c.Request().Context())
+	}
 	if err != nil {
 		return handleError(c, err, handler.constants)
 	}
 	return c.JSON(http.StatusOK, responses.NewConstants(consts))
 }
 
+type constantHistoryRequest struct {
+	Module string `param:"module" validate:"required,oneof=block evidence validator version generic"`
+	Name   string `param:"name"   validate:"required"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (p *constantHistoryRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// History godoc
+//
+//	@Summary		Get history of constant
+//	@Description	Get list of changes of the constant with heights and actions which made them
+//	@Tags			general
+//	@ID				get-constant-history
+//	@Param			module	path	string	true	"Module name"	Enums(block, evidence, validator, version, generic)
+//	@Param			name	path	string	true	"Constant name"
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.ConstantHistory
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/constants/{module}/{name}/history [get]
+func (handler *ConstantHandler) History(c echo.Context) error {
+	req, err := bindAndValidate[constantHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	history, err := handler.history.ByName(
+		c.Request().Context(),
+		types.ModuleName(req.Module),
+		req.Name,
+		req.Limit,
+		req.Offset,
+		pgSort(req.Sort),
+	)
+	if err != nil {
+		return handleError(c, err, handler.constants)
+	}
+
+	response := make([]responses.ConstantHistory, len(history))
+	for i := range history {
+		response[i] = responses.NewConstantHistory(history[i])
+	}
+	return returnArray(c, response)
+}
+
 // Enums godoc
 //
 //	@Summary		Get astria explorer enumerators
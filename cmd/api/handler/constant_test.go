@@ -8,11 +8,15 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -22,6 +26,7 @@ import (
 type ConstantTestSuite struct {
 	suite.Suite
 	constants *mock.MockIConstant
+	history   *mock.MockIConstantHistory
 	echo      *echo.Echo
 	handler   *ConstantHandler
 	ctrl      *gomock.Controller
@@ -33,7 +38,8 @@ func (s *ConstantTestSuite) SetupSuite() {
 	s.echo.Validator = NewApiValidator()
 	s.ctrl = gomock.NewController(s.T())
 	s.constants = mock.NewMockIConstant(s.ctrl)
-	s.handler = NewConstantHandler(s.constants, nil)
+	s.history = mock.NewMockIConstantHistory(s.ctrl)
+	s.handler = NewConstantHandler(s.constants, s.history, nil)
 }
 
 // TearDownSuite -
@@ -90,3 +96,89 @@ func (s *ConstantTestSuite) TestGet() {
 		s.Require().NotEmpty(module)
 	}
 }
+
+func (s *ConstantTestSuite) TestGetAtHeight() {
+	q := make(url.Values)
+	q.Set("height", "100")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/constants")
+
+	s.constants.EXPECT().
+		AtHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Constant{
+			{
+				Module: types.ModuleNameGeneric,
+				Name:   "transfer_base",
+				Value:  "12",
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var consts responses.Constants
+	err := json.NewDecoder(rec.Body).Decode(&consts)
+	s.Require().NoError(err)
+	s.Require().Contains(consts.Module, "generic")
+	s.Require().Equal("12", consts.Module["generic"]["transfer_base"])
+}
+
+func (s *ConstantTestSuite) TestHistory() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("sort", "asc")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/constants/:module/:name/history")
+	c.SetParamNames("module", "name")
+	c.SetParamValues("generic", "transfer_base")
+
+	prev := "10"
+	s.history.EXPECT().
+		ByName(gomock.Any(), types.ModuleNameGeneric, "transfer_base", 10, 0, sdk.SortOrderAsc).
+		Return([]storage.ConstantHistory{
+			{
+				Id:        1,
+				Height:    100,
+				Time:      testTime,
+				ActionId:  2,
+				Module:    types.ModuleNameGeneric,
+				Name:      "transfer_base",
+				Value:     "12",
+				PrevValue: &prev,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var history []responses.ConstantHistory
+	err := json.NewDecoder(rec.Body).Decode(&history)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().EqualValues(100, history[0].Height)
+	s.Require().EqualValues(2, history[0].ActionId)
+	s.Require().Equal("generic", history[0].Module)
+	s.Require().Equal("12", history[0].Value)
+	s.Require().NotNil(history[0].PrevValue)
+	s.Require().Equal("10", *history[0].PrevValue)
+}
+
+func (s *ConstantTestSuite) TestHistoryInvalidModule() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/constants/:module/:name/history")
+	c.SetParamNames("module", "name")
+	c.SetParamValues("unknown", "transfer_base")
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// csrfExemptPaths - POST endpoints which are called by API clients without browser session, so they don't have CSRF token
+var csrfExemptPaths = []string{
+	"blob",
+	"auth/rollup",
+	"tx/decode",
+	"fee/estimate",
+	"tx/batch",
+	"address/batch",
+	"block/batch",
+}
+
+// CSRFConfig - returns config of CSRF middleware of the API. Requests to the CSRF exempt endpoints and requests passed the skipper aren't checked.
+func CSRFConfig(skipper middleware.Skipper) middleware.CSRFConfig {
+	return middleware.CSRFConfig{
+		Skipper: func(c echo.Context) bool {
+			if skipper != nil && skipper(c) {
+				return true
+			}
+			return isCsrfExempt(c)
+		},
+	}
+}
+
+func isCsrfExempt(c echo.Context) bool {
+	for _, path := range csrfExemptPaths {
+		if strings.Contains(c.Request().URL.Path, path) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSRFConfig(t *testing.T) {
+	e := echo.New()
+	e.Use(middleware.CSRFWithConfig(CSRFConfig(func(c echo.Context) bool {
+		return strings.Contains(c.Request().URL.Path, "ws")
+	})))
+	ok := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+	for _, path := range []string{"/v1/tx/batch", "/v1/address/batch", "/v1/block/batch", "/v1/tx/decode", "/v1/fee/estimate", "/v1/ws", "/v1/rollup"} {
+		e.POST(path, ok)
+	}
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{path: "/v1/tx/batch", status: http.StatusOK},
+		{path: "/v1/address/batch", status: http.StatusOK},
+		{path: "/v1/block/batch", status: http.StatusOK},
+		{path: "/v1/tx/decode", status: http.StatusOK},
+		{path: "/v1/fee/estimate", status: http.StatusOK},
+		{path: "/v1/ws", status: http.StatusOK},
+		{path: "/v1/rollup", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+			require.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
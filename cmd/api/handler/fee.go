@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// depositBaseFee - constant part of variable fee component for deposits in the sequencer
+const depositBaseFee = 16
+
+type FeeHandler struct {
+	constantCache *cache.ConstantsCache
+	decoder       TxDecoder
+}
+
+func NewFeeHandler(
+	constantCache *cache.ConstantsCache,
+	decoder TxDecoder,
+) *FeeHandler {
+	return &FeeHandler{
+		constantCache: constantCache,
+		decoder:       decoder,
+	}
+}
+
+var _ Handler = (*FeeHandler)(nil)
+
+func (handler *FeeHandler) InitRoutes(srvr *echo.Group) {
+	srvr.POST("/fee/estimate", handler.Estimate)
+}
+
+type estimateFeeAction struct {
+	Type string `json:"type" validate:"required,action_type"`
+	Size uint64 `json:"size" validate:"omitempty"`
+}
+
+type estimateFeeRequest struct {
+	Actions  []estimateFeeAction `json:"actions"  validate:"required_without=Tx,omitempty,max=100,dive"`
+	Tx       string              `json:"tx"       validate:"required_without=Actions,excluded_with=Actions"`
+	Encoding string              `json:"encoding" validate:"omitempty,oneof=base64 hex"`
+}
+
+// Estimate godoc
+//
+//	@Summary		Estimate fee
+//	@Description	Estimate fee of actions by current fee components. Fee of action is `base + multiplier * size`, where size is a length of data for rollup data submission and a deposit size for bridge lock and bridge transfer. Size is zero for other actions.
+//	@Description	Actions can be passed as a list of action types with sizes or as a raw transaction in base64 or hex. For raw transaction sizes and fee assets are taken from actions.
+//	@Tags			general
+//	@ID				estimate-fee
+//	@Param			request	body	estimateFeeRequest	true	"Actions or raw transaction"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	responses.FeeEstimation
+//	@Failure		400	{object}	Error
+//	@Router			/v1/fee/estimate [post]
+func (handler *FeeHandler) Estimate(c echo.Context) error {
+	req, err := bindAndValidate[estimateFeeRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	var actions []responses.ActionFee
+	if req.Tx != "" {
+		actions, err = handler.txActions(req)
+	} else {
+		actions = make([]responses.ActionFee, len(req.Actions))
+		for i := range req.Actions {
+			actions[i] = responses.ActionFee{
+				Position: int64(i),
+				Type:     types.ActionType(req.Actions[i].Type),
+				Size:     req.Actions[i].Size,
+			}
+		}
+	}
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	total := decimal.Zero
+	for i := range actions {
+		fee, err := handler.actionFee(&actions[i])
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		total = total.Add(fee)
+	}
+
+	assets := handler.allowedFeeAssets()
+	response := responses.FeeEstimation{
+		Actions:   actions,
+		Total:     total.String(),
+		FeeAssets: make([]responses.FeeAssetTotal, 0, len(assets)),
+	}
+
+	if req.Tx != "" {
+		totals := make(map[string]decimal.Decimal)
+		for i := range actions {
+			totals[actions[i].FeeAsset] = totals[actions[i].FeeAsset].Add(decimal.RequireFromString(actions[i].Fee))
+		}
+		for asset, value := range totals {
+			_, allowed := assets[asset]
+			response.FeeAssets = append(response.FeeAssets, responses.FeeAssetTotal{
+				Asset:   asset,
+				Total:   value.String(),
+				Allowed: allowed,
+			})
+		}
+	} else {
+		for asset := range assets {
+			response.FeeAssets = append(response.FeeAssets, responses.FeeAssetTotal{
+				Asset:   asset,
+				Total:   total.String(),
+				Allowed: true,
+			})
+		}
+	}
+	sort.Slice(response.FeeAssets, func(i, j int) bool {
+		return response.FeeAssets[i].Asset < response.FeeAssets[j].Asset
+	})
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (handler *FeeHandler) txActions(req *estimateFeeRequest) ([]responses.ActionFee, error) {
+	decodeReq := decodeTxRequest{Tx: req.Tx, Encoding: req.Encoding}
+	raw, err := decodeReq.raw()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid transaction encoding")
+	}
+
+	tx, err := handler.decoder.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]responses.ActionFee, len(tx.Actions))
+	for i := range tx.Actions {
+		actions[i] = responses.ActionFee{
+			Position: tx.Actions[i].Position,
+			Type:     tx.Actions[i].Type,
+			Size:     feeVariableComponent(tx.Actions[i]),
+		}
+		if asset, ok := tx.Actions[i].Data["fee_asset"].(string); ok {
+			actions[i].FeeAsset = asset
+		}
+	}
+	return actions, nil
+}
+
+func (handler *FeeHandler) actionFee(action *responses.ActionFee) (decimal.Decimal, error) {
+	base, ok := handler.feeComponent(action.Type, "base")
+	if !ok {
+		return decimal.Zero, errors.Errorf("unknown fee components of action: %s", action.Type)
+	}
+	multiplier, ok := handler.feeComponent(action.Type, "multiplier")
+	if !ok {
+		return decimal.Zero, errors.Errorf("unknown fee components of action: %s", action.Type)
+	}
+
+	decBase, err := decimal.NewFromString(base)
+	if err != nil {
+		return decimal.Zero, errors.Wrapf(err, "invalid fee base of action: %s", action.Type)
+	}
+	decMultiplier, err := decimal.NewFromString(multiplier)
+	if err != nil {
+		return decimal.Zero, errors.Wrapf(err, "invalid fee multiplier of action: %s", action.Type)
+	}
+
+	fee := decBase.Add(decMultiplier.Mul(decimal.NewFromUint64(action.Size)))
+	action.Base = decBase.String()
+	action.Multiplier = decMultiplier.String()
+	action.Fee = fee.String()
+	return fee, nil
+}
+
+func (handler *FeeHandler) allowedFeeAssets() map[string]struct{} {
+	constants := handler.constantCache.ByPrefix(types.ModuleNameGeneric, storage.AllowedFeeAssetPrefix)
+	assets := make(map[string]struct{}, len(constants))
+	for name, value := range constants {
+		if value == "true" {
+			assets[strings.TrimPrefix(name, storage.AllowedFeeAssetPrefix)] = struct{}{}
+		}
+	}
+	return assets
+}
+
+// feeComponent - returns value of fee component of the action type. Fee changes store components by action type name, so they take precedence over genesis components.
+func (handler *FeeHandler) feeComponent(typ types.ActionType, component string) (string, bool) {
+	if value, ok := handler.constantCache.Get(types.ModuleNameGeneric, fmt.Sprintf("%s_%s", typ, component)); ok {
+		return value, true
+	}
+	return handler.constantCache.Get(types.ModuleNameGeneric, fmt.Sprintf("%s_%s", feeComponentName(typ), component))
+}
+
+// feeComponentName - returns name of fee components of the action type which is used in genesis constant names
+func feeComponentName(typ types.ActionType) string {
+	switch typ {
+	case types.ActionTypeBridgeSudoChangeAction:
+		return "bridge_sudo_change"
+	case types.ActionTypeIbcSudoChangeAction:
+		return "ibc_sudo_change"
+	default:
+		return typ.String()
+	}
+}
+
+// feeVariableComponent - returns size of the action which is multiplied by fee multiplier
+func feeVariableComponent(action storage.Action) uint64 {
+	switch action.Type {
+	case types.ActionTypeRollupDataSubmission:
+		if data, ok := action.Data["data"].([]byte); ok {
+			return uint64(len(data))
+		}
+	case types.ActionTypeBridgeLock:
+		asset, _ := action.Data["asset"].(string)
+		destination, _ := action.Data["destination_chain_address"].(string)
+		return uint64(depositBaseFee + len(asset) + len(destination))
+	case types.ActionTypeBridgeTransfer:
+		// deposit is made in the asset of the bridge account which may differ from the fee asset
+		var asset string
+		if len(action.BalanceUpdates) > 0 {
+			asset = action.BalanceUpdates[0].Currency
+		}
+		destination, _ := action.Data["destination_chain_address"].(string)
+		return uint64(depositBaseFee + len(asset) + len(destination))
+	}
+	return 0
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// FeeTestSuite -
+type FeeTestSuite struct {
+	suite.Suite
+	constants *mock.MockIConstant
+	decoder   *testTxDecoder
+	echo      *echo.Echo
+	handler   *FeeHandler
+	ctrl      *gomock.Controller
+}
+
+func TestSuiteFee_Run(t *testing.T) {
+	suite.Run(t, new(FeeTestSuite))
+}
+
+func (s *FeeTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.constants = mock.NewMockIConstant(s.ctrl)
+	s.decoder = new(testTxDecoder)
+
+	s.constants.EXPECT().
+		All(gomock.Any()).
+		Return([]storage.Constant{
+			{Module: types.ModuleNameGeneric, Name: "rollup_data_submission_base", Value: "32"},
+			{Module: types.ModuleNameGeneric, Name: "rollup_data_submission_multiplier", Value: "1"},
+			{Module: types.ModuleNameGeneric, Name: "transfer_base", Value: "12"},
+			{Module: types.ModuleNameGeneric, Name: "transfer_multiplier", Value: "0"},
+			{Module: types.ModuleNameGeneric, Name: "bridge_sudo_change_base", Value: "24"},
+			{Module: types.ModuleNameGeneric, Name: "bridge_sudo_change_multiplier", Value: "0"},
+			{Module: types.ModuleNameGeneric, Name: "ibc_sudo_change_base", Value: "24"},
+			{Module: types.ModuleNameGeneric, Name: "ibc_sudo_change_multiplier", Value: "0"},
+			{Module: types.ModuleNameGeneric, Name: "ibc_sudo_change_action_base", Value: "48"},
+			{Module: types.ModuleNameGeneric, Name: "ibc_sudo_change_action_multiplier", Value: "0"},
+			{Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("nria"), Value: "true"},
+			{Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("ibc/usdc"), Value: "true"},
+			{Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("removed"), Value: "false"},
+		}, nil).
+		Times(1)
+
+	constantCache := cache.NewConstantsCache(bus.NewObserver(storage.ChannelConstant))
+	s.Require().NoError(constantCache.Start(context.Background(), s.constants))
+	s.handler = NewFeeHandler(constantCache, s.decoder)
+}
+
+func (s *FeeTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func (s *FeeTestSuite) estimateRequest(body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/fee/estimate")
+	return c, rec
+}
+
+func (s *FeeTestSuite) TestEstimateActions() {
+	c, rec := s.estimateRequest(`{"actions":[{"type":"rollup_data_submission","size":1000},{"type":"transfer"},{"type":"bridge_sudo_change_action"}]}`)
+
+	s.Require().NoError(s.handler.Estimate(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var estimation responses.FeeEstimation
+	err := json.NewDecoder(rec.Body).Decode(&estimation)
+	s.Require().NoError(err)
+	s.Require().Len(estimation.Actions, 3)
+
+	s.Require().Equal(types.ActionTypeRollupDataSubmission, estimation.Actions[0].Type)
+	s.Require().EqualValues(1000, estimation.Actions[0].Size)
+	s.Require().Equal("32", estimation.Actions[0].Base)
+	s.Require().Equal("1", estimation.Actions[0].Multiplier)
+	s.Require().Equal("1032", estimation.Actions[0].Fee)
+	s.Require().Equal("12", estimation.Actions[1].Fee)
+	s.Require().Equal("24", estimation.Actions[2].Fee)
+	s.Require().Equal("1068", estimation.Total)
+
+	s.Require().Len(estimation.FeeAssets, 2)
+	s.Require().Equal("ibc/usdc", estimation.FeeAssets[0].Asset)
+	s.Require().Equal("1068", estimation.FeeAssets[0].Total)
+	s.Require().True(estimation.FeeAssets[0].Allowed)
+	s.Require().Equal("nria", estimation.FeeAssets[1].Asset)
+	s.Require().Equal("1068", estimation.FeeAssets[1].Total)
+}
+
+func (s *FeeTestSuite) TestEstimateChangedFeeComponents() {
+	c, rec := s.estimateRequest(`{"actions":[{"type":"ibc_sudo_change_action"}]}`)
+
+	s.Require().NoError(s.handler.Estimate(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var estimation responses.FeeEstimation
+	err := json.NewDecoder(rec.Body).Decode(&estimation)
+	s.Require().NoError(err)
+	s.Require().Len(estimation.Actions, 1)
+	// components of fee change take precedence over genesis ones
+	s.Require().Equal("48", estimation.Actions[0].Base)
+	s.Require().Equal("48", estimation.Total)
+}
+
+func (s *FeeTestSuite) TestEstimateTx() {
+	s.decoder.err = nil
+	c, rec := s.estimateRequest(`{"tx":"AQID"}`)
+
+	s.Require().NoError(s.handler.Estimate(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var estimation responses.FeeEstimation
+	err := json.NewDecoder(rec.Body).Decode(&estimation)
+	s.Require().NoError(err)
+	s.Require().Len(estimation.Actions, 1)
+	s.Require().Equal("nria", estimation.Actions[0].FeeAsset)
+	s.Require().Equal("32", estimation.Actions[0].Fee)
+	s.Require().Equal("32", estimation.Total)
+
+	s.Require().Len(estimation.FeeAssets, 1)
+	s.Require().Equal("nria", estimation.FeeAssets[0].Asset)
+	s.Require().Equal("32", estimation.FeeAssets[0].Total)
+	s.Require().True(estimation.FeeAssets[0].Allowed)
+}
+
+func (s *FeeTestSuite) TestEstimateInvalidRequest() {
+	for _, body := range []string{
+		`{}`,
+		`{"actions":[{"type":"unknown"}]}`,
+		`{"actions":[{"type":"transfer"}],"tx":"AQID"}`,
+		`{"actions":[{"type":"ibc_relay"}]}`,
+	} {
+		c, rec := s.estimateRequest(body)
+		s.Require().NoError(s.handler.Estimate(c), body)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, body)
+	}
+}
+
+func TestFeeVariableComponent(t *testing.T) {
+	tests := []struct {
+		name   string
+		action storage.Action
+		want   uint64
+	}{
+		{
+			name: "rollup data submission",
+			action: storage.Action{
+				Type: types.ActionTypeRollupDataSubmission,
+				Data: map[string]any{"data": []byte{1, 2, 3}},
+			},
+			want: 3,
+		}, {
+			name: "bridge lock",
+			action: storage.Action{
+				Type: types.ActionTypeBridgeLock,
+				Data: map[string]any{"asset": "nria", "destination_chain_address": "0x1234"},
+			},
+			want: 26,
+		}, {
+			name: "bridge transfer",
+			action: storage.Action{
+				Type: types.ActionTypeBridgeTransfer,
+				Data: map[string]any{"fee_asset": "ibc/usdc", "destination_chain_address": "0x1234"},
+				BalanceUpdates: []storage.BalanceUpdate{
+					{Currency: "nria"},
+				},
+			},
+			want: 26,
+		}, {
+			name: "transfer",
+			action: storage.Action{
+				Type: types.ActionTypeTransfer,
+				Data: map[string]any{"amount": "100"},
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := feeVariableComponent(tt.action); got != tt.want {
+				t.Errorf("feeVariableComponent() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
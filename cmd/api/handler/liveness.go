@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+)
+
+type LivenessHandler struct {
+	monitor *liveness.Monitor
+	rollups storage.IRollup
+}
+
+func NewLivenessHandler(
+	monitor *liveness.Monitor,
+	rollups storage.IRollup,
+) *LivenessHandler {
+	return &LivenessHandler{
+		monitor: monitor,
+		rollups: rollups,
+	}
+}
+
+var _ Handler = (*LivenessHandler)(nil)
+
+func (handler *LivenessHandler) InitRoutes(srvr *echo.Group) {
+	srvr.GET("/rollup/:hash/liveness", handler.Get)
+}
+
+// Get godoc
+//
+//	@Summary		Get rollup liveness
+//	@Description	Get liveness of rollup data submissions. Rollup is `stalled` if it does not submit data longer than expected interval multiplied by configured factor. Expected interval is learned from rollup statistics or configured per application. Status is `unknown` if rollup did not submit data for the last 30 days or expected interval can not be learned.
+//	@Description	Intervals and delay are in seconds.
+//	@Tags			rollup
+//	@ID				get-rollup-liveness
+//	@Param			hash	path	string	true	"Base64Url encoded rollup id"
+//	@Produce		json
+//	@Success		200	{object}	responses.RollupLiveness
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/rollup/{hash}/liveness [get]
+func (handler *LivenessHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getRollupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := base64.URLEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	rollup, err := handler.rollups.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+
+	l, ok := handler.monitor.Get(rollup.Id)
+	if !ok {
+		l = liveness.Liveness{RollupId: rollup.Id}
+	}
+	l.Rollup = rollup.AstriaId
+	return c.JSON(http.StatusOK, responses.NewRollupLiveness(l))
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// LivenessTestSuite -
+type LivenessTestSuite struct {
+	suite.Suite
+	rollups *mock.MockIRollup
+	stats   *mock.MockIStats
+	state   *mock.MockIState
+	echo    *echo.Echo
+	handler *LivenessHandler
+	ctrl    *gomock.Controller
+}
+
+// SetupSuite -
+func (s *LivenessTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.stats = mock.NewMockIStats(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+
+	s.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(storage.State{LastTime: testTime}, nil).
+		Times(1)
+
+	s.stats.EXPECT().
+		RollupCadence(gomock.Any(), gomock.Any()).
+		Return([]storage.RollupCadence{
+			{
+				RollupId:     testRollup.Id,
+				ActionsCount: 11,
+				FirstTime:    testTime.Add(-time.Hour),
+				LastTime:     testTime.Add(-time.Minute),
+				Rollup:       &testRollup,
+			},
+		}, nil).
+		Times(1)
+
+	monitor := liveness.NewMonitor(liveness.Config{}, nil, s.stats, nil, nil)
+	s.Require().NoError(monitor.Start(context.Background(), s.state, testIndexerName))
+	s.handler = NewLivenessHandler(monitor, s.rollups)
+}
+
+// TearDownSuite -
+func (s *LivenessTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteLiveness_Run(t *testing.T) {
+	suite.Run(t, new(LivenessTestSuite))
+}
+
+func (s *LivenessTestSuite) TestGet() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/liveness")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response responses.RollupLiveness
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal(testRollupHash, response.Rollup)
+	s.Require().Equal(liveness.StatusLive, response.Status)
+	s.Require().EqualValues(354, response.ExpectedInterval)
+	s.Require().EqualValues(60, response.Delay)
+	s.Require().False(response.Configured)
+	s.Require().NotNil(response.LastActionTime)
+	s.Require().Nil(response.StalledSince)
+}
+
+func (s *LivenessTestSuite) TestGetUnknown() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/liveness")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	rollup := testRollup
+	rollup.Id = 100
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(rollup, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response responses.RollupLiveness
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal(testRollupHash, response.Rollup)
+	s.Require().Equal(liveness.StatusUnknown, response.Status)
+	s.Require().Nil(response.LastActionTime)
+}
+
+func (s *LivenessTestSuite) TestGetInvalidHash() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/liveness")
+	c.SetParamNames("hash")
+	c.SetParamValues("invalid")
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
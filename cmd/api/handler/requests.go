@@ -15,6 +15,12 @@ const (
 	desc = "desc"
 )
 
+// errors of batch items
+const (
+	batchErrInvalidId = "invalid identifier"
+	batchErrNotFound  = "not found"
+)
+
 func bindAndValidate[T any](c echo.Context) (*T, error) {
 	req := new(T)
 	if err := c.Bind(req); err != nil {
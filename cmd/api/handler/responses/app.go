@@ -37,6 +37,8 @@ type AppWithStats struct {
 
 	Links        []string      `json:"links,omitempty"`
 	NativeBridge *ShortAddress `json:"native_bridge,omitempty"`
+	Rollups      []AppRollup   `json:"rollups,omitempty"`
+	Bridges      []AppBridge   `json:"bridges,omitempty"`
 }
 
 func NewAppWithStats(r storage.AppWithStats) AppWithStats {
@@ -74,6 +76,33 @@ func NewAppWithStats(r storage.AppWithStats) AppWithStats {
 	return app
 }
 
+type AppRollup struct {
+	Rollup string `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" format:"string" json:"rollup" swaggertype:"string"`
+	Role   string `example:"mainnet"                                      format:"string" json:"role"   swaggertype:"string"`
+}
+
+func NewAppRollup(r storage.AppRollup) AppRollup {
+	result := AppRollup{
+		Role: r.Role,
+	}
+	if r.Rollup != nil {
+		result.Rollup = base64.StdEncoding.EncodeToString(r.Rollup.AstriaId)
+	}
+	return result
+}
+
+type AppBridge struct {
+	Bridge *ShortAddress `json:"bridge,omitempty"`
+	Role   string        `example:"usdc" format:"string" json:"role" swaggertype:"string"`
+}
+
+func NewAppBridge(b storage.AppBridge) AppBridge {
+	return AppBridge{
+		Bridge: NewShortAddress(b.Bridge),
+		Role:   b.Role,
+	}
+}
+
 type App struct {
 	Id          uint64 `example:"321"                                       format:"integer" json:"id"                    swaggertype:"integer"`
 	Name        string `example:"Rollup name"                               format:"string"  json:"name"                  swaggertype:"string"`
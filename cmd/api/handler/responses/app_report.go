@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+)
+
+type AppReport struct {
+	Size           int64     `example:"1000"                      format:"integer"   json:"size"                 swaggertype:"integer"`
+	ActionsCount   int64     `example:"100"                       format:"integer"   json:"actions_count"        swaggertype:"integer"`
+	FirstAction    time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"first_message_time"   swaggertype:"string"`
+	LastAction     time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"last_message_time"    swaggertype:"string"`
+	AvgInterval    float64   `example:"12.5"                      format:"float"     json:"avg_interval"         swaggertype:"number"`
+	IntervalJitter float64   `example:"1.2"                       format:"float"     json:"interval_jitter"      swaggertype:"number"`
+
+	Fees    []AppReportFee    `json:"fees"`
+	Gaps    []AppReportGap    `json:"gaps"`
+	Signers []AppReportSigner `json:"signers"`
+}
+
+func NewAppReport(report storage.AppReport) AppReport {
+	response := AppReport{
+		Size:           report.Size,
+		ActionsCount:   report.ActionsCount,
+		FirstAction:    report.FirstTime,
+		LastAction:     report.LastTime,
+		AvgInterval:    report.AvgInterval,
+		IntervalJitter: report.IntervalJitter,
+		Fees:           newAppReportFees(report.Fees, report.Size),
+		Gaps:           make([]AppReportGap, len(report.Gaps)),
+		Signers:        make([]AppReportSigner, len(report.Signers)),
+	}
+
+	for i := range report.Gaps {
+		response.Gaps[i] = AppReportGap{
+			FromTime:   report.Gaps[i].FromTime,
+			ToTime:     report.Gaps[i].ToTime,
+			FromHeight: report.Gaps[i].FromHeight,
+			ToHeight:   report.Gaps[i].ToHeight,
+			Duration:   report.Gaps[i].Duration,
+		}
+	}
+	for i := range report.Signers {
+		response.Signers[i] = AppReportSigner{
+			ActionsCount: report.Signers[i].ActionsCount,
+			Size:         report.Signers[i].Size,
+			Signer:       NewShortAddress(report.Signers[i].Signer),
+		}
+	}
+	return response
+}
+
+type AppReportFee struct {
+	Asset      string `example:"nria"    format:"string"  json:"asset"`
+	Amount     string `example:"1000000" format:"integer" json:"amount"`
+	FeeCount   int64  `example:"100"     format:"integer" json:"fee_count"`
+	FeePerByte string `example:"1.5"     format:"decimal" json:"fee_per_byte"`
+}
+
+func newAppReportFees(fees []storage.AppReportFee, size int64) []AppReportFee {
+	response := make([]AppReportFee, len(fees))
+	for i := range fees {
+		response[i] = AppReportFee{
+			Asset:      fees[i].Asset,
+			Amount:     fees[i].Amount,
+			FeeCount:   fees[i].FeeCount,
+			FeePerByte: "0",
+		}
+		if size == 0 {
+			continue
+		}
+		if amount, err := decimal.NewFromString(fees[i].Amount); err == nil {
+			response[i].FeePerByte = amount.Div(decimal.NewFromInt(size)).String()
+		}
+	}
+	return response
+}
+
+type AppReportGap struct {
+	FromTime   time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"from_time"   swaggertype:"string"`
+	ToTime     time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"to_time"     swaggertype:"string"`
+	FromHeight pkgTypes.Level `example:"100"                       format:"int64"     json:"from_height" swaggertype:"integer"`
+	ToHeight   pkgTypes.Level `example:"100"                       format:"int64"     json:"to_height"   swaggertype:"integer"`
+	Duration   float64        `example:"3600"                      format:"float"     json:"duration"    swaggertype:"number"`
+}
+
+type AppReportSigner struct {
+	ActionsCount int64 `example:"100"  format:"integer" json:"actions_count" swaggertype:"integer"`
+	Size         int64 `example:"1000" format:"integer" json:"size"          swaggertype:"integer"`
+
+	Signer *ShortAddress `json:"signer,omitempty"`
+}
+
+type AppReportItem struct {
+	Time           time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"            swaggertype:"string"`
+	Size           int64     `example:"1000"                      format:"integer"   json:"size"            swaggertype:"integer"`
+	ActionsCount   int64     `example:"100"                       format:"integer"   json:"actions_count"   swaggertype:"integer"`
+	AvgInterval    float64   `example:"12.5"                      format:"float"     json:"avg_interval"    swaggertype:"number"`
+	IntervalJitter float64   `example:"1.2"                       format:"float"     json:"interval_jitter" swaggertype:"number"`
+
+	Fees []AppReportFee `json:"fees"`
+}
+
+func NewAppReportItem(item storage.AppReportItem) AppReportItem {
+	return AppReportItem{
+		Time:           item.Time,
+		Size:           item.Size,
+		ActionsCount:   item.ActionsCount,
+		AvgInterval:    item.AvgInterval,
+		IntervalJitter: item.IntervalJitter,
+		Fees:           newAppReportFees(item.Fees, item.Size),
+	}
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+// BatchItem - result of batch request for one identifier. Either data or error is set.
+type BatchItem[T any] struct {
+	Id    string `example:"100"       json:"id"`
+	Data  *T     `json:"data,omitempty"`
+	Error string `example:"not found" json:"error,omitempty"`
+}
+
+func NewBatchItem[T any](id string, data T) BatchItem[T] {
+	return BatchItem[T]{
+		Id:   id,
+		Data: &data,
+	}
+}
+
+func NewBatchError[T any](id string, err string) BatchItem[T] {
+	return BatchItem[T]{
+		Id:    id,
+		Error: err,
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+type ConstantHistory struct {
+	Id        uint64         `example:"321"                       format:"int64"     json:"id"                   swaggertype:"integer"`
+	Height    pkgTypes.Level `example:"100"                       format:"int64"     json:"height"               swaggertype:"integer"`
+	Time      time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"                 swaggertype:"string"`
+	ActionId  uint64         `example:"1024"                      format:"int64"     json:"action_id,omitempty"  swaggertype:"integer"`
+	Module    string         `example:"generic"                   format:"string"    json:"module"               swaggertype:"string"`
+	Name      string         `example:"transfer_base"             format:"string"    json:"name"                 swaggertype:"string"`
+	Value     string         `example:"12"                        format:"string"    json:"value"                swaggertype:"string"`
+	PrevValue *string        `example:"10"                        format:"string"    json:"prev_value,omitempty" swaggertype:"string"`
+}
+
+func NewConstantHistory(history storage.ConstantHistory) ConstantHistory {
+	response := ConstantHistory{
+		Id:       history.Id,
+		Height:   history.Height,
+		Time:     history.Time,
+		ActionId: history.ActionId,
+		Module:   history.Module.String(),
+		Name:     history.Name,
+		Value:    roundCounstant(history.Value),
+	}
+	if history.PrevValue != nil {
+		prev := roundCounstant(*history.PrevValue)
+		response.PrevValue = &prev
+	}
+	return response
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+)
+
+type DecodedTx struct {
+	Signer         string   `example:"astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p" format:"string"  json:"signer"          swaggertype:"string"`
+	Nonce          uint32   `example:"1"                                             format:"int64"   json:"nonce"           swaggertype:"integer"`
+	ChainId        string   `example:"astria-dusk-10"                                format:"string"  json:"chain_id"        swaggertype:"string"`
+	SignatureValid bool     `example:"true"                                          format:"boolean" json:"signature_valid" swaggertype:"boolean"`
+	ActionTypes    []string `example:"rollup_data_submission,transfer"               format:"string"  json:"action_types"    swaggertype:"string"`
+
+	Actions []Action `json:"actions"`
+}
+
+func NewDecodedTx(signer string, nonce uint32, chainId string, signatureValid bool, actionTypes types.Bits, actions []storage.Action) DecodedTx {
+	result := DecodedTx{
+		Signer:         signer,
+		Nonce:          nonce,
+		ChainId:        chainId,
+		SignatureValid: signatureValid,
+		ActionTypes:    types.NewActionTypeMaskBits(actionTypes).Strings(),
+		Actions:        make([]Action, len(actions)),
+	}
+
+	for i := range actions {
+		result.Actions[i] = NewAction(actions[i])
+	}
+	return result
+}
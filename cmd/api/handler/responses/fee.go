@@ -18,6 +18,8 @@ type FullFee struct {
 	Height pkgTypes.Level `example:"100"                                                              format:"int64"     json:"height"            swaggertype:"integer"`
 	Time   time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"              swaggertype:"string"`
 
+	ActionType string `example:"rollup_data_submission" format:"string" json:"action_type,omitempty" swaggertype:"string"`
+
 	Payer *ShortAddress `json:"payer,omitempty"`
 }
 
@@ -28,6 +30,8 @@ func NewFullFee(fee storage.Fee) FullFee {
 		Asset:  fee.Asset,
 		Amount: fee.Amount.String(),
 		Payer:  NewShortAddress(fee.Payer),
+
+		ActionType: fee.ActionType.String(),
 	}
 
 	if fee.Tx != nil {
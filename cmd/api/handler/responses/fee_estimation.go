@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import "github.com/celenium-io/astria-indexer/internal/storage/types"
+
+type ActionFee struct {
+	Position   int64            `example:"0"                      format:"int64"  json:"position"            swaggertype:"integer"`
+	Type       types.ActionType `example:"rollup_data_submission" format:"string" json:"type"                swaggertype:"string"`
+	Size       uint64           `example:"1024"                   format:"int64"  json:"size"                swaggertype:"integer"`
+	FeeAsset   string           `example:"nria"                   format:"string" json:"fee_asset,omitempty" swaggertype:"string"`
+	Base       string           `example:"32"                     format:"string" json:"base"                swaggertype:"string"`
+	Multiplier string           `example:"1"                      format:"string" json:"multiplier"          swaggertype:"string"`
+	Fee        string           `example:"1056"                   format:"string" json:"fee"                 swaggertype:"string"`
+}
+
+type FeeAssetTotal struct {
+	Asset   string `example:"nria" format:"string"  json:"asset"   swaggertype:"string"`
+	Total   string `example:"1056" format:"string"  json:"total"   swaggertype:"string"`
+	Allowed bool   `example:"true" format:"boolean" json:"allowed" swaggertype:"boolean"`
+}
+
+type FeeEstimation struct {
+	Actions   []ActionFee     `json:"actions"`
+	Total     string          `example:"1056" format:"string" json:"total" swaggertype:"string"`
+	FeeAssets []FeeAssetTotal `json:"fee_assets"`
+}
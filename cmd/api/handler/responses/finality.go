@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+
+// Finality - source of confirmations for indexed heights
+type Finality interface {
+	Confirmations(height pkgTypes.Level) uint64
+	IsFinalized(height pkgTypes.Level) bool
+}
+
+// confirmations - returns confirmations of unsafe height. Finalized responses may be cached as immutable, so they don't contain growing count of confirmations.
+func confirmations(f Finality, height pkgTypes.Level, finalized bool) *uint64 {
+	if finalized {
+		return nil
+	}
+	count := f.Confirmations(height)
+	return &count
+}
+
+func (b *Block) SetFinality(f Finality) {
+	b.Finalized = f.IsFinalized(pkgTypes.Level(b.Height))
+	b.Confirmations = confirmations(f, pkgTypes.Level(b.Height), b.Finalized)
+}
+
+func (tx *Tx) SetFinality(f Finality) {
+	tx.Finalized = f.IsFinalized(tx.Height)
+	tx.Confirmations = confirmations(f, tx.Height, tx.Finalized)
+
+	for i := range tx.Actions {
+		tx.Actions[i].SetFinality(f)
+	}
+}
+
+func (a *Action) SetFinality(f Finality) {
+	a.Finalized = f.IsFinalized(a.Height)
+	a.Confirmations = confirmations(f, a.Height, a.Finalized)
+}
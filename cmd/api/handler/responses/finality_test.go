@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockSetFinality(t *testing.T) {
+	f := cache.NewFinality(nil, 10)
+	f.SetHead(100)
+
+	block := Block{Height: 95}
+	block.SetFinality(f)
+	require.False(t, block.Finalized)
+	require.NotNil(t, block.Confirmations)
+	require.EqualValues(t, 5, *block.Confirmations)
+
+	block = Block{Height: 80}
+	block.SetFinality(f)
+	require.True(t, block.Finalized)
+	require.Nil(t, block.Confirmations)
+}
+
+func TestTxSetFinalityZeroDepth(t *testing.T) {
+	f := cache.NewFinality(nil, 0)
+	f.SetHead(100)
+
+	tx := Tx{
+		Height: 1,
+		Actions: []Action{
+			{Height: 1},
+		},
+	}
+	tx.SetFinality(f)
+	require.True(t, tx.Finalized)
+	require.Nil(t, tx.Confirmations)
+	require.True(t, tx.Actions[0].Finalized)
+	require.Nil(t, tx.Actions[0].Confirmations)
+
+	// response of finalized height is not changed by new blocks
+	f.SetHead(200)
+	next := Tx{Height: 1}
+	next.SetFinality(f)
+	require.Equal(t, tx.Finalized, next.Finalized)
+	require.Equal(t, tx.Confirmations, next.Confirmations)
+
+	tx = Tx{Height: 201}
+	tx.SetFinality(f)
+	require.False(t, tx.Finalized)
+	require.NotNil(t, tx.Confirmations)
+	require.EqualValues(t, 0, *tx.Confirmations)
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
+)
+
+type RollupLiveness struct {
+	Rollup           string     `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" format:"base64"    json:"rollup"                  swaggertype:"string"`
+	Status           string     `example:"live"                                         format:"string"    json:"status"                  swaggertype:"string"`
+	LastActionTime   *time.Time `example:"2023-07-04T03:10:57+00:00"                    format:"date-time" json:"last_action_time,omitempty" swaggertype:"string"`
+	ExpectedInterval float64    `example:"12.5"                                         format:"float"     json:"expected_interval"       swaggertype:"number"`
+	Configured       bool       `example:"false"                                        format:"boolean"   json:"configured"              swaggertype:"boolean"`
+	Delay            float64    `example:"10"                                           format:"float"     json:"delay"                   swaggertype:"number"`
+	StalledSince     *time.Time `example:"2023-07-04T03:10:57+00:00"                    format:"date-time" json:"stalled_since,omitempty" swaggertype:"string"`
+	CheckedAt        *time.Time `example:"2023-07-04T03:10:57+00:00"                    format:"date-time" json:"checked_at,omitempty"    swaggertype:"string"`
+}
+
+func NewRollupLiveness(l liveness.Liveness) RollupLiveness {
+	response := RollupLiveness{
+		Rollup:           base64.StdEncoding.EncodeToString(l.Rollup),
+		Status:           l.Status(),
+		ExpectedInterval: l.ExpectedInterval.Seconds(),
+		Configured:       l.Configured,
+		Delay:            l.Delay().Seconds(),
+	}
+	if !l.LastActionTime.IsZero() {
+		response.LastActionTime = &l.LastActionTime
+	}
+	if !l.StalledSince.IsZero() {
+		response.StalledSince = &l.StalledSince
+	}
+	if !l.CheckedAt.IsZero() {
+		response.CheckedAt = &l.CheckedAt
+	}
+	return response
+}
+
+type RollupLivenessEvent struct {
+	Type string    `example:"rollup_stalled"            format:"string"    json:"type" swaggertype:"string"`
+	Time time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time" swaggertype:"string"`
+
+	RollupLiveness
+}
+
+func NewRollupLivenessEvent(event liveness.Event) RollupLivenessEvent {
+	return RollupLivenessEvent{
+		Type:           event.Type,
+		Time:           event.Time,
+		RollupLiveness: NewRollupLiveness(event.Liveness),
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+type RollbackEvent struct {
+	Id             uint64         `example:"321"                                                              format:"int64"     json:"id"              swaggertype:"integer"`
+	Time           time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"            swaggertype:"string"`
+	FromHeight     pkgTypes.Level `example:"100"                                                              format:"int64"     json:"from_height"     swaggertype:"integer"`
+	ToHeight       pkgTypes.Level `example:"101"                                                              format:"int64"     json:"to_height"       swaggertype:"integer"`
+	Depth          int64          `example:"2"                                                                format:"int64"     json:"depth"           swaggertype:"integer"`
+	OldHash        string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"string"    json:"old_hash"        swaggertype:"string"`
+	NewHash        string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"string"    json:"new_hash"        swaggertype:"string"`
+	TxCount        int64          `example:"12"                                                               format:"int64"     json:"tx_count"        swaggertype:"integer"`
+	ActionsCount   int64          `example:"20"                                                               format:"int64"     json:"actions_count"   swaggertype:"integer"`
+	AddressesCount int64          `example:"1"                                                                format:"int64"     json:"addresses_count" swaggertype:"integer"`
+	RollupsCount   int64          `example:"1"                                                                format:"int64"     json:"rollups_count"   swaggertype:"integer"`
+	BridgesCount   int64          `example:"0"                                                                format:"int64"     json:"bridges_count"   swaggertype:"integer"`
+}
+
+func NewRollbackEvent(event storage.RollbackEvent) RollbackEvent {
+	return RollbackEvent{
+		Id:             event.Id,
+		Time:           event.Time,
+		FromHeight:     event.FromHeight,
+		ToHeight:       event.ToHeight,
+		Depth:          event.Depth,
+		OldHash:        hex.EncodeToString(event.OldHash),
+		NewHash:        hex.EncodeToString(event.NewHash),
+		TxCount:        event.TxCount,
+		ActionsCount:   event.ActionsCount,
+		AddressesCount: event.AddressesCount,
+		RollupsCount:   event.RollupsCount,
+		BridgesCount:   event.BridgesCount,
+	}
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"sort"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/shopspring/decimal"
+)
+
+type RollupAccount struct {
+	Destination         string               `example:"0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA" json:"destination"`
+	Deposits            []Deposit            `json:"deposits"`
+	Withdrawals         []Action             `json:"withdrawals"`
+	PossibleWithdrawals []Action             `json:"possible_withdrawals"`
+	Totals              []RollupAccountTotal `json:"totals"`
+}
+
+type RollupAccountTotal struct {
+	Asset                    string `example:"nria" format:"string"  json:"asset"                      swaggertype:"string"`
+	Deposited                string `example:"1000" format:"string"  json:"deposited"                  swaggertype:"string"`
+	DepositsCount            int64  `example:"10"   format:"integer" json:"deposits_count"             swaggertype:"integer"`
+	Withdrawn                string `example:"100"  format:"string"  json:"withdrawn"                  swaggertype:"string"`
+	WithdrawalsCount         int64  `example:"1"    format:"integer" json:"withdrawals_count"          swaggertype:"integer"`
+	PossiblyWithdrawn        string `example:"100"  format:"string"  json:"possibly_withdrawn"         swaggertype:"string"`
+	PossibleWithdrawalsCount int64  `example:"1"    format:"integer" json:"possible_withdrawals_count" swaggertype:"integer"`
+}
+
+// NewRollupAccountTotals - merges deposit, exactly linked and possible withdrawal totals by asset
+func NewRollupAccountTotals(deposits, withdrawals, possible []storage.AssetTotal) []RollupAccountTotal {
+	type total struct {
+		deposited        decimal.Decimal
+		depositsCount    int64
+		withdrawn        decimal.Decimal
+		withdrawalsCount int64
+		possible         decimal.Decimal
+		possibleCount    int64
+	}
+
+	assets := make(map[string]*total)
+	get := func(asset string) *total {
+		t, ok := assets[asset]
+		if !ok {
+			t = &total{
+				deposited: decimal.Zero,
+				withdrawn: decimal.Zero,
+				possible:  decimal.Zero,
+			}
+			assets[asset] = t
+		}
+		return t
+	}
+
+	for i := range deposits {
+		t := get(deposits[i].Asset)
+		t.deposited = t.deposited.Add(deposits[i].Amount)
+		t.depositsCount += deposits[i].Count
+	}
+	for i := range withdrawals {
+		t := get(withdrawals[i].Asset)
+		t.withdrawn = t.withdrawn.Add(withdrawals[i].Amount)
+		t.withdrawalsCount += withdrawals[i].Count
+	}
+	for i := range possible {
+		t := get(possible[i].Asset)
+		t.possible = t.possible.Add(possible[i].Amount)
+		t.possibleCount += possible[i].Count
+	}
+
+	result := make([]RollupAccountTotal, 0, len(assets))
+	for asset, t := range assets {
+		result = append(result, RollupAccountTotal{
+			Asset:                    asset,
+			Deposited:                t.deposited.String(),
+			DepositsCount:            t.depositsCount,
+			Withdrawn:                t.withdrawn.String(),
+			WithdrawalsCount:         t.withdrawalsCount,
+			PossiblyWithdrawn:        t.possible.String(),
+			PossibleWithdrawalsCount: t.possibleCount,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Asset < result[j].Asset
+	})
+	return result
+}
@@ -4,9 +4,11 @@
 package responses
 
 import (
+	"encoding/base64"
 	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 )
 
 type SeriesItem struct {
@@ -37,6 +39,97 @@ func NewRollupSeriesItem(item storage.SeriesItem) RollupSeriesItem {
 	}
 }
 
+type RollupComparison struct {
+	Timeframe string                   `example:"day"  json:"timeframe"`
+	Name      string                   `example:"size" json:"name"`
+	Time      []time.Time              `json:"time"    swaggertype:"array,string"`
+	Rollups   []RollupComparisonSeries `json:"rollups"`
+}
+
+type RollupComparisonSeries struct {
+	Hash   []byte   `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" json:"hash" swaggertype:"string"`
+	Values []string `json:"values"                                          swaggertype:"array,string"`
+}
+
+// NewRollupComparison - aligns series of rollups by time. Values of each rollup are in the same order as timestamps, missing ones are zero.
+func NewRollupComparison(timeframe, name string, rollups []storage.Rollup, points []storage.RollupSeriesPoint) RollupComparison {
+	result := RollupComparison{
+		Timeframe: timeframe,
+		Name:      name,
+		Time:      make([]time.Time, 0),
+		Rollups:   make([]RollupComparisonSeries, len(rollups)),
+	}
+
+	index := make(map[time.Time]int)
+	for i := range points {
+		if _, ok := index[points[i].Time]; ok {
+			continue
+		}
+		index[points[i].Time] = len(result.Time)
+		result.Time = append(result.Time, points[i].Time)
+	}
+
+	series := make(map[uint64]int, len(rollups))
+	for i := range rollups {
+		series[rollups[i].Id] = i
+		values := make([]string, len(result.Time))
+		for j := range values {
+			values[j] = "0"
+		}
+		result.Rollups[i] = RollupComparisonSeries{
+			Hash:   rollups[i].AstriaId,
+			Values: values,
+		}
+	}
+
+	for i := range points {
+		idx, ok := series[points[i].RollupId]
+		if !ok {
+			continue
+		}
+		result.Rollups[idx].Values[index[points[i].Time]] = points[i].Value
+	}
+	return result
+}
+
+type Distribution struct {
+	Count     int64             `example:"1000"   format:"integer" json:"count"`
+	Min       float64           `example:"12"     format:"number"  json:"min"`
+	Max       float64           `example:"120000" format:"number"  json:"max"`
+	Avg       float64           `example:"2500.5" format:"number"  json:"avg"`
+	P50       float64           `example:"1024"   format:"number"  json:"p50"`
+	P90       float64           `example:"20000"  format:"number"  json:"p90"`
+	P99       float64           `example:"100000" format:"number"  json:"p99"`
+	Histogram []HistogramBucket `json:"histogram"`
+}
+
+type HistogramBucket struct {
+	From  float64 `example:"12"   format:"number"  json:"from"`
+	To    float64 `example:"6011" format:"number"  json:"to"`
+	Count int64   `example:"800"  format:"integer" json:"count"`
+}
+
+func NewDistribution(distribution storage.Distribution) Distribution {
+	result := Distribution{
+		Count:     distribution.Count,
+		Min:       distribution.Min,
+		Max:       distribution.Max,
+		Avg:       distribution.Avg,
+		P50:       distribution.P50,
+		P90:       distribution.P90,
+		P99:       distribution.P99,
+		Histogram: make([]HistogramBucket, len(distribution.Histogram)),
+	}
+	for i := range distribution.Histogram {
+		result.Histogram[i] = HistogramBucket{
+			From:  distribution.Histogram[i].From,
+			To:    distribution.Histogram[i].To,
+			Count: distribution.Histogram[i].Count,
+		}
+	}
+	return result
+}
+
 type NetworkSummary struct {
 	DataSize     int64   `example:"1000000" format:"integer" json:"data_size"`
 	TxCount      int64   `example:"100"     format:"integer" json:"tx_count"`
@@ -117,6 +210,74 @@ func NewFeeSummary(summary storage.FeeSummary) FeeSummary {
 	}
 }
 
+type FeeActionSummary struct {
+	ActionType string `example:"rollup_data_submission" format:"string"  json:"action_type"`
+	Asset      string `example:"nria"                   format:"string"  json:"asset"`
+	Amount     string `example:"1000000"                format:"integer" json:"amount"`
+	FeeCount   int64  `example:"1000000"                format:"integer" json:"fee_count"`
+}
+
+func NewFeeActionSummary(summary storage.FeeActionSummary) FeeActionSummary {
+	return FeeActionSummary{
+		ActionType: summary.ActionType,
+		Asset:      summary.Asset,
+		Amount:     summary.Amount,
+		FeeCount:   summary.FeeCount,
+	}
+}
+
+type ActionTypeShare struct {
+	ActionType   string  `example:"rollup_data_submission" format:"string"  json:"action_type"`
+	Category     string  `example:"rollup_data"            format:"string"  json:"category"`
+	ActionsCount int64   `example:"1000000"                format:"integer" json:"actions_count"`
+	Share        float64 `example:"65.5"                   format:"number"  json:"share"`
+}
+
+func NewActionTypeShare(share storage.ActionTypeShare) ActionTypeShare {
+	return ActionTypeShare{
+		ActionType:   share.ActionType,
+		Category:     types.ActionType(share.ActionType).Category(),
+		ActionsCount: share.ActionsCount,
+		Share:        share.Share,
+	}
+}
+
+type FeePayer struct {
+	Asset    string `example:"nria"    format:"string"  json:"asset"`
+	Amount   string `example:"1000000" format:"integer" json:"amount"`
+	FeeCount int64  `example:"1000000" format:"integer" json:"fee_count"`
+
+	Payer *ShortAddress `json:"payer,omitempty"`
+}
+
+func NewFeePayer(payer storage.FeePayer) FeePayer {
+	return FeePayer{
+		Asset:    payer.Asset,
+		Amount:   payer.Amount,
+		FeeCount: payer.FeeCount,
+		Payer:    NewShortAddress(payer.Payer),
+	}
+}
+
+type RollupFee struct {
+	Rollup   string `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" format:"base64"  json:"rollup" swaggertype:"string"`
+	Asset    string `example:"nria"                                         format:"string"  json:"asset"`
+	Amount   string `example:"1000000"                                      format:"integer" json:"amount"`
+	FeeCount int64  `example:"1000000"                                      format:"integer" json:"fee_count"`
+}
+
+func NewRollupFee(fee storage.RollupFee) RollupFee {
+	response := RollupFee{
+		Asset:    fee.Asset,
+		Amount:   fee.Amount,
+		FeeCount: fee.FeeCount,
+	}
+	if fee.Rollup != nil {
+		response.Rollup = base64.StdEncoding.EncodeToString(fee.Rollup.AstriaId)
+	}
+	return response
+}
+
 type TokenTransferDistributionItem struct {
 	Asset          string `example:"nria"    format:"string"  json:"asset"`
 	Amount         string `example:"1000000" format:"integer" json:"amount"`
@@ -136,7 +297,7 @@ type Candle struct {
 	Close string    `example:"0.17632"                   format:"string"    json:"close"`
 	High  string    `example:"0.17632"                   format:"string"    json:"high"`
 	Low   string    `example:"0.17632"                   format:"string"    json:"low"`
-	Time  time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"  swaggertype:"string"`
+	Time  time.Time `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time" swaggertype:"string"`
 }
 
 func NewCandle(candle storage.Candle) Candle {
@@ -14,18 +14,20 @@ import (
 )
 
 type Tx struct {
-	Id           uint64         `example:"321"                                                              format:"int64"     json:"id"                  swaggertype:"integer"`
-	Height       pkgTypes.Level `example:"100"                                                              format:"int64"     json:"height"              swaggertype:"integer"`
-	Position     int64          `example:"11"                                                               format:"int64"     json:"position"            swaggertype:"integer"`
-	ActionsCount int64          `example:"1"                                                                format:"int64"     json:"actions_count"       swaggertype:"integer"`
-	Nonce        uint32         `example:"1"                                                                format:"int64"     json:"nonce"               swaggertype:"integer"`
-	Hash         string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"hash"                swaggertype:"string"`
-	Error        string         `example:"some error text"                                                  format:"string"    json:"error,omitempty"     swaggertype:"string"`
-	Codespace    string         `example:"sdk"                                                              format:"string"    json:"codespace,omitempty" swaggertype:"string"`
-	Signature    string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"string"    json:"signature"           swaggertype:"string"`
-	Time         time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"                swaggertype:"string"`
-	Status       types.Status   `example:"success"                                                          format:"string"    json:"status"              swaggertype:"string"`
-	ActionTypes  []string       `example:"rollup_data_submission,transfer"                                  format:"string"    json:"action_types"        swaggertype:"string"`
+	Id            uint64         `example:"321"                                                              format:"int64"     json:"id"                      swaggertype:"integer"`
+	Height        pkgTypes.Level `example:"100"                                                              format:"int64"     json:"height"                  swaggertype:"integer"`
+	Position      int64          `example:"11"                                                               format:"int64"     json:"position"                swaggertype:"integer"`
+	ActionsCount  int64          `example:"1"                                                                format:"int64"     json:"actions_count"           swaggertype:"integer"`
+	Nonce         uint32         `example:"1"                                                                format:"int64"     json:"nonce"                   swaggertype:"integer"`
+	Hash          string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"binary"    json:"hash"                    swaggertype:"string"`
+	Error         string         `example:"some error text"                                                  format:"string"    json:"error,omitempty"         swaggertype:"string"`
+	Codespace     string         `example:"sdk"                                                              format:"string"    json:"codespace,omitempty"     swaggertype:"string"`
+	Signature     string         `example:"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF" format:"string"    json:"signature"               swaggertype:"string"`
+	Time          time.Time      `example:"2023-07-04T03:10:57+00:00"                                        format:"date-time" json:"time"                    swaggertype:"string"`
+	Status        types.Status   `example:"success"                                                          format:"string"    json:"status"                  swaggertype:"string"`
+	ActionTypes   []string       `example:"rollup_data_submission,transfer"                                  format:"string"    json:"action_types"            swaggertype:"string"`
+	Confirmations *uint64        `example:"10"                                                               format:"int64"     json:"confirmations,omitempty" swaggertype:"integer"`
+	Finalized     bool           `example:"true"                                                             format:"boolean"   json:"finalized"               swaggertype:"boolean"`
 
 	Actions []Action      `json:"actions,omitempty"`
 	Fees    []TxFee       `json:"fees,omitempty"`
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+)
+
+type RollbackHandler struct {
+	rollbacks storage.IRollbackEvent
+}
+
+func NewRollbackHandler(rollbacks storage.IRollbackEvent) *RollbackHandler {
+	return &RollbackHandler{
+		rollbacks: rollbacks,
+	}
+}
+
+var _ Handler = (*RollbackHandler)(nil)
+
+func (handler *RollbackHandler) InitRoutes(srvr *echo.Group) {
+	srvr.GET("/rollbacks", handler.List)
+}
+
+type rollbacksRequest struct {
+	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (p *rollbacksRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// List godoc
+//
+//	@Summary		List rollback events
+//	@Description	List of rollbacks with removed heights and counts of removed entities. Data from the removed blocks should be invalidated by clients.
+//	@Tags			general
+//	@ID				list-rollbacks
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.RollbackEvent
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/rollbacks [get]
+func (handler *RollbackHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[rollbacksRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	events, err := handler.rollbacks.List(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.rollbacks)
+	}
+
+	response := make([]responses.RollbackEvent, len(events))
+	for i := range events {
+		response[i] = responses.NewRollbackEvent(*events[i])
+	}
+	return returnArray(c, response)
+}
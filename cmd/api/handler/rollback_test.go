@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// RollbackTestSuite -
+type RollbackTestSuite struct {
+	suite.Suite
+	rollbacks *mock.MockIRollbackEvent
+	echo      *echo.Echo
+	handler   *RollbackHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *RollbackTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.rollbacks = mock.NewMockIRollbackEvent(s.ctrl)
+	s.handler = NewRollbackHandler(s.rollbacks)
+}
+
+// TearDownSuite -
+func (s *RollbackTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteRollback_Run(t *testing.T) {
+	suite.Run(t, new(RollbackTestSuite))
+}
+
+func (s *RollbackTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollbacks")
+
+	s.rollbacks.EXPECT().
+		List(gomock.Any(), uint64(10), uint64(0), sdk.SortOrderDesc).
+		Return([]*storage.RollbackEvent{
+			{
+				Id:           1,
+				Time:         time.Now(),
+				FromHeight:   99,
+				ToHeight:     100,
+				Depth:        2,
+				OldHash:      []byte{0x01},
+				NewHash:      []byte{0x02},
+				TxCount:      3,
+				ActionsCount: 4,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var events []responses.RollbackEvent
+	err := json.NewDecoder(rec.Body).Decode(&events)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+
+	event := events[0]
+	s.Require().EqualValues(1, event.Id)
+	s.Require().EqualValues(99, event.FromHeight)
+	s.Require().EqualValues(100, event.ToHeight)
+	s.Require().EqualValues(2, event.Depth)
+	s.Require().Equal("01", event.OldHash)
+	s.Require().Equal("02", event.NewHash)
+	s.Require().EqualValues(3, event.TxCount)
+	s.Require().EqualValues(4, event.ActionsCount)
+}
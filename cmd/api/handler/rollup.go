@@ -17,7 +17,9 @@ import (
 )
 
 type RollupHandler struct {
+	cache         cache.ICache
 	constantCache *cache.ConstantsCache
+	finality      *cache.Finality
 	rollups       storage.IRollup
 	actions       storage.IAction
 	bridge        storage.IBridge
@@ -28,7 +30,9 @@ type RollupHandler struct {
 }
 
 func NewRollupHandler(
+	ttlCache cache.ICache,
 	constantCache *cache.ConstantsCache,
+	finality *cache.Finality,
 	rollups storage.IRollup,
 	actions storage.IAction,
 	bridge storage.IBridge,
@@ -38,7 +42,9 @@ func NewRollupHandler(
 	indexerName string,
 ) *RollupHandler {
 	return &RollupHandler{
+		cache:         ttlCache,
 		constantCache: constantCache,
+		finality:      finality,
 		rollups:       rollups,
 		actions:       actions,
 		bridge:        bridge,
@@ -57,7 +63,7 @@ func (handler *RollupHandler) InitRoutes(srvr *echo.Group) {
 		rollupsGroup.GET("", handler.List)
 		rollupsGroup.GET("/count", handler.Count)
 
-		rollupGroup := rollupsGroup.Group("/:hash")
+		rollupGroup := rollupsGroup.Group("/:hash", cache.NewRollupMiddlewareCache(handler.cache, "hash"))
 		{
 			rollupGroup.GET("", handler.Get)
 			rollupGroup.GET("/actions", handler.Actions)
@@ -65,6 +71,7 @@ func (handler *RollupHandler) InitRoutes(srvr *echo.Group) {
 			rollupGroup.GET("/addresses", handler.Addresses)
 			rollupGroup.GET("/bridges", handler.Bridges)
 			rollupGroup.GET("/deposits", handler.Deposits)
+			rollupGroup.GET("/account/:destination", handler.Account)
 		}
 	}
 }
@@ -121,6 +128,8 @@ type listRollupsRequest struct {
 	Offset    int    `query:"offset"  validate:"omitempty,min=0"`
 	Sort      string `query:"sort"    validate:"omitempty,oneof=asc desc"`
 	SortField string `query:"sort_by" validate:"omitempty,oneof=size id"`
+
+	Finalized bool `query:"finalized" validate:"omitempty"`
 }
 
 func (p *listRollupsRequest) SetDefault() {
@@ -142,6 +151,7 @@ func (p *listRollupsRequest) SetDefault() {
 //	@Param			offset		query	integer	false	"Offset"								mininum(1)
 //	@Param			sort		query	string	false	"Sort order"							Enums(asc, desc)
 //	@Param			sort_by		query	string	false	"Field using for sorting. Default: id"	Enums(id, size)
+//	@Param			finalized	query	boolean	false	"If true hide rollups first seen in not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Rollup
 //	@Failure		400	{object}	Error
@@ -160,6 +170,13 @@ func (handler *RollupHandler) List(c echo.Context) error {
 		SortOrder: pgSort(req.Sort),
 		SortField: req.SortField,
 	}
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Rollup{})
+		}
+		fltrs.MaxHeight = height
+	}
 	rollups, err := handler.rollups.ListExt(c.Request().Context(), fltrs)
 	if err != nil {
 		return handleError(c, err, handler.rollups)
@@ -189,6 +206,23 @@ func (p *getRollupList) SetDefault() {
 	}
 }
 
+type getRollupActions struct {
+	Hash      string `param:"hash"      validate:"required,base64url"`
+	Limit     int    `query:"limit"     validate:"omitempty,min=1,max=100"`
+	Offset    int    `query:"offset"    validate:"omitempty,min=0"`
+	Sort      string `query:"sort"      validate:"omitempty,oneof=asc desc"`
+	Finalized bool   `query:"finalized" validate:"omitempty"`
+}
+
+func (p *getRollupActions) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = asc
+	}
+}
+
 // Actions godoc
 //
 //	@Summary		Get rollup actions
@@ -199,13 +233,14 @@ func (p *getRollupList) SetDefault() {
 //	@Param			limit			query	integer					false	"Count of requested entities"			minimum(1)		maximum(100)
 //	@Param			offset			query	integer					false	"Offset"								minimum(1)
 //	@Param			sort			query	string					false	"Sort order"							Enums(asc, desc)
+//	@Param			finalized		query	boolean					false	"If true hide actions from not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.RollupAction
 //	@Failure		400	{object}	Error
 //	@Failure		500	{object}	Error
 //	@Router			/v1/rollup/{hash}/actions [get]
 func (handler *RollupHandler) Actions(c echo.Context) error {
-	req, err := bindAndValidate[getRollupList](c)
+	req, err := bindAndValidate[getRollupActions](c)
 	if err != nil {
 		return badRequestError(c, err)
 	}
@@ -221,7 +256,20 @@ func (handler *RollupHandler) Actions(c echo.Context) error {
 		return handleError(c, err, handler.rollups)
 	}
 
-	actions, err := handler.actions.ByRollup(c.Request().Context(), rollup.Id, req.Limit, req.Offset, pgSort(req.Sort))
+	fltrs := storage.RollupActionsFilter{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Sort:   pgSort(req.Sort),
+	}
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.RollupAction{})
+		}
+		fltrs.MaxHeight = height
+	}
+
+	actions, err := handler.actions.ByRollup(c.Request().Context(), rollup.Id, fltrs)
 	if err != nil {
 		return handleError(c, err, handler.rollups)
 	}
@@ -353,6 +401,7 @@ type allRollupActionsRequest struct {
 	RollupActions *bool       `query:"rollup_actions" validate:"omitempty"`
 	BridgeActions *bool       `query:"bridge_actions" validate:"omitempty"`
 	ActionTypes   StringArray `query:"action_types"   validate:"omitempty,dive,action_type"`
+	Finalized     bool        `query:"finalized"      validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
 	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
@@ -411,6 +460,7 @@ func (p *allRollupActionsRequest) toDbRequest() storage.RollupAndBridgeActionsFi
 //	@Param			action_types	query	types.ActionType	false	"Comma-separated action types list"
 //	@Param			from			query	integer				false	"Time from in unix timestamp"					mininum(1)
 //	@Param			to				query	integer				false	"Time to in unix timestamp"						mininum(1)
+//	@Param			finalized		query	boolean				false	"If true hide actions from not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Action
 //	@Failure		400	{object}	Error
@@ -433,7 +483,16 @@ func (handler *RollupHandler) AllActions(c echo.Context) error {
 		return handleError(c, err, handler.rollups)
 	}
 
-	actions, err := handler.actions.ByRollupAndBridge(c.Request().Context(), rollup.Id, req.toDbRequest())
+	fltrs := req.toDbRequest()
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Action{})
+		}
+		fltrs.MaxHeight = height
+	}
+
+	actions, err := handler.actions.ByRollupAndBridge(c.Request().Context(), rollup.Id, fltrs)
 	if err != nil {
 		return handleError(c, err, handler.rollups)
 	}
@@ -441,6 +500,8 @@ func (handler *RollupHandler) AllActions(c echo.Context) error {
 	response := make([]responses.Action, len(actions))
 	for i := range actions {
 		response[i] = responses.NewActionWithTx(actions[i])
+		response[i].SetFinality(handler.finality)
+		handler.finality.SkipUnsafe(c, response[i].Height)
 	}
 
 	return returnArray(c, response)
@@ -504,3 +565,107 @@ func (handler *RollupHandler) Deposits(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type getRollupAccount struct {
+	Hash        string `param:"hash"        validate:"required,base64url"`
+	Destination string `param:"destination" validate:"required,max=256"`
+	Limit       int    `query:"limit"       validate:"omitempty,min=1,max=100"`
+	Offset      int    `query:"offset"      validate:"omitempty,min=0"`
+	Sort        string `query:"sort"        validate:"omitempty,oneof=asc desc"`
+}
+
+func (p *getRollupAccount) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+// Account godoc
+//
+//	@Summary		Get deposits and withdrawals of rollup-side address
+//	@Description	Get deposits credited to the rollup-side address, withdrawals related to it and totals per asset. Address is compared case-insensitively.
+//	@Description	`withdrawals` are ICS20 withdrawals from the rollup bridge which memo has the address as rollup return address.
+//	@Description	`possible_withdrawals` are unlocks from the rollup bridge to the sequencer address which made deposit to the address: bridge unlock doesn't contain rollup-side sender, so the link is not verified. They are counted in totals separately.
+//	@Tags			rollup
+//	@ID				get-rollup-account
+//	@Param			hash		path	string	true	"Base64Url encoded rollup id"
+//	@Param			destination	path	string	true	"Rollup-side address"
+//	@Param			limit		query	integer	false	"Count of requested deposits and withdrawals"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"										mininum(1)
+//	@Param			sort		query	string	false	"Sort order"									Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{object}	responses.RollupAccount
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/rollup/{hash}/account/{destination} [get]
+func (handler *RollupHandler) Account(c echo.Context) error {
+	req, err := bindAndValidate[getRollupAccount](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	hash, err := base64.URLEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	rollup, err := handler.rollups.ByHash(ctx, hash)
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+
+	deposits, err := handler.deposits.ByDestination(ctx, rollup.Id, req.Destination, req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+	depositTotals, err := handler.deposits.TotalsByDestination(ctx, rollup.Id, req.Destination)
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+
+	withdrawals, err := handler.actions.WithdrawalsByDestination(ctx, rollup.Id, req.Destination, true, req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+	withdrawalTotals, err := handler.actions.WithdrawalTotalsByDestination(ctx, rollup.Id, req.Destination, true)
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+
+	possible, err := handler.actions.WithdrawalsByDestination(ctx, rollup.Id, req.Destination, false, req.Limit, req.Offset, pgSort(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+	possibleTotals, err := handler.actions.WithdrawalTotalsByDestination(ctx, rollup.Id, req.Destination, false)
+	if err != nil {
+		return handleError(c, err, handler.rollups)
+	}
+
+	response := responses.RollupAccount{
+		Destination:         req.Destination,
+		Deposits:            make([]responses.Deposit, len(deposits)),
+		Withdrawals:         make([]responses.Action, len(withdrawals)),
+		PossibleWithdrawals: make([]responses.Action, len(possible)),
+		Totals:              responses.NewRollupAccountTotals(depositTotals, withdrawalTotals, possibleTotals),
+	}
+	for i := range deposits {
+		response.Deposits[i] = responses.NewDeposit(deposits[i])
+		handler.finality.SkipUnsafe(c, response.Deposits[i].Height)
+	}
+	for i := range withdrawals {
+		response.Withdrawals[i] = responses.NewActionWithTx(withdrawals[i])
+		response.Withdrawals[i].SetFinality(handler.finality)
+		handler.finality.SkipUnsafe(c, response.Withdrawals[i].Height)
+	}
+	for i := range possible {
+		response.PossibleWithdrawals[i] = responses.NewActionWithTx(possible[i])
+		response.PossibleWithdrawals[i].SetFinality(handler.finality)
+		handler.finality.SkipUnsafe(c, response.PossibleWithdrawals[i].Height)
+	}
+	return c.JSON(http.StatusOK, response)
+}
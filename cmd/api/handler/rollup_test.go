@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
@@ -51,7 +52,7 @@ func (s *RollupTestSuite) SetupSuite() {
 	s.app = mock.NewMockIApp(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
 	cc := cache.NewConstantsCache(nil)
-	s.handler = NewRollupHandler(cc, s.rollups, s.actions, s.bridge, s.deposits, s.app, s.state, testIndexerName)
+	s.handler = NewRollupHandler(nil, cc, newTestFinality(), s.rollups, s.actions, s.bridge, s.deposits, s.app, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -185,6 +186,35 @@ func (s *RollupTestSuite) TestList() {
 	s.Require().Equal(testRollup.AstriaId, rollup.AstriaId)
 }
 
+func (s *RollupTestSuite) TestListFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup")
+
+	s.rollups.EXPECT().
+		ListExt(gomock.Any(), storage.RollupListFilter{
+			Limit:     10,
+			SortOrder: sdk.SortOrderDesc,
+			MaxHeight: 100,
+		}).
+		Return([]storage.Rollup{
+			testRollup,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var rollups []responses.Rollup
+	err := json.NewDecoder(rec.Body).Decode(&rollups)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 1)
+}
+
 func (s *RollupTestSuite) TestActions() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -204,7 +234,10 @@ func (s *RollupTestSuite) TestActions() {
 		Times(1)
 
 	s.actions.EXPECT().
-		ByRollup(gomock.Any(), uint64(1), 10, 0, sdk.SortOrderDesc).
+		ByRollup(gomock.Any(), uint64(1), storage.RollupActionsFilter{
+			Limit: 10,
+			Sort:  sdk.SortOrderDesc,
+		}).
 		Return([]storage.RollupAction{
 			{
 				RollupId: 1,
@@ -351,6 +384,71 @@ func (s *RollupTestSuite) TestBridges() {
 	s.Require().Equal("nria", bridge.FeeAsset)
 }
 
+func (s *RollupTestSuite) TestActionsFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/actions")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		ByRollup(gomock.Any(), uint64(1), storage.RollupActionsFilter{
+			Limit:     10,
+			Sort:      sdk.SortOrderAsc,
+			MaxHeight: 100,
+		}).
+		Return([]storage.RollupAction{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Actions(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var actions []responses.RollupAction
+	err := json.NewDecoder(rec.Body).Decode(&actions)
+	s.Require().NoError(err)
+	s.Require().Len(actions, 0)
+}
+
+func (s *RollupTestSuite) TestAllActionsFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/all_actions")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		ByRollupAndBridge(gomock.Any(), uint64(1), storage.RollupAndBridgeActionsFilter{
+			Limit:         10,
+			Sort:          sdk.SortOrderAsc,
+			RollupActions: true,
+			BridgeActions: true,
+			MaxHeight:     100,
+		}).
+		Return([]storage.ActionWithTx{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.AllActions(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
 func (s *RollupTestSuite) TestAllActions() {
 	q := make(url.Values)
 	q.Set("limit", "10")
@@ -451,3 +549,153 @@ func (s *RollupTestSuite) TestDeposits() {
 	s.Require().NoError(err)
 	s.Require().Len(deposits, 1)
 }
+
+func (s *RollupTestSuite) TestAccount() {
+	q := make(url.Values)
+	q.Set("limit", "10")
+	q.Set("offset", "0")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/account/:destination")
+	c.SetParamNames("hash", "destination")
+	c.SetParamValues(testRollupURLHash, "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA")
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+
+	s.deposits.EXPECT().
+		ByDestination(gomock.Any(), uint64(1), "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA", 10, 0, sdk.SortOrderDesc).
+		Return([]storage.Deposit{
+			{
+				TxId:                    testTx.Id,
+				Time:                    testTime,
+				Height:                  1000,
+				ActionId:                1,
+				Amount:                  decimal.RequireFromString("1000"),
+				Asset:                   currency.DefaultCurrency,
+				BridgeId:                1,
+				DestinationChainAddress: "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA",
+				Tx:                      &testTx,
+				Bridge: &storage.Bridge{
+					Address: &testAddress,
+				},
+			},
+		}, nil).
+		Times(1)
+
+	s.deposits.EXPECT().
+		TotalsByDestination(gomock.Any(), uint64(1), "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA").
+		Return([]storage.AssetTotal{
+			{
+				Asset:  currency.DefaultCurrency,
+				Amount: decimal.RequireFromString("1000"),
+				Count:  1,
+			},
+		}, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		WithdrawalsByDestination(gomock.Any(), uint64(1), "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA", true, 10, 0, sdk.SortOrderDesc).
+		Return([]storage.ActionWithTx{
+			{
+				Action: storage.Action{
+					Id:       3,
+					Height:   1002,
+					Time:     testTime,
+					Position: 0,
+					Type:     types.ActionTypeIcs20Withdrawal,
+					TxId:     testTx.Id,
+					Data: map[string]any{
+						"amount": "5",
+						"denom":  "transfer/channel-0/utia",
+					},
+				},
+				Tx: &testTx,
+			},
+		}, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		WithdrawalTotalsByDestination(gomock.Any(), uint64(1), "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA", true).
+		Return([]storage.AssetTotal{
+			{
+				Asset:  "transfer/channel-0/utia",
+				Amount: decimal.RequireFromString("5"),
+				Count:  1,
+			},
+		}, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		WithdrawalsByDestination(gomock.Any(), uint64(1), "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA", false, 10, 0, sdk.SortOrderDesc).
+		Return([]storage.ActionWithTx{
+			{
+				Action: storage.Action{
+					Id:       2,
+					Height:   1001,
+					Time:     testTime,
+					Position: 0,
+					Type:     types.ActionTypeBridgeUnlock,
+					TxId:     testTx.Id,
+					Data: map[string]any{
+						"amount": "100",
+					},
+				},
+				Tx: &testTx,
+			},
+		}, nil).
+		Times(1)
+
+	s.actions.EXPECT().
+		WithdrawalTotalsByDestination(gomock.Any(), uint64(1), "0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA", false).
+		Return([]storage.AssetTotal{
+			{
+				Asset:  currency.DefaultCurrency,
+				Amount: decimal.RequireFromString("100"),
+				Count:  1,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Account(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var account responses.RollupAccount
+	err := json.NewDecoder(rec.Body).Decode(&account)
+	s.Require().NoError(err)
+	s.Require().Equal("0x8bAec8896775DDa83796eda3e7E67217b5E3C5dA", account.Destination)
+	s.Require().Len(account.Deposits, 1)
+	s.Require().Len(account.Withdrawals, 1)
+	s.Require().EqualValues(3, account.Withdrawals[0].Id)
+	s.Require().Len(account.PossibleWithdrawals, 1)
+	s.Require().EqualValues(2, account.PossibleWithdrawals[0].Id)
+
+	s.Require().Len(account.Totals, 2)
+	s.Require().Equal(currency.DefaultCurrency, account.Totals[0].Asset)
+	s.Require().Equal("1000", account.Totals[0].Deposited)
+	s.Require().EqualValues(1, account.Totals[0].DepositsCount)
+	s.Require().Equal("0", account.Totals[0].Withdrawn)
+	s.Require().EqualValues(0, account.Totals[0].WithdrawalsCount)
+	s.Require().Equal("100", account.Totals[0].PossiblyWithdrawn)
+	s.Require().EqualValues(1, account.Totals[0].PossibleWithdrawalsCount)
+	s.Require().Equal("transfer/channel-0/utia", account.Totals[1].Asset)
+	s.Require().Equal("0", account.Totals[1].Deposited)
+	s.Require().Equal("5", account.Totals[1].Withdrawn)
+	s.Require().Equal("0", account.Totals[1].PossiblyWithdrawn)
+}
+
+func (s *RollupTestSuite) TestAccountInvalidDestination() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/rollup/:hash/account/:destination")
+	c.SetParamNames("hash", "destination")
+	c.SetParamValues(testRollupURLHash, strings.Repeat("a", 257))
+
+	s.Require().NoError(s.handler.Account(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
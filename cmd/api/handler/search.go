@@ -14,6 +14,7 @@ import (
 
 type SearchHandler struct {
 	constantCache *cache.ConstantsCache
+	finality      *cache.Finality
 	search        storage.ISearch
 	address       storage.IAddress
 	blocks        storage.IBlock
@@ -23,10 +24,12 @@ type SearchHandler struct {
 	validators    storage.IValidator
 	celestials    celestials.ICelestial
 	app           storage.IApp
+	assets        storage.IAsset
 }
 
 func NewSearchHandler(
 	constantCache *cache.ConstantsCache,
+	finality *cache.Finality,
 	search storage.ISearch,
 	address storage.IAddress,
 	blocks storage.IBlock,
@@ -36,9 +39,11 @@ func NewSearchHandler(
 	validators storage.IValidator,
 	celestials celestials.ICelestial,
 	app storage.IApp,
+	assets storage.IAsset,
 ) *SearchHandler {
 	return &SearchHandler{
 		constantCache: constantCache,
+		finality:      finality,
 		search:        search,
 		address:       address,
 		blocks:        blocks,
@@ -48,6 +53,7 @@ func NewSearchHandler(
 		validators:    validators,
 		celestials:    celestials,
 		app:           app,
+		assets:        assets,
 	}
 }
 
@@ -64,6 +70,8 @@ type searchRequest struct {
 // Search godoc
 //
 //	@Summary				Search by hash or text
+//	@Description			Search by text, block height, block, transaction and rollup hash or its hex prefix, base64 or base64url rollup id, address or its prefix, astriacompat address, IBC denom hash with `ibc/` prefix and rollup-side deposit destination address.
+//	@Description			Result types: `block`, `tx`, `rollup`, `address`, `validator`, `bridge`, `app`, `asset` and `rollup_account`. `rollup_account` value is a destination chain address and body is the rollup which received deposits to it. `asset` value is a denom of the found IBC asset and body is its statistics.
 //	@Tags					search
 //	@ID						search
 //	@Param					query	query	string	true	"Search string"
@@ -93,13 +101,17 @@ func (s *SearchHandler) Search(c echo.Context) error {
 			if err != nil {
 				return handleError(c, err, s.address)
 			}
-			body = responses.NewBlock(*block)
+			blockResponse := responses.NewBlock(*block)
+			blockResponse.SetFinality(s.finality)
+			body = blockResponse
 		case "tx":
 			tx, err := s.txs.GetByID(c.Request().Context(), results[i].Id)
 			if err != nil {
 				return handleError(c, err, s.address)
 			}
-			body = responses.NewTx(*tx)
+			txResponse := responses.NewTx(*tx)
+			txResponse.SetFinality(s.finality)
+			body = txResponse
 		case "rollup":
 			rollup, err := s.rollups.GetByID(c.Request().Context(), results[i].Id)
 			if err != nil {
@@ -126,12 +138,24 @@ func (s *SearchHandler) Search(c echo.Context) error {
 				return handleError(c, err, s.address)
 			}
 			body = responses.NewBridge(bridge)
+		case "rollup_account":
+			rollup, err := s.rollups.GetByID(c.Request().Context(), results[i].Id)
+			if err != nil {
+				return handleError(c, err, s.address)
+			}
+			body = responses.NewRollup(rollup)
 		case "app":
 			app, err := s.app.GetByID(c.Request().Context(), results[i].Id)
 			if err != nil {
 				return handleError(c, err, s.address)
 			}
 			body = responses.NewApp(*app)
+		case "asset":
+			asset, err := s.assets.ByDenom(c.Request().Context(), results[i].Value)
+			if err != nil {
+				return handleError(c, err, s.address)
+			}
+			body = responses.NewAsset(asset)
 		case "celestial":
 			address, err := s.address.GetByID(c.Request().Context(), results[i].Id)
 			if err != nil {
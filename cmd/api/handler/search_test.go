@@ -17,6 +17,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
 	celestialMock "github.com/celenium-io/celestial-module/pkg/storage/mock"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -33,6 +34,7 @@ type SearchTestSuite struct {
 	bridges    *mock.MockIBridge
 	celestials *celestialMock.MockICelestial
 	app        *mock.MockIApp
+	assets     *mock.MockIAsset
 	echo       *echo.Echo
 	handler    *SearchHandler
 	ctrl       *gomock.Controller
@@ -52,8 +54,9 @@ func (s *SearchTestSuite) SetupSuite() {
 	s.bridges = mock.NewMockIBridge(s.ctrl)
 	s.celestials = celestialMock.NewMockICelestial(s.ctrl)
 	s.app = mock.NewMockIApp(s.ctrl)
+	s.assets = mock.NewMockIAsset(s.ctrl)
 	cc := cache.NewConstantsCache(nil)
-	s.handler = NewSearchHandler(cc, s.search, s.address, s.blocks, s.txs, s.rollups, s.bridges, s.validators, s.celestials, s.app)
+	s.handler = NewSearchHandler(cc, newTestFinality(), s.search, s.address, s.blocks, s.txs, s.rollups, s.bridges, s.validators, s.celestials, s.app, s.assets)
 }
 
 // TearDownSuite -
@@ -391,3 +394,58 @@ func (s *SearchTestSuite) TestSearchCelestial() {
 	s.Require().Equal("celestial 1", result.Value)
 	s.Require().NotNil(result.Body)
 }
+
+func (s *SearchTestSuite) TestSearchRollupAccount() {
+	const destination = "0x5cF0f7a4C9D7D4a1cB2EC6Bd3dE4Bc2e1Bf1c1a2"
+	q := make(url.Values)
+	q.Add("query", destination)
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/search")
+
+	s.search.EXPECT().
+		Search(gomock.Any(), destination).
+		Return([]storage.SearchResult{
+			{
+				Type:  "rollup_account",
+				Value: destination,
+				Id:    testRollup.Id,
+			},
+			{
+				Type:  "asset",
+				Value: "transfer/channel-0/utia",
+			},
+		}, nil).
+		Times(1)
+
+	s.rollups.EXPECT().
+		GetByID(gomock.Any(), testRollup.Id).
+		Return(&testRollup, nil).
+		Times(1)
+
+	s.assets.EXPECT().
+		ByDenom(gomock.Any(), "transfer/channel-0/utia").
+		Return(storage.Asset{
+			Asset:  "transfer/channel-0/utia",
+			Supply: decimal.NewFromInt(1000),
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Search(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var results []responses.SearchResult
+	err := json.NewDecoder(rec.Body).Decode(&results)
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+
+	s.Require().Equal("rollup_account", results[0].Type)
+	s.Require().Equal(destination, results[0].Value)
+	s.Require().NotNil(results[0].Body)
+
+	s.Require().Equal("asset", results[1].Type)
+	s.Require().Equal("transfer/channel-0/utia", results[1].Value)
+	s.Require().NotNil(results[1].Body)
+}
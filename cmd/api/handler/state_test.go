@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
@@ -33,8 +34,16 @@ var (
 		TotalBridges:  2,
 		TotalRollups:  30,
 	}
+	testConfirmationDepth uint64 = 10
 )
 
+// newTestFinality - returns finality with head which makes height of test data finalized
+func newTestFinality() *cache.Finality {
+	finality := cache.NewFinality(nil, testConfirmationDepth)
+	finality.SetHead(testState.LastHeight + 10)
+	return finality
+}
+
 // StateTestSuite -
 type StateTestSuite struct {
 	suite.Suite
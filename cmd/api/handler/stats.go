@@ -6,27 +6,32 @@ package handler
 import (
 	"encoding/base64"
 	"net/http"
+	"slices"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 type StatsHandler struct {
 	repo    storage.IStats
 	rollups storage.IRollup
+	apps    storage.IApp
 	cache   cache.ICache
 }
 
 func NewStatsHandler(
 	repo storage.IStats,
 	rollups storage.IRollup,
+	apps storage.IApp,
 	cache cache.ICache,
 ) *StatsHandler {
 	return &StatsHandler{
 		repo:    repo,
 		rollups: rollups,
+		apps:    apps,
 		cache:   cache,
 	}
 }
@@ -35,22 +40,37 @@ var _ Handler = (*StatsHandler)(nil)
 
 func (sh *StatsHandler) InitRoutes(srvr *echo.Group) {
 	middlewareCache := cache.NewStatMiddlewareCache(sh.cache)
+	headCache := cache.NewHeadMiddlewareCache(sh.cache)
 
 	stats := srvr.Group("/stats")
 	{
-		stats.GET("/summary", sh.Summary)
-		stats.GET("/summary/:timeframe", sh.SummaryTimeframe)
-		stats.GET("/summary/active_addresses_count", sh.ActiveAddressesCount)
+		stats.GET("/summary", sh.Summary, headCache)
+		stats.GET("/summary/:timeframe", sh.SummaryTimeframe, headCache)
+		stats.GET("/summary/active_addresses_count", sh.ActiveAddressesCount, headCache)
 		stats.GET("/series/:name/:timeframe", sh.Series, middlewareCache)
+		stats.GET("/distribution/:name", sh.Distribution, middlewareCache)
+
+		actions := stats.Group("/actions")
+		{
+			actions.GET("/series/:type/:timeframe", sh.ActionSeries, middlewareCache)
+			actions.GET("/breakdown", sh.ActionBreakdown, middlewareCache)
+		}
 
 		rollup := stats.Group("/rollup")
 		{
 			rollup.GET("/series/:hash/:name/:timeframe", sh.RollupSeries, middlewareCache)
+			rollup.GET("/compare", sh.CompareRollups, middlewareCache)
 		}
 
 		fee := stats.Group("/fee")
 		{
 			fee.GET("/summary", sh.FeeSummary, middlewareCache)
+			fee.GET("/actions", sh.FeeByActionType, middlewareCache)
+			fee.GET("/series/:timeframe", sh.FeeSeries, middlewareCache)
+			fee.GET("/payers", sh.TopFeePayers, middlewareCache)
+			fee.GET("/rollups", sh.RollupFees, middlewareCache)
+			fee.GET("/rollup/:hash", sh.RollupFee, middlewareCache)
+			fee.GET("/app/:slug", sh.AppFee, middlewareCache)
 		}
 
 		token := stats.Group("/token")
@@ -108,20 +128,29 @@ func (sh *StatsHandler) SummaryTimeframe(c echo.Context) error {
 }
 
 type seriesRequest struct {
-	Timeframe  string `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day month"`
+	Timeframe  string `example:"hour"       param:"timeframe" swaggertype:"string"  validate:"required,series_timeframe"`
 	SeriesName string `example:"tps"        param:"name"      swaggertype:"string"  validate:"required,oneof=data_size tps bps rbps supply_change block_time tx_count bytes_in_block"`
 	From       int64  `example:"1692892095" query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
 	To         int64  `example:"1692892095" query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
 }
 
+type addressSeriesRequest struct {
+	Timeframe  string `example:"day"              param:"timeframe" swaggertype:"string"  validate:"required,oneof=day week month"`
+	SeriesName string `example:"active_addresses" param:"name"      swaggertype:"string"  validate:"required,oneof=active_addresses new_addresses returning_addresses churned_addresses"`
+	From       int64  `example:"1692892095"       query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To         int64  `example:"1692892095"       query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
 // Series godoc
 //
 //	@Summary		Get histogram with precomputed stats
-//	@Description	Get histogram with precomputed stats by series name and timeframe
+//	@Description	Get histogram with precomputed stats by series name and timeframe.
+//	@Description	Besides hour, day, week and month timeframe may be a custom bucket width in minutes, hours, days or weeks from 1m to 4w, e.g. 5m, 15m, 4h or 1w. Series contains at most 100 buckets.
+//	@Description	Address series: `active_addresses` - count of distinct signers, `new_addresses` - count of addresses first seen in the period, `returning_addresses` - signers active in the period and in the previous one, `churned_addresses` - signers active in the previous period and inactive in the period, the current period is not included until it is closed. Address series support only day, week and month timeframes.
 //	@Tags			stats
 //	@ID				stats-series
-//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
-//	@Param			name		path	string	true	"Series name"					Enums(data_size, tps, bps, rbps, supply_change, block_time, tx_count, bytes_in_block)
+//	@Param			timeframe	path	string	true	"Timeframe: hour, day, week, month or custom bucket like 15m"
+//	@Param			name		path	string	true	"Series name"					Enums(data_size, tps, bps, rbps, supply_change, block_time, tx_count, bytes_in_block, active_addresses, new_addresses, returning_addresses, churned_addresses)
 //	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
 //	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
 //	@Produce		json
@@ -130,6 +159,10 @@ type seriesRequest struct {
 //	@Failure		500	{object}	Error
 //	@Router			/v1/stats/series/{name}/{timeframe} [get]
 func (sh *StatsHandler) Series(c echo.Context) error {
+	if storage.IsAddressSeries(c.Param("name")) {
+		return sh.addressSeries(c)
+	}
+
 	req, err := bindAndValidate[seriesRequest](c)
 	if err != nil {
 		return badRequestError(c, err)
@@ -152,9 +185,111 @@ func (sh *StatsHandler) Series(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+func (sh *StatsHandler) addressSeries(c echo.Context) error {
+	req, err := bindAndValidate[addressSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	histogram, err := sh.repo.AddressSeries(
+		c.Request().Context(),
+		storage.Timeframe(req.Timeframe),
+		req.SeriesName,
+		storage.NewSeriesRequest(req.From, req.To),
+	)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	response := make([]responses.SeriesItem, len(histogram))
+	for i := range histogram {
+		response[i] = responses.NewSeriesItem(histogram[i])
+	}
+	return returnArray(c, response)
+}
+
+type actionSeriesRequest struct {
+	Timeframe  string `example:"hour"                   param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day month"`
+	ActionType string `example:"rollup_data_submission" param:"type"      swaggertype:"string"  validate:"required,action_type"`
+	From       int64  `example:"1692892095"             query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To         int64  `example:"1692892095"             query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// ActionSeries godoc
+//
+//	@Summary		Get histogram of actions count by action type
+//	@Description	Get histogram of actions count of the action type by timeframe
+//	@Tags			stats
+//	@ID				stats-actions-series
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
+//	@Param			type		path	string	true	"Action type"
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.SeriesItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/actions/series/{type}/{timeframe} [get]
+func (sh *StatsHandler) ActionSeries(c echo.Context) error {
+	req, err := bindAndValidate[actionSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	histogram, err := sh.repo.ActionSeries(
+		c.Request().Context(),
+		storage.Timeframe(req.Timeframe),
+		req.ActionType,
+		storage.NewSeriesRequest(req.From, req.To),
+	)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	response := make([]responses.SeriesItem, len(histogram))
+	for i := range histogram {
+		response[i] = responses.NewSeriesItem(histogram[i])
+	}
+	return returnArray(c, response)
+}
+
+type actionBreakdownRequest struct {
+	Timeframe string `query:"timeframe" validate:"omitempty,oneof=day week month"`
+}
+
+// ActionBreakdown godoc
+//
+//	@Summary		Get share of each action type
+//	@Description	Get actions count and share in percents of each action type. Every action type has category: rollup_data, transfer, bridge, ibc or admin. If timeframe is passed, actions of the last day, week or month are counted.
+//	@Tags			stats
+//	@ID				stats-actions-breakdown
+//	@Param			timeframe	query	string	false	"Timeframe"	Enums(day, week, month)
+//	@Produce		json
+//	@Success		200	{array}		responses.ActionTypeShare
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/actions/breakdown [get]
+func (sh *StatsHandler) ActionBreakdown(c echo.Context) error {
+	req, err := bindAndValidate[actionBreakdownRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	shares, err := sh.repo.ActionBreakdown(c.Request().Context(), storage.Timeframe(req.Timeframe))
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	response := make([]responses.ActionTypeShare, len(shares))
+	for i := range shares {
+		response[i] = responses.NewActionTypeShare(shares[i])
+	}
+	return returnArray(c, response)
+}
+
 type rollupSeriesRequest struct {
 	Hash       string `example:"O0Ia+lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" param:"hash"      swaggertype:"string"  validate:"required,base64url"`
-	Timeframe  string `example:"hour"                                         param:"timeframe" swaggertype:"string"  validate:"required,oneof=hour day month"`
+	Timeframe  string `example:"hour"                                         param:"timeframe" swaggertype:"string"  validate:"required,series_timeframe"`
 	SeriesName string `example:"size"                                         param:"name"      swaggertype:"string"  validate:"required,oneof=size avg_size min_size max_size actions_count"`
 	From       int64  `example:"1692892095"                                   query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
 	To         int64  `example:"1692892095"                                   query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
@@ -163,11 +298,12 @@ type rollupSeriesRequest struct {
 // RollupSeries godoc
 //
 //	@Summary		Get histogram with precomputed rollup stats
-//	@Description	Get histogram with precomputed rollup by series name and timeframe
+//	@Description	Get histogram with precomputed rollup by series name and timeframe.
+//	@Description	Besides hour, day and month timeframe may be a custom bucket width in minutes, hours, days or weeks from 1m to 4w, e.g. 5m, 15m, 4h or 1w. Series contains at most 100 buckets.
 //	@Tags			stats
 //	@ID				stats-rollup-series
 //	@Param			hash		path	string	true	"Base64Url encoded rollup id"
-//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
+//	@Param			timeframe	path	string	true	"Timeframe: hour, day, month or custom bucket like 15m"
 //	@Param			name		path	string	true	"Series name"					Enums(size, avg_size, min_size, max_size, actions_count)
 //	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
 //	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
@@ -210,6 +346,138 @@ func (sh *StatsHandler) RollupSeries(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+type compareRollupsRequest struct {
+	Rollups    StringArray `example:"O0Ia-lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" query:"rollups"   swaggertype:"string"  validate:"required,min=1,max=10,dive,base64url"`
+	Timeframe  string      `example:"day"                                          query:"timeframe" swaggertype:"string"  validate:"required,series_timeframe"`
+	SeriesName string      `example:"size"                                         query:"name"      swaggertype:"string"  validate:"required,oneof=size avg_size min_size max_size actions_count"`
+	From       int64       `example:"1692892095"                                   query:"from"      swaggertype:"integer" validate:"omitempty,min=1"`
+	To         int64       `example:"1692892095"                                   query:"to"        swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// CompareRollups godoc
+//
+//	@Summary		Compare series of several rollups
+//	@Description	Get series of several rollups aligned by time. Missing buckets of rollup are filled with zero.
+//	@Description	Timeframe may be hour, day, month or a custom bucket width from 1m to 4w, e.g. 5m, 15m, 4h or 1w.
+//	@Tags			stats
+//	@ID				stats-rollup-compare
+//	@Param			rollups		query	string	true	"Comma-separated list of base64url encoded rollup ids (up to 10)"
+//	@Param			name		query	string	true	"Series name"					Enums(size, avg_size, min_size, max_size, actions_count)
+//	@Param			timeframe	query	string	true	"Timeframe: hour, day, month or custom bucket like 15m"
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.RollupComparison
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/rollup/compare [get]
+func (sh *StatsHandler) CompareRollups(c echo.Context) error {
+	req, err := bindAndValidate[compareRollupsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	rollups := make([]storage.Rollup, 0, len(req.Rollups))
+	ids := make([]uint64, 0, len(req.Rollups))
+	for i := range req.Rollups {
+		hash, err := base64.URLEncoding.DecodeString(req.Rollups[i])
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		rollup, err := sh.rollups.ByHash(ctx, hash)
+		if err != nil {
+			return handleError(c, err, sh.rollups)
+		}
+		if slices.Contains(ids, rollup.Id) {
+			continue
+		}
+		rollups = append(rollups, rollup)
+		ids = append(ids, rollup.Id)
+	}
+
+	points, err := sh.repo.CompareRollupSeries(
+		ctx,
+		ids,
+		storage.Timeframe(req.Timeframe),
+		req.SeriesName,
+		storage.NewSeriesRequest(req.From, req.To),
+	)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewRollupComparison(req.Timeframe, req.SeriesName, rollups, points))
+}
+
+type distributionRequest struct {
+	Name      string `example:"rollup_size"                                  param:"name"      swaggertype:"string"  validate:"required,oneof=rollup_size block_time tx_size fee"`
+	Timeframe string `example:"day"                                          query:"timeframe" swaggertype:"string"  validate:"omitempty,oneof=day week month"`
+	Rollup    string `example:"O0Ia-lPYYMf3iFfxBaWXCSdlhphc6d4ZoBXINov6Tjc=" query:"rollup"    swaggertype:"string"  validate:"omitempty,base64url"`
+	Asset     string `example:"nria"                                         query:"asset"     swaggertype:"string"  validate:"omitempty"`
+	Buckets   int    `example:"20"                                           query:"buckets"   swaggertype:"integer" validate:"omitempty,min=1,max=100"`
+}
+
+// Distribution godoc
+//
+//	@Summary		Get distribution of values
+//	@Description	Get percentiles and histogram of values over the last day, week or month.
+//	@Description	`rollup_size` - size of rollup data submissions in bytes, `block_time` - block time in milliseconds, `tx_size` - average transaction size of a block in bytes, `fee` - fee amounts in the asset (nria by default).
+//	@Description	`rollup_size` is computed from hourly aggregates: its percentiles and histogram are approximate and the window is aligned to hours.
+//	@Description	Rollup filter is supported only by `rollup_size` and `fee`.
+//	@Tags			stats
+//	@ID				stats-distribution
+//	@Param			name		path	string	true	"Distribution name"				Enums(rollup_size, block_time, tx_size, fee)
+//	@Param			timeframe	query	string	false	"Timeframe"						Enums(day, week, month)
+//	@Param			rollup		query	string	false	"Base64Url encoded rollup id"
+//	@Param			asset		query	string	false	"Fee asset"
+//	@Param			buckets		query	integer	false	"Count of histogram buckets"	mininum(1)	maximum(100)
+//	@Produce		json
+//	@Success		200	{object}	responses.Distribution
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/distribution/{name} [get]
+func (sh *StatsHandler) Distribution(c echo.Context) error {
+	req, err := bindAndValidate[distributionRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	fltrs := storage.DistributionFilter{
+		Timeframe: storage.Timeframe(req.Timeframe),
+		Buckets:   req.Buckets,
+	}
+
+	if req.Asset != "" {
+		if req.Name != storage.DistributionFee {
+			return badRequestError(c, errors.Errorf("distribution %s can not be filtered by asset", req.Name))
+		}
+		fltrs.Asset = req.Asset
+	}
+
+	if req.Rollup != "" {
+		if req.Name != storage.DistributionRollupSize && req.Name != storage.DistributionFee {
+			return badRequestError(c, errors.Errorf("distribution %s can not be filtered by rollup", req.Name))
+		}
+
+		hash, err := base64.URLEncoding.DecodeString(req.Rollup)
+		if err != nil {
+			return badRequestError(c, err)
+		}
+		rollup, err := sh.rollups.ByHash(c.Request().Context(), hash)
+		if err != nil {
+			return handleError(c, err, sh.rollups)
+		}
+		fltrs.RollupId = rollup.Id
+	}
+
+	distribution, err := sh.repo.Distribution(c.Request().Context(), req.Name, fltrs)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+	return c.JSON(http.StatusOK, responses.NewDistribution(distribution))
+}
+
 // FeeSummary godoc
 //
 //	@Summary		Get fee summary
@@ -232,6 +500,250 @@ func (sh *StatsHandler) FeeSummary(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// FeeByActionType godoc
+//
+//	@Summary		Get fee summary by action type
+//	@Description	Get total fees grouped by action type and fee asset
+//	@Tags			stats
+//	@ID				stats-fee-actions
+//	@Produce		json
+//	@Success		200	{array}		responses.FeeActionSummary
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/fee/actions [get]
+func (sh *StatsHandler) FeeByActionType(c echo.Context) error {
+	summary, err := sh.repo.FeeByActionType(c.Request().Context())
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+	response := make([]responses.FeeActionSummary, len(summary))
+	for i := range summary {
+		response[i] = responses.NewFeeActionSummary(summary[i])
+	}
+	return returnArray(c, response)
+}
+
+type feeSeriesRequest struct {
+	Timeframe  string `example:"hour"                   param:"timeframe"   swaggertype:"string"  validate:"required,oneof=hour day month"`
+	ActionType string `example:"rollup_data_submission" query:"action_type" swaggertype:"string"  validate:"omitempty,action_type"`
+	Asset      string `example:"nria"                   query:"asset"       swaggertype:"string"  validate:"omitempty"`
+	From       int64  `example:"1692892095"             query:"from"        swaggertype:"integer" validate:"omitempty,min=1"`
+	To         int64  `example:"1692892095"             query:"to"          swaggertype:"integer" validate:"omitempty,min=1"`
+}
+
+// FeeSeries godoc
+//
+//	@Summary		Get histogram of paid fees
+//	@Description	Get histogram of paid fees by timeframe. Value is a sum of fees, max and min are the biggest and the smallest fee in the bucket.
+//	@Tags			stats
+//	@ID				stats-fee-series
+//	@Param			timeframe	path	string	true	"Timeframe"						Enums(hour, day, month)
+//	@Param			action_type	query	string	false	"Action type"
+//	@Param			asset		query	string	false	"Fee asset"
+//	@Param			from		query	integer	false	"Time from in unix timestamp"	mininum(1)
+//	@Param			to			query	integer	false	"Time to in unix timestamp"		mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.SeriesItem
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/fee/series/{timeframe} [get]
+func (sh *StatsHandler) FeeSeries(c echo.Context) error {
+	req, err := bindAndValidate[feeSeriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	histogram, err := sh.repo.FeeSeries(
+		c.Request().Context(),
+		storage.Timeframe(req.Timeframe),
+		storage.NewSeriesRequest(req.From, req.To),
+		storage.FeeSeriesFilter{
+			ActionType: req.ActionType,
+			Asset:      req.Asset,
+		},
+	)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	response := make([]responses.SeriesItem, len(histogram))
+	for i := range histogram {
+		response[i] = responses.NewSeriesItem(histogram[i])
+	}
+	return returnArray(c, response)
+}
+
+type topFeePayersRequest struct {
+	Timeframe string `query:"timeframe" validate:"omitempty,oneof=day week month"`
+	Asset     string `query:"asset"     validate:"omitempty"`
+	Limit     int    `query:"limit"     validate:"omitempty,min=1,max=100"`
+}
+
+func (p *topFeePayersRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// TopFeePayers godoc
+//
+//	@Summary		Get top fee payers
+//	@Description	Get addresses which paid the most fees. If timeframe is passed, fees of the last day, week or month are counted.
+//	@Tags			stats
+//	@ID				stats-fee-payers
+//	@Param			timeframe	query	string	false	"Timeframe"						Enums(day, week, month)
+//	@Param			asset		query	string	false	"Fee asset"
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Produce		json
+//	@Success		200	{array}		responses.FeePayer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/fee/payers [get]
+func (sh *StatsHandler) TopFeePayers(c echo.Context) error {
+	req, err := bindAndValidate[topFeePayersRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	payers, err := sh.repo.TopFeePayers(c.Request().Context(), storage.TopFeePayersFilter{
+		Timeframe: storage.Timeframe(req.Timeframe),
+		Asset:     req.Asset,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	response := make([]responses.FeePayer, len(payers))
+	for i := range payers {
+		response[i] = responses.NewFeePayer(payers[i])
+	}
+	return returnArray(c, response)
+}
+
+type rollupFeesRequest struct {
+	Asset  string `query:"asset"  validate:"omitempty"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (p *rollupFeesRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+// RollupFees godoc
+//
+//	@Summary		Get fee spend of rollups
+//	@Description	Get rollups sorted by fees paid for their data submissions
+//	@Tags			stats
+//	@ID				stats-fee-rollups
+//	@Param			asset	query	string	false	"Fee asset"
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.RollupFee
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/fee/rollups [get]
+func (sh *StatsHandler) RollupFees(c echo.Context) error {
+	req, err := bindAndValidate[rollupFeesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	return sh.rollupFees(c, storage.RollupFeeFilter{
+		Asset:  req.Asset,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+}
+
+// RollupFee godoc
+//
+//	@Summary		Get fee spend of the rollup
+//	@Description	Get fees paid for data submissions of the rollup grouped by fee asset
+//	@Tags			stats
+//	@ID				stats-fee-rollup
+//	@Param			hash	path	string	true	"Base64Url encoded rollup id"
+//	@Produce		json
+//	@Success		200	{array}		responses.RollupFee
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/fee/rollup/{hash} [get]
+func (sh *StatsHandler) RollupFee(c echo.Context) error {
+	req, err := bindAndValidate[getRollupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := base64.URLEncoding.DecodeString(req.Hash)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	rollup, err := sh.rollups.ByHash(c.Request().Context(), hash)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	return sh.rollupFees(c, storage.RollupFeeFilter{
+		RollupIds: []uint64{rollup.Id},
+		Limit:     100,
+	})
+}
+
+// AppFee godoc
+//
+//	@Summary		Get fee spend of the application
+//	@Description	Get fees paid for data submissions of the application rollup grouped by fee asset
+//	@Tags			stats
+//	@ID				stats-fee-app
+//	@Param			slug	path	string	true	"Slug"
+//	@Produce		json
+//	@Success		200	{array}		responses.RollupFee
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/stats/fee/app/{slug} [get]
+func (sh *StatsHandler) AppFee(c echo.Context) error {
+	req, err := bindAndValidate[getAppRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	app, err := sh.apps.BySlug(c.Request().Context(), req.Slug)
+	if err != nil {
+		return handleError(c, err, sh.apps)
+	}
+	rollupIds := app.RollupIds
+	if len(rollupIds) == 0 && app.RollupId > 0 {
+		rollupIds = []uint64{app.RollupId}
+	}
+	if len(rollupIds) == 0 {
+		return returnArray(c, []responses.RollupFee{})
+	}
+
+	return sh.rollupFees(c, storage.RollupFeeFilter{
+		RollupIds: rollupIds,
+		Limit:     100,
+	})
+}
+
+func (sh *StatsHandler) rollupFees(c echo.Context, fltrs storage.RollupFeeFilter) error {
+	fees, err := sh.repo.RollupFees(c.Request().Context(), fltrs)
+	if err != nil {
+		return handleError(c, err, sh.rollups)
+	}
+
+	response := make([]responses.RollupFee, len(fees))
+	for i := range fees {
+		response[i] = responses.NewRollupFee(fees[i])
+	}
+	return returnArray(c, response)
+}
+
 type tokenTransferDistributionRequest struct {
 	Limit uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
 }
@@ -5,15 +5,20 @@ package handler
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -25,6 +30,7 @@ type StatsTestSuite struct {
 	suite.Suite
 	stats   *mock.MockIStats
 	rollups *mock.MockIRollup
+	apps    *mock.MockIApp
 	echo    *echo.Echo
 	handler *StatsHandler
 	ctrl    *gomock.Controller
@@ -37,7 +43,8 @@ func (s *StatsTestSuite) SetupSuite() {
 	s.ctrl = gomock.NewController(s.T())
 	s.stats = mock.NewMockIStats(s.ctrl)
 	s.rollups = mock.NewMockIRollup(s.ctrl)
-	s.handler = NewStatsHandler(s.stats, s.rollups, nil)
+	s.apps = mock.NewMockIApp(s.ctrl)
+	s.handler = NewStatsHandler(s.stats, s.rollups, s.apps, nil)
 }
 
 // TearDownSuite -
@@ -100,6 +107,142 @@ func (s *StatsTestSuite) TestBlockStatsHistogram() {
 	}
 }
 
+func (s *StatsTestSuite) TestAddressSeries() {
+	for _, name := range []string{
+		storage.AddressSeriesActive,
+		storage.AddressSeriesNew,
+		storage.AddressSeriesReturning,
+		storage.AddressSeriesChurned,
+	} {
+		for _, tf := range []storage.Timeframe{
+			storage.TimeframeDay,
+			storage.TimeframeWeek,
+			storage.TimeframeMonth,
+		} {
+			req := httptest.NewRequest(http.MethodGet, "/?from=1692892095", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetPath("/v1/stats/series/:name/:timeframe")
+			c.SetParamNames("name", "timeframe")
+			c.SetParamValues(name, string(tf))
+
+			s.stats.EXPECT().
+				AddressSeries(gomock.Any(), tf, name, storage.NewSeriesRequest(1692892095, 0)).
+				Return([]storage.SeriesItem{
+					{
+						Time:  testTime,
+						Value: "42",
+					},
+				}, nil).
+				Times(1)
+
+			s.Require().NoError(s.handler.Series(c))
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			var response []responses.SeriesItem
+			err := json.NewDecoder(rec.Body).Decode(&response)
+			s.Require().NoError(err)
+			s.Require().Len(response, 1)
+			s.Require().Equal("42", response[0].Value)
+		}
+	}
+}
+
+func (s *StatsTestSuite) TestAddressSeriesInvalidTimeframe() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/series/:name/:timeframe")
+	c.SetParamNames("name", "timeframe")
+	c.SetParamValues(storage.AddressSeriesActive, string(storage.TimeframeHour))
+
+	s.Require().NoError(s.handler.Series(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *StatsTestSuite) TestActionSeries() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/actions/series/:type/:timeframe")
+		c.SetParamNames("type", "timeframe")
+		c.SetParamValues(string(types.ActionTypeRollupDataSubmission), string(tf))
+
+		s.stats.EXPECT().
+			ActionSeries(gomock.Any(), tf, string(types.ActionTypeRollupDataSubmission), gomock.Any()).
+			Return([]storage.SeriesItem{
+				{
+					Time:  testTime,
+					Value: "100",
+				},
+			}, nil).
+			Times(1)
+
+		s.Require().NoError(s.handler.ActionSeries(c))
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var response []responses.SeriesItem
+		err := json.NewDecoder(rec.Body).Decode(&response)
+		s.Require().NoError(err)
+		s.Require().Len(response, 1)
+		s.Require().Equal("100", response[0].Value)
+	}
+}
+
+func (s *StatsTestSuite) TestActionSeriesInvalidType() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/actions/series/:type/:timeframe")
+	c.SetParamNames("type", "timeframe")
+	c.SetParamValues("unknown", string(storage.TimeframeDay))
+
+	s.Require().NoError(s.handler.ActionSeries(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *StatsTestSuite) TestActionBreakdown() {
+	q := make(url.Values)
+	q.Set("timeframe", "week")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/actions/breakdown")
+
+	s.stats.EXPECT().
+		ActionBreakdown(gomock.Any(), storage.TimeframeWeek).
+		Return([]storage.ActionTypeShare{
+			{
+				ActionType:   string(types.ActionTypeRollupDataSubmission),
+				ActionsCount: 75,
+				Share:        75,
+			}, {
+				ActionType:   string(types.ActionTypeBridgeLock),
+				ActionsCount: 25,
+				Share:        25,
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.ActionBreakdown(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response []responses.ActionTypeShare
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Len(response, 2)
+	s.Require().Equal(types.ActionCategoryRollupData, response[0].Category)
+	s.Require().EqualValues(75, response[0].Share)
+	s.Require().Equal(types.ActionCategoryBridge, response[1].Category)
+	s.Require().EqualValues(25, response[1].ActionsCount)
+}
+
 func (s *StatsTestSuite) TestRollupStatsHistogram() {
 	for _, name := range []string{
 		storage.RollupSeriesActionsCount,
@@ -149,6 +292,200 @@ func (s *StatsTestSuite) TestRollupStatsHistogram() {
 	}
 }
 
+func (s *StatsTestSuite) TestCustomTimeframeSeries() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeWeek,
+		"5m",
+		"15m",
+		"4h",
+		"1w",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/series/:name/:timeframe")
+		c.SetParamNames("name", "timeframe")
+		c.SetParamValues(storage.SeriesTxCount, string(tf))
+
+		s.stats.EXPECT().
+			Series(gomock.Any(), tf, storage.SeriesTxCount, gomock.Any()).
+			Return([]storage.SeriesItem{
+				{
+					Time:  testTime,
+					Value: "10",
+				},
+			}, nil).
+			Times(1)
+
+		s.Require().NoError(s.handler.Series(c), tf)
+		s.Require().Equal(http.StatusOK, rec.Code, tf)
+	}
+}
+
+func (s *StatsTestSuite) TestSeriesInvalidTimeframe() {
+	for _, tf := range []string{"0m", "30s", "5w", "1y", "h", "week1", "10000m"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/series/:name/:timeframe")
+		c.SetParamNames("name", "timeframe")
+		c.SetParamValues(storage.SeriesTxCount, tf)
+
+		s.Require().NoError(s.handler.Series(c), tf)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, tf)
+	}
+}
+
+func (s *StatsTestSuite) TestCompareRollups() {
+	secondRollup := storage.Rollup{
+		Id:       2,
+		AstriaId: []byte{0x02, 0x02, 0x02},
+	}
+
+	q := make(url.Values)
+	q.Set("rollups", testRollupURLHash+","+base64.URLEncoding.EncodeToString(secondRollup.AstriaId))
+	q.Set("name", storage.RollupSeriesSize)
+	q.Set("timeframe", "15m")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/rollup/compare")
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), secondRollup.AstriaId).
+		Return(secondRollup, nil).
+		Times(1)
+
+	later := testTime.Add(15 * time.Minute)
+	s.stats.EXPECT().
+		CompareRollupSeries(gomock.Any(), []uint64{testRollup.Id, secondRollup.Id}, storage.Timeframe("15m"), storage.RollupSeriesSize, gomock.Any()).
+		Return([]storage.RollupSeriesPoint{
+			{RollupId: testRollup.Id, SeriesItem: storage.SeriesItem{Time: later, Value: "100"}},
+			{RollupId: secondRollup.Id, SeriesItem: storage.SeriesItem{Time: later, Value: "200"}},
+			{RollupId: secondRollup.Id, SeriesItem: storage.SeriesItem{Time: testTime, Value: "300"}},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.CompareRollups(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response responses.RollupComparison
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().Equal("15m", response.Timeframe)
+	s.Require().Len(response.Time, 2)
+	s.Require().True(response.Time[0].Equal(later))
+	s.Require().True(response.Time[1].Equal(testTime))
+	s.Require().Len(response.Rollups, 2)
+	s.Require().Equal(testRollup.AstriaId, response.Rollups[0].Hash)
+	s.Require().Equal([]string{"100", "0"}, response.Rollups[0].Values)
+	s.Require().Equal(secondRollup.AstriaId, response.Rollups[1].Hash)
+	s.Require().Equal([]string{"200", "300"}, response.Rollups[1].Values)
+}
+
+func (s *StatsTestSuite) TestCompareRollupsValidation() {
+	tooMany := make([]string, 11)
+	for i := range tooMany {
+		tooMany[i] = testRollupURLHash
+	}
+
+	for _, query := range []url.Values{
+		{"name": []string{storage.RollupSeriesSize}, "timeframe": []string{"day"}},
+		{"rollups": []string{testRollupURLHash}, "name": []string{"unknown"}, "timeframe": []string{"day"}},
+		{"rollups": []string{testRollupURLHash}, "name": []string{storage.RollupSeriesSize}, "timeframe": []string{"2y"}},
+		{"rollups": []string{strings.Join(tooMany, ",")}, "name": []string{storage.RollupSeriesSize}, "timeframe": []string{"day"}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/rollup/compare")
+
+		s.Require().NoError(s.handler.CompareRollups(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, query.Encode())
+	}
+}
+
+func (s *StatsTestSuite) TestDistribution() {
+	q := make(url.Values)
+	q.Set("timeframe", "week")
+	q.Set("rollup", testRollupURLHash)
+	q.Set("buckets", "2")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/distribution/:name")
+	c.SetParamNames("name")
+	c.SetParamValues(storage.DistributionRollupSize)
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+
+	s.stats.EXPECT().
+		Distribution(gomock.Any(), storage.DistributionRollupSize, storage.DistributionFilter{
+			Timeframe: storage.TimeframeWeek,
+			RollupId:  testRollup.Id,
+			Buckets:   2,
+		}).
+		Return(storage.Distribution{
+			Count: 3,
+			Min:   10,
+			Max:   30,
+			Avg:   20,
+			P50:   20,
+			P90:   28,
+			P99:   29.8,
+			Histogram: []storage.HistogramBucket{
+				{From: 10, To: 20, Count: 1},
+				{From: 20, To: 30, Count: 2},
+			},
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Distribution(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var response responses.Distribution
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, response.Count)
+	s.Require().EqualValues(20, response.P50)
+	s.Require().EqualValues(29.8, response.P99)
+	s.Require().Len(response.Histogram, 2)
+	s.Require().EqualValues(20, response.Histogram[1].From)
+	s.Require().EqualValues(2, response.Histogram[1].Count)
+}
+
+func (s *StatsTestSuite) TestDistributionValidation() {
+	for _, tc := range []struct {
+		name  string
+		query url.Values
+	}{
+		{"unknown", url.Values{}},
+		{storage.DistributionBlockTime, url.Values{"timeframe": []string{"hour"}}},
+		{storage.DistributionBlockTime, url.Values{"rollup": []string{testRollupURLHash}}},
+		{storage.DistributionTxSize, url.Values{"asset": []string{"nria"}}},
+		{storage.DistributionFee, url.Values{"buckets": []string{"101"}}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/?"+tc.query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/distribution/:name")
+		c.SetParamNames("name")
+		c.SetParamValues(tc.name)
+
+		s.Require().NoError(s.handler.Distribution(c))
+		s.Require().Equal(http.StatusBadRequest, rec.Code, tc.name+"?"+tc.query.Encode())
+	}
+}
+
 func (s *StatsTestSuite) TestSummary() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	rec := httptest.NewRecorder()
@@ -318,3 +655,284 @@ func (s *StatsTestSuite) TestActiveAddressesCount() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(100, result)
 }
+
+func (s *StatsTestSuite) TestFeeByActionType() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/actions")
+
+	s.stats.EXPECT().
+		FeeByActionType(gomock.Any()).
+		Return([]storage.FeeActionSummary{
+			{
+				ActionType: "rollup_data_submission",
+				Asset:      currency.DefaultCurrency,
+				Amount:     "1000",
+				FeeCount:   100,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.FeeByActionType(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var result []responses.FeeActionSummary
+	err := json.NewDecoder(rec.Body).Decode(&result)
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+
+	summary := result[0]
+	s.Require().EqualValues("rollup_data_submission", summary.ActionType)
+	s.Require().EqualValues("1000", summary.Amount)
+	s.Require().EqualValues(100, summary.FeeCount)
+	s.Require().EqualValues(currency.DefaultCurrency, summary.Asset)
+}
+
+func (s *StatsTestSuite) TestFeeSeries() {
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		q := make(url.Values)
+		q.Set("action_type", "transfer")
+		q.Set("asset", currency.DefaultCurrency)
+
+		req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/v1/stats/fee/series/:timeframe")
+		c.SetParamNames("timeframe")
+		c.SetParamValues(string(tf))
+
+		s.stats.EXPECT().
+			FeeSeries(gomock.Any(), tf, gomock.Any(), storage.FeeSeriesFilter{
+				ActionType: "transfer",
+				Asset:      currency.DefaultCurrency,
+			}).
+			Return([]storage.SeriesItem{
+				{
+					Time:  testTime,
+					Value: "11234",
+					Max:   "100",
+					Min:   "10",
+				},
+			}, nil)
+
+		s.Require().NoError(s.handler.FeeSeries(c))
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var response []responses.SeriesItem
+		err := json.NewDecoder(rec.Body).Decode(&response)
+		s.Require().NoError(err)
+		s.Require().Len(response, 1)
+		s.Require().Equal("11234", response[0].Value)
+		s.Require().Equal("100", response[0].Max)
+		s.Require().Equal("10", response[0].Min)
+	}
+}
+
+func (s *StatsTestSuite) TestFeeSeriesInvalidActionType() {
+	q := make(url.Values)
+	q.Set("action_type", "unknown")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/series/:timeframe")
+	c.SetParamNames("timeframe")
+	c.SetParamValues(string(storage.TimeframeDay))
+
+	s.Require().NoError(s.handler.FeeSeries(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *StatsTestSuite) TestTopFeePayers() {
+	q := make(url.Values)
+	q.Set("timeframe", "week")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/payers")
+
+	s.stats.EXPECT().
+		TopFeePayers(gomock.Any(), storage.TopFeePayersFilter{
+			Timeframe: storage.TimeframeWeek,
+			Limit:     10,
+		}).
+		Return([]storage.FeePayer{
+			{
+				PayerId:  testAddress.Id,
+				Asset:    currency.DefaultCurrency,
+				Amount:   "1000",
+				FeeCount: 10,
+				Payer:    &testAddress,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.TopFeePayers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var result []responses.FeePayer
+	err := json.NewDecoder(rec.Body).Decode(&result)
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+
+	payer := result[0]
+	s.Require().EqualValues("1000", payer.Amount)
+	s.Require().EqualValues(10, payer.FeeCount)
+	s.Require().EqualValues(currency.DefaultCurrency, payer.Asset)
+	s.Require().NotNil(payer.Payer)
+	s.Require().Equal(testAddress.Hash, payer.Payer.Hash)
+}
+
+func (s *StatsTestSuite) TestRollupFees() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/rollups")
+
+	s.stats.EXPECT().
+		RollupFees(gomock.Any(), storage.RollupFeeFilter{
+			Limit: 10,
+		}).
+		Return([]storage.RollupFee{
+			{
+				RollupId: testRollup.Id,
+				Asset:    currency.DefaultCurrency,
+				Amount:   "1000",
+				FeeCount: 10,
+				Rollup:   &testRollup,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.RollupFees(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var result []responses.RollupFee
+	err := json.NewDecoder(rec.Body).Decode(&result)
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+
+	fee := result[0]
+	s.Require().EqualValues("1000", fee.Amount)
+	s.Require().EqualValues(10, fee.FeeCount)
+	s.Require().Equal(testRollupHash, fee.Rollup)
+}
+
+func (s *StatsTestSuite) TestRollupFee() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/rollup/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testRollupURLHash)
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollup.AstriaId).
+		Return(testRollup, nil).
+		Times(1)
+
+	s.stats.EXPECT().
+		RollupFees(gomock.Any(), storage.RollupFeeFilter{
+			RollupIds: []uint64{testRollup.Id},
+			Limit:     100,
+		}).
+		Return([]storage.RollupFee{
+			{
+				RollupId: testRollup.Id,
+				Asset:    currency.DefaultCurrency,
+				Amount:   "1000",
+				FeeCount: 10,
+				Rollup:   &testRollup,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.RollupFee(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var result []responses.RollupFee
+	err := json.NewDecoder(rec.Body).Decode(&result)
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+	s.Require().EqualValues("1000", result[0].Amount)
+}
+
+func (s *StatsTestSuite) TestAppFee() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/app/:slug")
+	c.SetParamNames("slug")
+	c.SetParamValues("test-app")
+
+	s.apps.EXPECT().
+		BySlug(gomock.Any(), "test-app").
+		Return(storage.AppWithStats{
+			App: storage.App{
+				Id:       1,
+				Slug:     "test-app",
+				RollupId: testRollup.Id,
+			},
+		}, nil).
+		Times(1)
+
+	s.stats.EXPECT().
+		RollupFees(gomock.Any(), storage.RollupFeeFilter{
+			RollupIds: []uint64{testRollup.Id},
+			Limit:     100,
+		}).
+		Return([]storage.RollupFee{
+			{
+				RollupId: testRollup.Id,
+				Asset:    currency.DefaultCurrency,
+				Amount:   "1000",
+				FeeCount: 10,
+				Rollup:   &testRollup,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.AppFee(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var result []responses.RollupFee
+	err := json.NewDecoder(rec.Body).Decode(&result)
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+	s.Require().Equal(testRollupHash, result[0].Rollup)
+}
+
+func (s *StatsTestSuite) TestAppFeeLinkedRollups() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/v1/stats/fee/app/:slug")
+	c.SetParamNames("slug")
+	c.SetParamValues("test-app")
+
+	s.apps.EXPECT().
+		BySlug(gomock.Any(), "test-app").
+		Return(storage.AppWithStats{
+			App: storage.App{
+				Id:       1,
+				Slug:     "test-app",
+				RollupId: testRollup.Id,
+			},
+			AppStats: storage.AppStats{
+				RollupIds: []uint64{testRollup.Id, 2},
+			},
+		}, nil).
+		Times(1)
+
+	s.stats.EXPECT().
+		RollupFees(gomock.Any(), storage.RollupFeeFilter{
+			RollupIds: []uint64{testRollup.Id, 2},
+			Limit:     100,
+		}).
+		Return([]storage.RollupFee{}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.AppFee(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
@@ -22,6 +22,8 @@ type TxHandler struct {
 	fees        storage.IFee
 	state       storage.IState
 	cache       cache.ICache
+	finality    *cache.Finality
+	decoder     TxDecoder
 	indexerName string
 }
 
@@ -32,6 +34,8 @@ func NewTxHandler(
 	fees storage.IFee,
 	state storage.IState,
 	cache cache.ICache,
+	finality *cache.Finality,
+	decoder TxDecoder,
 	indexerName string,
 ) *TxHandler {
 	return &TxHandler{
@@ -41,6 +45,8 @@ func NewTxHandler(
 		fees:        fees,
 		state:       state,
 		cache:       cache,
+		finality:    finality,
+		decoder:     decoder,
 		indexerName: indexerName,
 	}
 }
@@ -49,12 +55,16 @@ var _ Handler = (*TxHandler)(nil)
 
 func (handler *TxHandler) InitRoutes(srvr *echo.Group) {
 	middlewareCache := cache.NewDefaultMiddlewareCache(handler.cache)
+	headCache := cache.NewHeadMiddlewareCache(handler.cache)
+	headConditional := handler.finality.HeadConditionalMiddleware()
 
 	txGroup := srvr.Group("/tx")
 	{
-		txGroup.GET("", handler.List)
-		txGroup.GET("/count", handler.Count)
-		hashGroup := txGroup.Group("/:hash", middlewareCache)
+		txGroup.GET("", handler.List, headConditional, headCache)
+		txGroup.GET("/count", handler.Count, headConditional, headCache)
+		txGroup.POST("/decode", handler.Decode)
+		txGroup.POST("/batch", handler.Batch)
+		hashGroup := txGroup.Group("/:hash", cache.ConditionalMiddleware(), middlewareCache)
 		{
 			hashGroup.GET("", handler.Get, middlewareCache)
 			hashGroup.GET("/actions", handler.GetActions, middlewareCache)
@@ -100,7 +110,11 @@ func (handler *TxHandler) Get(c echo.Context) error {
 	if err != nil {
 		return handleError(c, err, handler.tx)
 	}
+	handler.finality.SkipUnsafe(c, tx.Height)
+	handler.finality.SetImmutable(c, tx.Hash, tx.Height, tx.Time)
+
 	response := responses.NewTx(tx)
+	response.SetFinality(handler.finality)
 
 	if req.Fee {
 		fees, err := handler.fees.FullTxFee(c.Request().Context(), tx.Id)
@@ -124,6 +138,7 @@ type txListRequest struct {
 	Status      StringArray `query:"status"       validate:"omitempty,dive,status"`
 	ActionTypes StringArray `query:"action_types" validate:"omitempty,dive,action_type"`
 	WithActions bool        `query:"with_actions" validate:"omitempty"`
+	Finalized   bool        `query:"finalized"    validate:"omitempty"`
 
 	From int64 `example:"1692892095" query:"from" swaggertype:"integer" validate:"omitempty,min=1"`
 	To   int64 `example:"1692892095" query:"to"   swaggertype:"integer" validate:"omitempty,min=1"`
@@ -153,6 +168,7 @@ func (p *txListRequest) SetDefault() {
 //	@Param			to					query	integer				false	"Time to in unix timestamp"		mininum(1)
 //	@Param			height				query	integer				false	"Block number"					mininum(1)
 //	@Param			messages			query	boolean				false	"If true join actions"			mininum(1)
+//	@Param			finalized			query	boolean				false	"If true hide transactions from not finalized blocks"
 //	@Produce		json
 //	@Success		200	{array}		responses.Tx
 //	@Failure		400	{object}	Error
@@ -183,6 +199,13 @@ func (handler *TxHandler) List(c echo.Context) error {
 	for i := range req.ActionTypes {
 		fltrs.ActionTypes.SetType(types.ActionType(req.ActionTypes[i]))
 	}
+	if req.Finalized {
+		height, ok := handler.finality.FinalizedHeight()
+		if !ok {
+			return returnArray(c, []responses.Tx{})
+		}
+		fltrs.MaxHeight = uint64(height)
+	}
 
 	txs, err := handler.tx.Filter(c.Request().Context(), fltrs)
 	if err != nil {
@@ -191,6 +214,7 @@ func (handler *TxHandler) List(c echo.Context) error {
 	response := make([]responses.Tx, len(txs))
 	for i := range txs {
 		response[i] = responses.NewTx(txs[i])
+		response[i].SetFinality(handler.finality)
 	}
 	return returnArray(c, response)
 }
@@ -238,6 +262,8 @@ func (handler *TxHandler) GetActions(c echo.Context) error {
 		return handleError(c, err, handler.tx)
 	}
 
+	handler.finality.SkipUnsafe(c, tx.Height)
+
 	events, err := handler.actions.ByTxId(c.Request().Context(), tx.Id, req.Limit, req.Offset)
 	if err != nil {
 		return handleError(c, err, handler.tx)
@@ -245,6 +271,7 @@ func (handler *TxHandler) GetActions(c echo.Context) error {
 	response := make([]responses.Action, len(events))
 	for i := range events {
 		response[i] = responses.NewAction(events[i])
+		response[i].SetFinality(handler.finality)
 	}
 	return returnArray(c, response)
 }
@@ -297,6 +324,7 @@ func (handler *TxHandler) RollupActions(c echo.Context) error {
 	if err != nil {
 		return handleError(c, err, handler.tx)
 	}
+	handler.finality.SkipUnsafe(c, tx.Height)
 
 	actions, err := handler.rollups.ActionsByTxId(c.Request().Context(), tx.Id, req.Limit, req.Offset)
 	if err != nil {
@@ -336,6 +364,7 @@ func (handler *TxHandler) RollupActionsCount(c echo.Context) error {
 	if err != nil {
 		return handleError(c, err, handler.tx)
 	}
+	handler.finality.SkipUnsafe(c, tx.Height)
 
 	count, err := handler.rollups.CountActionsByTxId(c.Request().Context(), tx.Id)
 	if err != nil {
@@ -374,6 +403,7 @@ func (handler *TxHandler) GetFees(c echo.Context) error {
 	if err != nil {
 		return handleError(c, err, handler.tx)
 	}
+	handler.finality.SkipUnsafe(c, tx.Height)
 
 	fees, err := handler.fees.ByTxId(c.Request().Context(), tx.Id, req.Limit, req.Offset)
 	if err != nil {
@@ -385,3 +415,71 @@ func (handler *TxHandler) GetFees(c echo.Context) error {
 	}
 	return returnArray(c, response)
 }
+
+type txBatchRequest struct {
+	Hashes []string `json:"hashes" validate:"required,min=1,max=100"`
+}
+
+// Batch godoc
+//
+//	@Summary		Get transactions by hashes
+//	@Description	Get up to 100 transactions by hashes in one request. Items of the response are returned in order of the requested hashes with error for invalid and unknown ones.
+//	@Tags			transactions
+//	@ID				get-transactions-batch
+//	@Param			request	body	txBatchRequest	true	"Transaction hashes in hexadecimal"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		responses.BatchItem[responses.Tx]
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/tx/batch [post]
+func (handler *TxHandler) Batch(c echo.Context) error {
+	req, err := bindAndValidate[txBatchRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hashes := make([][]byte, 0, len(req.Hashes))
+	for i := range req.Hashes {
+		if hash, ok := decodeTxHash(req.Hashes[i]); ok {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	txs := make(map[string]storage.Tx, len(hashes))
+	if len(hashes) > 0 {
+		found, err := handler.tx.ByHashes(c.Request().Context(), hashes)
+		if err != nil {
+			return handleError(c, err, handler.tx)
+		}
+		for i := range found {
+			txs[hex.EncodeToString(found[i].Hash)] = found[i]
+		}
+	}
+
+	response := make([]responses.BatchItem[responses.Tx], len(req.Hashes))
+	for i := range req.Hashes {
+		hash, ok := decodeTxHash(req.Hashes[i])
+		if !ok {
+			response[i] = responses.NewBatchError[responses.Tx](req.Hashes[i], batchErrInvalidId)
+			continue
+		}
+		tx, ok := txs[hex.EncodeToString(hash)]
+		if !ok {
+			response[i] = responses.NewBatchError[responses.Tx](req.Hashes[i], batchErrNotFound)
+			continue
+		}
+		item := responses.NewTx(tx)
+		item.SetFinality(handler.finality)
+		response[i] = responses.NewBatchItem(req.Hashes[i], item)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func decodeTxHash(value string) ([]byte, bool) {
+	hash, err := hex.DecodeString(value)
+	if err != nil || len(hash) != 32 {
+		return nil, false
+	}
+	return hash, true
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// DecodedTx - transaction decoded from raw bytes without database access
+type DecodedTx struct {
+	Signer         string
+	Nonce          uint32
+	ChainId        string
+	ActionTypes    types.Bits
+	Actions        []storage.Action
+	SignatureValid bool
+}
+
+// TxDecoder - decodes raw signed transactions
+type TxDecoder interface {
+	Decode(raw []byte) (DecodedTx, error)
+}
+
+const (
+	encodingBase64 = "base64"
+	encodingHex    = "hex"
+)
+
+type decodeTxRequest struct {
+	Tx       string `json:"tx"       validate:"required"`
+	Encoding string `json:"encoding" validate:"omitempty,oneof=base64 hex"`
+}
+
+func (req *decodeTxRequest) raw() ([]byte, error) {
+	switch req.Encoding {
+	case encodingHex:
+		return hex.DecodeString(strings.TrimPrefix(req.Tx, "0x"))
+	case encodingBase64:
+		return base64.StdEncoding.DecodeString(req.Tx)
+	default:
+		if raw, err := hex.DecodeString(strings.TrimPrefix(req.Tx, "0x")); err == nil {
+			return raw, nil
+		}
+		return base64.StdEncoding.DecodeString(req.Tx)
+	}
+}
+
+// Decode godoc
+//
+//	@Summary		Decode raw transaction
+//	@Description	Decode signed transaction which is not broadcasted yet. Transaction bytes can be passed in base64 or hex. If encoding is not set hex is tried first. Database is not used, so actions with unknown bridge assets can not be decoded.
+//	@Tags			transactions
+//	@ID				decode-transaction
+//	@Param			request	body	decodeTxRequest	true	"Raw transaction"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	responses.DecodedTx
+//	@Failure		400	{object}	Error
+//	@Router			/v1/tx/decode [post]
+func (handler *TxHandler) Decode(c echo.Context) error {
+	req, err := bindAndValidate[decodeTxRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	raw, err := req.raw()
+	if err != nil {
+		return badRequestError(c, errors.Wrap(err, "invalid transaction encoding"))
+	}
+
+	tx, err := handler.decoder.Decode(raw)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, responses.NewDecodedTx(
+		tx.Signer,
+		tx.Nonce,
+		tx.ChainId,
+		tx.SignatureValid,
+		tx.ActionTypes,
+		tx.Actions,
+	))
+}
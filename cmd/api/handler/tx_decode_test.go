@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type testTxDecoder struct {
+	raw []byte
+	err error
+}
+
+func (d *testTxDecoder) Decode(raw []byte) (DecodedTx, error) {
+	d.raw = raw
+	if d.err != nil {
+		return DecodedTx{}, d.err
+	}
+	return DecodedTx{
+		Signer:      testAddress.Hash,
+		Nonce:       10,
+		ChainId:     "astria",
+		ActionTypes: types.ActionTypeRollupDataSubmissionBits,
+		Actions: []storage.Action{
+			{
+				Position: 0,
+				Type:     types.ActionTypeRollupDataSubmission,
+				Data: map[string]any{
+					"fee_asset": "nria",
+				},
+			},
+		},
+		SignatureValid: true,
+	}, nil
+}
+
+func (s *TxTestSuite) decodeRequest(body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/decode")
+	return c, rec
+}
+
+func (s *TxTestSuite) TestDecode() {
+	for _, body := range []string{
+		`{"tx":"AQID"}`,
+		`{"tx":"AQID","encoding":"base64"}`,
+		`{"tx":"010203"}`,
+		`{"tx":"0x010203","encoding":"hex"}`,
+	} {
+		s.decoder.err = nil
+		c, rec := s.decodeRequest(body)
+
+		s.Require().NoError(s.handler.Decode(c), body)
+		s.Require().Equal(http.StatusOK, rec.Code, body)
+		s.Require().True(bytes.Equal([]byte{1, 2, 3}, s.decoder.raw), body)
+
+		var tx responses.DecodedTx
+		err := json.NewDecoder(rec.Body).Decode(&tx)
+		s.Require().NoError(err)
+		s.Require().Equal(testAddress.Hash, tx.Signer)
+		s.Require().EqualValues(10, tx.Nonce)
+		s.Require().Equal("astria", tx.ChainId)
+		s.Require().True(tx.SignatureValid)
+		s.Require().Equal([]string{types.ActionTypeRollupDataSubmission.String()}, tx.ActionTypes)
+		s.Require().Len(tx.Actions, 1)
+		s.Require().Equal(types.ActionTypeRollupDataSubmission, tx.Actions[0].Type)
+		s.Require().Equal("nria", tx.Actions[0].Data["fee_asset"])
+	}
+}
+
+func (s *TxTestSuite) TestDecodeInvalidRequest() {
+	for _, body := range []string{
+		`{}`,
+		`{"tx":"AQID","encoding":"hex"}`,
+		`{"tx":"010203","encoding":"base32"}`,
+		`{"tx":"not encoded!"}`,
+	} {
+		c, rec := s.decodeRequest(body)
+		s.Require().NoError(s.handler.Decode(c), body)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, body)
+	}
+}
+
+func (s *TxTestSuite) TestDecodeError() {
+	s.decoder.err = errors.New("tx decoding")
+	defer func() { s.decoder.err = nil }()
+
+	c, rec := s.decodeRequest(`{"tx":"AQID"}`)
+	s.Require().NoError(s.handler.Decode(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var e Error
+	err := json.NewDecoder(rec.Body).Decode(&e)
+	s.Require().NoError(err)
+	s.Require().Contains(e.Message, "tx decoding")
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ type TxTestSuite struct {
 	rollups *mock.MockIRollup
 	fees    *mock.MockIFee
 	state   *mock.MockIState
+	decoder *testTxDecoder
 	echo    *echo.Echo
 	handler *TxHandler
 	ctrl    *gomock.Controller
@@ -49,7 +51,8 @@ func (s *TxTestSuite) SetupSuite() {
 	s.rollups = mock.NewMockIRollup(s.ctrl)
 	s.fees = mock.NewMockIFee(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewTxHandler(s.tx, s.actions, s.rollups, s.fees, s.state, nil, testIndexerName)
+	s.decoder = new(testTxDecoder)
+	s.handler = NewTxHandler(s.tx, s.actions, s.rollups, s.fees, s.state, nil, newTestFinality(), s.decoder, testIndexerName)
 }
 
 func (s *TxTestSuite) TearDownSuite() {
@@ -88,6 +91,8 @@ func (s *TxTestSuite) TestGet() {
 	s.Require().EqualValues(testAddress.Hash, tx.Signer.Hash)
 	s.Require().Equal("codespace", tx.Codespace)
 	s.Require().Equal(types.StatusSuccess, tx.Status)
+	s.Require().Nil(tx.Confirmations)
+	s.Require().True(tx.Finalized)
 }
 
 func (s *TxTestSuite) TestGetWithFee() {
@@ -210,6 +215,37 @@ func (s *TxTestSuite) TestList() {
 	s.Require().Equal(types.StatusSuccess, tx.Status)
 }
 
+func (s *TxTestSuite) TestListFinalized() {
+	q := make(url.Values)
+	q.Set("finalized", "true")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx")
+
+	s.tx.EXPECT().
+		Filter(gomock.Any(), storage.TxFilter{
+			Limit:       10,
+			Sort:        pgSort(asc),
+			ActionTypes: types.NewActionTypeMask(),
+			MaxHeight:   100,
+		}).
+		Return([]storage.Tx{
+			testTx,
+		}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var txs []responses.Tx
+	err := json.NewDecoder(rec.Body).Decode(&txs)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().True(txs[0].Finalized)
+}
+
 func (s *TxTestSuite) TestListValidationStatusError() {
 	q := make(url.Values)
 	q.Set("limit", "2")
@@ -519,3 +555,58 @@ func (s *TxTestSuite) TestFees() {
 	s.Require().NoError(err)
 	s.Require().Len(fees, 1)
 }
+
+func (s *TxTestSuite) TestBatch() {
+	unknownHash := strings.Repeat("ab", 32)
+	body := `{"hashes":["` + strings.ToUpper(testTxHash) + `","invalid","` + unknownHash + `"]}`
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/batch")
+
+	s.tx.EXPECT().
+		ByHashes(gomock.Any(), gomock.Len(2)).
+		Return([]storage.Tx{testTx}, nil).
+		Times(1)
+
+	s.Require().NoError(s.handler.Batch(c))
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var items []responses.BatchItem[responses.Tx]
+	err := json.NewDecoder(rec.Body).Decode(&items)
+	s.Require().NoError(err)
+	s.Require().Len(items, 3)
+
+	s.Require().Equal(strings.ToUpper(testTxHash), items[0].Id)
+	s.Require().NotNil(items[0].Data)
+	s.Require().EqualValues(1, items[0].Data.Id)
+	s.Require().Empty(items[0].Error)
+
+	s.Require().Equal("invalid", items[1].Id)
+	s.Require().Nil(items[1].Data)
+	s.Require().Equal("invalid identifier", items[1].Error)
+
+	s.Require().Equal(unknownHash, items[2].Id)
+	s.Require().Nil(items[2].Data)
+	s.Require().Equal("not found", items[2].Error)
+}
+
+func (s *TxTestSuite) TestBatchTooManyHashes() {
+	hashes := make([]string, 101)
+	for i := range hashes {
+		hashes[i] = testTxHash
+	}
+	body, err := json.Marshal(map[string][]string{"hashes": hashes})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/tx/batch")
+
+	s.Require().NoError(s.handler.Batch(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
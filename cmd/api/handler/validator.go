@@ -6,6 +6,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/pkg/types"
@@ -17,6 +18,7 @@ type ValidatorHandler struct {
 	blocks          storage.IBlock
 	blockSignatures storage.IBlockSignature
 	state           storage.IState
+	finality        *cache.Finality
 	indexerName     string
 }
 
@@ -25,6 +27,7 @@ func NewValidatorHandler(
 	blocks storage.IBlock,
 	blockSignatures storage.IBlockSignature,
 	state storage.IState,
+	finality *cache.Finality,
 	indexerName string,
 ) *ValidatorHandler {
 	return &ValidatorHandler{
@@ -32,6 +35,7 @@ func NewValidatorHandler(
 		blocks:          blocks,
 		blockSignatures: blockSignatures,
 		state:           state,
+		finality:        finality,
 		indexerName:     indexerName,
 	}
 }
@@ -179,6 +183,7 @@ func (handler *ValidatorHandler) Blocks(c echo.Context) error {
 	response := make([]responses.Block, len(blocks))
 	for i := range blocks {
 		response[i] = responses.NewBlock(blocks[i])
+		response[i].SetFinality(handler.finality)
 	}
 	return returnArray(c, response)
 }
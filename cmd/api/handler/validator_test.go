@@ -42,7 +42,7 @@ func (s *ValidatorTestSuite) SetupSuite() {
 	s.blocks = mock.NewMockIBlock(s.ctrl)
 	s.blockSignatures = mock.NewMockIBlockSignature(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewValidatorHandler(s.validators, s.blocks, s.blockSignatures, s.state, testIndexerName)
+	s.handler = NewValidatorHandler(s.validators, s.blocks, s.blockSignatures, s.state, newTestFinality(), testIndexerName)
 }
 
 // TearDownSuite -
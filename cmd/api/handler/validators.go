@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/celenium-io/astria-indexer/internal/astria"
+	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -30,6 +31,9 @@ func NewApiValidator() *ApiValidator {
 	if err := v.RegisterValidation("app_category", categoryValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("series_timeframe", seriesTimeframeValidator()); err != nil {
+		panic(err)
+	}
 	return &ApiValidator{validator: v}
 }
 
@@ -70,3 +74,14 @@ func categoryValidator() validator.Func {
 		return err == nil
 	}
 }
+
+func seriesTimeframeValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		tf := storage.Timeframe(fl.Field().String())
+		if tf.IsFixed() {
+			return true
+		}
+		_, err := tf.Bucket()
+		return err == nil
+	}
+}
@@ -77,6 +77,10 @@ func (c *Client) ApplyFilters(msg Subscribe) error {
 		c.filters.head = true
 	case ChannelBlocks:
 		c.filters.blocks = true
+	case ChannelRollback:
+		c.filters.rollbacks = true
+	case ChannelLiveness:
+		c.filters.liveness = true
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
@@ -92,6 +96,10 @@ func (c *Client) DetachFilters(msg Unsubscribe) error {
 		c.filters.head = false
 	case ChannelBlocks:
 		c.filters.blocks = false
+	case ChannelRollback:
+		c.filters.rollbacks = false
+	case ChannelLiveness:
+		c.filters.liveness = false
 	default:
 		return errors.Wrap(ErrUnknownChannel, msg.Channel)
 	}
@@ -179,6 +187,8 @@ func (c *Client) ReadMessages(ctx context.Context, ws *websocket.Conn, sub *Clie
 					websocket.CloseGoingAway):
 					c.manager.RemoveClientFromChannel(ChannelHead, c)
 					c.manager.RemoveClientFromChannel(ChannelBlocks, c)
+					c.manager.RemoveClientFromChannel(ChannelRollback, c)
+					c.manager.RemoveClientFromChannel(ChannelLiveness, c)
 					return
 				}
 				log.Errorf("read websocket message: %s", err.Error())
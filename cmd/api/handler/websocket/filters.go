@@ -37,7 +37,35 @@ func (f BlockFilter) Filter(c client, msg Notification[*responses.Block]) bool {
 	return fltrs.blocks
 }
 
+type RollbackFilter struct{}
+
+func (f RollbackFilter) Filter(c client, msg Notification[*responses.RollbackEvent]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil {
+		return false
+	}
+	return fltrs.rollbacks
+}
+
+type LivenessFilter struct{}
+
+func (f LivenessFilter) Filter(c client, msg Notification[*responses.RollupLivenessEvent]) bool {
+	if msg.Body == nil {
+		return false
+	}
+	fltrs := c.Filters()
+	if fltrs == nil {
+		return false
+	}
+	return fltrs.liveness
+}
+
 type Filters struct {
-	head   bool
-	blocks bool
+	head      bool
+	blocks    bool
+	rollbacks bool
+	liveness  bool
 }
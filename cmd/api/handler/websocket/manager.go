@@ -13,6 +13,7 @@ import (
 
 	"github.com/celenium-io/astria-indexer/cmd/api/bus"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
@@ -25,13 +26,17 @@ type Manager struct {
 	clients  *sdkSync.Map[uint64, *Client]
 	observer *bus.Observer
 
-	head   *Channel[storage.State, *responses.State]
-	blocks *Channel[storage.Block, *responses.Block]
+	head      *Channel[storage.State, *responses.State]
+	blocks    *Channel[storage.Block, *responses.Block]
+	rollbacks *Channel[storage.RollbackEvent, *responses.RollbackEvent]
+	liveness  *Channel[liveness.Event, *responses.RollupLivenessEvent]
+
+	livenessEvents <-chan liveness.Event
 
 	g workerpool.Group
 }
 
-func NewManager(observer *bus.Observer) *Manager {
+func NewManager(observer *bus.Observer, monitor *liveness.Monitor) *Manager {
 	manager := &Manager{
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:  1024,
@@ -54,6 +59,17 @@ func NewManager(observer *bus.Observer) *Manager {
 		blockProcessor,
 		BlockFilter{},
 	)
+	manager.rollbacks = NewChannel[storage.RollbackEvent, *responses.RollbackEvent](
+		rollbackProcessor,
+		RollbackFilter{},
+	)
+	manager.liveness = NewChannel[liveness.Event, *responses.RollupLivenessEvent](
+		livenessProcessor,
+		LivenessFilter{},
+	)
+	if monitor != nil {
+		manager.livenessEvents = monitor.Events()
+	}
 
 	return manager
 }
@@ -71,6 +87,14 @@ func (manager *Manager) listen(ctx context.Context) {
 			if err := manager.blocks.processMessage(*block); err != nil {
 				log.Err(err).Msg("handle block")
 			}
+		case event := <-manager.observer.Rollbacks():
+			if err := manager.rollbacks.processMessage(*event); err != nil {
+				log.Err(err).Msg("handle rollback")
+			}
+		case event := <-manager.livenessEvents:
+			if err := manager.liveness.processMessage(event); err != nil {
+				log.Err(err).Msg("handle rollup liveness")
+			}
 		}
 	}
 }
@@ -133,6 +157,10 @@ func (manager *Manager) AddClientToChannel(channel string, client *Client) {
 		manager.head.AddClient(client)
 	case ChannelBlocks:
 		manager.blocks.AddClient(client)
+	case ChannelRollback:
+		manager.rollbacks.AddClient(client)
+	case ChannelLiveness:
+		manager.liveness.AddClient(client)
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
@@ -144,6 +172,10 @@ func (manager *Manager) RemoveClientFromChannel(channel string, client *Client)
 		manager.head.RemoveClient(client.id)
 	case ChannelBlocks:
 		manager.blocks.RemoveClient(client.id)
+	case ChannelRollback:
+		manager.rollbacks.RemoveClient(client.id)
+	case ChannelLiveness:
+		manager.liveness.RemoveClient(client.id)
 	default:
 		log.Error().Str("channel", channel).Msg("unknown channel name")
 	}
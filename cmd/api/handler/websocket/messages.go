@@ -16,8 +16,10 @@ const (
 
 // channels
 const (
-	ChannelHead   = "head"
-	ChannelBlocks = "blocks"
+	ChannelHead     = "head"
+	ChannelBlocks   = "blocks"
+	ChannelRollback = "rollback"
+	ChannelLiveness = "rollup_liveness"
 )
 
 type Message struct {
@@ -40,7 +42,7 @@ type TransactionFilters struct {
 }
 
 type INotification interface {
-	*responses.Block | *responses.State
+	*responses.Block | *responses.State | *responses.RollbackEvent | *responses.RollupLivenessEvent
 }
 
 type Notification[T INotification] struct {
@@ -61,3 +63,17 @@ func NewStateNotification(state responses.State) Notification[*responses.State]
 		Body:    &state,
 	}
 }
+
+func NewRollbackNotification(event responses.RollbackEvent) Notification[*responses.RollbackEvent] {
+	return Notification[*responses.RollbackEvent]{
+		Channel: ChannelRollback,
+		Body:    &event,
+	}
+}
+
+func NewRollupLivenessNotification(event responses.RollupLivenessEvent) Notification[*responses.RollupLivenessEvent] {
+	return Notification[*responses.RollupLivenessEvent]{
+		Channel: ChannelLiveness,
+		Body:    &event,
+	}
+}
@@ -5,6 +5,7 @@ package websocket
 
 import (
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 )
 
@@ -17,3 +18,13 @@ func headProcessor(state storage.State) Notification[*responses.State] {
 	response := responses.NewState(state)
 	return NewStateNotification(response)
 }
+
+func rollbackProcessor(event storage.RollbackEvent) Notification[*responses.RollbackEvent] {
+	response := responses.NewRollbackEvent(event)
+	return NewRollbackNotification(response)
+}
+
+func livenessProcessor(event liveness.Event) Notification[*responses.RollupLivenessEvent] {
+	response := responses.NewRollupLivenessEvent(event)
+	return NewRollupLivenessNotification(response)
+}
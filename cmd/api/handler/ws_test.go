@@ -84,7 +84,7 @@ func TestWebsocket(t *testing.T) {
 			}
 		}
 	}()
-	manager := ws.NewManager(observer)
+	manager := ws.NewManager(observer, nil)
 	manager.Start(ctx)
 
 	server := httptest.NewServer(http.HandlerFunc(
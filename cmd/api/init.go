@@ -14,6 +14,8 @@ import (
 	"github.com/celenium-io/astria-indexer/cmd/api/cache"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler"
 	"github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/celenium-io/astria-indexer/cmd/api/liveness"
+	"github.com/celenium-io/astria-indexer/cmd/api/ratelimit"
 	"github.com/celenium-io/astria-indexer/internal/profiler"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
@@ -26,6 +28,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	echoSwagger "github.com/swaggo/echo-swagger"
@@ -92,7 +95,7 @@ func newProflier(cfg *Config) (*pyroscope.Profiler, error) {
 	return profiler.New(cfg.Profiler, "api")
 }
 
-func newServer(cfg *Config, wsManager *websocket.Manager, handlers []handler.Handler) (*echo.Echo, error) {
+func newServer(cfg *Config, wsManager *websocket.Manager, limiter *ratelimit.Limiter, handlers []handler.Handler) (*echo.Echo, error) {
 	e := echo.New()
 	e.Validator = handler.NewApiValidator()
 	e.Server.IdleTimeout = time.Second * 30
@@ -153,11 +156,7 @@ func newServer(cfg *Config, wsManager *websocket.Manager, handlers []handler.Han
 	decompressConfig := middleware.DecompressConfig{
 		Skipper: websocketSkipper,
 	}
-	csrfConfig := middleware.CSRFConfig{
-		Skipper: func(c echo.Context) bool {
-			return websocketSkipper(c) || postSkipper(c)
-		},
-	}
+	csrfConfig := handler.CSRFConfig(websocketSkipper)
 
 	middlewares := []echo.MiddlewareFunc{
 		middleware.TimeoutWithConfig(timeoutConfig),
@@ -177,12 +176,21 @@ func newServer(cfg *Config, wsManager *websocket.Manager, handlers []handler.Han
 			Skipper:   websocketSkipper,
 		}))
 	}
+	if limiter != nil {
+		middlewares = append(middlewares, limiter.Authenticate(websocketSkipper))
+	}
 	if cfg.ApiConfig.RateLimit > 0 {
 		middlewares = append(middlewares, middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
-			Skipper: websocketSkipper,
-			Store:   middleware.NewRateLimiterMemoryStore(rate.Limit(cfg.ApiConfig.RateLimit)),
+			Skipper: func(c echo.Context) bool {
+				// requests with authenticated API keys are limited by their tiers
+				return websocketSkipper(c) || ratelimit.IsAuthenticated(c)
+			},
+			Store: middleware.NewRateLimiterMemoryStore(rate.Limit(cfg.ApiConfig.RateLimit)),
 		}))
 	}
+	if limiter != nil {
+		middlewares = append(middlewares, limiter.Middleware(websocketSkipper))
+	}
 
 	sentryMiddleware, err := initSentry(cfg.ApiConfig.SentryDsn, cfg.Environment)
 	if err != nil {
@@ -232,6 +240,16 @@ func newConstantCache(dispatcher *bus.Dispatcher) *cache.ConstantsCache {
 	return cache.NewConstantsCache(constantObserver)
 }
 
+func newCacheInvalidator(dispatcher *bus.Dispatcher, ttlCache cache.ICache, addresses storage.IAddress, rollups storage.IRollup) *cache.Invalidator {
+	observer := dispatcher.Observe(storage.ChannelBlock, storage.ChannelRollback)
+	return cache.NewInvalidator(ttlCache, observer, addresses, rollups)
+}
+
+func newFinality(cfg *Config, dispatcher *bus.Dispatcher) *cache.Finality {
+	headObserver := dispatcher.Observe(storage.ChannelHead, storage.ChannelRollback)
+	return cache.NewFinality(headObserver, cfg.ApiConfig.ConfirmationDepth)
+}
+
 func initSentry(dsn, environment string) (echo.MiddlewareFunc, error) {
 	if dsn == "" {
 		return nil, nil
@@ -251,8 +269,26 @@ func initSentry(dsn, environment string) (echo.MiddlewareFunc, error) {
 	return SentryMiddleware(), nil
 }
 
-func newWebsocket(dispatcher *bus.Dispatcher) *websocket.Manager {
-	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock)
-	wsManager := websocket.NewManager(observer)
+func newLivenessMonitor(cfg *Config, dispatcher *bus.Dispatcher, stats storage.IStats, apps storage.IApp) *liveness.Monitor {
+	var registerer prometheus.Registerer
+	if cfg.ApiConfig.Prometheus {
+		registerer = prometheus.DefaultRegisterer
+	}
+	headObserver := dispatcher.Observe(storage.ChannelHead)
+	return liveness.NewMonitor(cfg.ApiConfig.Liveness, headObserver, stats, apps, registerer)
+}
+
+func newRateLimiter(cfg *Config, ttlCache cache.ICache, apiKeys storage.IApiKey, usage storage.IApiKeyUsage) *ratelimit.Limiter {
+	if !cfg.ApiConfig.ApiKeys.Enabled {
+		return nil
+	}
+	// counters are shared between API instances through valkey if it's configured
+	store, _ := ttlCache.(ratelimit.Store)
+	return ratelimit.NewLimiter(cfg.ApiConfig.ApiKeys, store, apiKeys, usage)
+}
+
+func newWebsocket(dispatcher *bus.Dispatcher, monitor *liveness.Monitor) *websocket.Manager {
+	observer := dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock, storage.ChannelRollback)
+	wsManager := websocket.NewManager(observer, monitor)
 	return wsManager
 }
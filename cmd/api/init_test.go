@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// postHandler - registers POST endpoints answering 200 OK to check middlewares of the server
+type postHandler struct {
+	paths []string
+}
+
+func (h postHandler) InitRoutes(srvr *echo.Group) {
+	for _, path := range h.paths {
+		srvr.POST(path, func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		})
+	}
+}
+
+func TestServerCSRF(t *testing.T) {
+	e, err := newServer(&Config{}, nil, nil, []handler.Handler{
+		postHandler{
+			paths: []string{"/tx/batch", "/address/batch", "/block/batch", "/tx/decode", "/fee/estimate", "/rollup"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{path: "/v1/tx/batch", status: http.StatusOK},
+		{path: "/v1/address/batch", status: http.StatusOK},
+		{path: "/v1/block/batch", status: http.StatusOK},
+		{path: "/v1/tx/decode", status: http.StatusOK},
+		{path: "/v1/fee/estimate", status: http.StatusOK},
+		{path: "/v1/rollup", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+			require.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package liveness
+
+import (
+	"time"
+)
+
+// statuses of rollup liveness
+const (
+	StatusUnknown = "unknown"
+	StatusLive    = "live"
+	StatusStalled = "stalled"
+)
+
+// types of liveness events
+const (
+	EventRollupStalled = "rollup_stalled"
+	EventRollupResumed = "rollup_resumed"
+)
+
+// Liveness - state of rollup data submissions. Rollup is stalled if it does not post data longer than threshold computed from expected interval between submissions.
+type Liveness struct {
+	RollupId         uint64
+	Rollup           []byte
+	LastActionTime   time.Time
+	ExpectedInterval time.Duration
+	Configured       bool
+	Stalled          bool
+	StalledSince     time.Time
+	CheckedAt        time.Time
+}
+
+// Status - returns status of rollup liveness
+func (l Liveness) Status() string {
+	switch {
+	case l.LastActionTime.IsZero() || l.ExpectedInterval == 0:
+		return StatusUnknown
+	case l.Stalled:
+		return StatusStalled
+	default:
+		return StatusLive
+	}
+}
+
+// Delay - returns time passed since the last rollup data submission at the moment of the last check
+func (l Liveness) Delay() time.Duration {
+	if l.LastActionTime.IsZero() || l.CheckedAt.Before(l.LastActionTime) {
+		return 0
+	}
+	return l.CheckedAt.Sub(l.LastActionTime)
+}
+
+// Event - change of rollup liveness status
+type Event struct {
+	Type string
+	Time time.Time
+	Liveness
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package liveness
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "astria_api"
+
+type metrics struct {
+	delay    *prometheus.GaugeVec
+	expected *prometheus.GaugeVec
+	stalled  *prometheus.GaugeVec
+}
+
+func newMetrics(registerer prometheus.Registerer) *metrics {
+	labels := []string{"rollup"}
+	m := &metrics{
+		delay: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rollup_seconds_since_last_submission",
+			Help:      "Seconds passed since the last rollup data submission",
+		}, labels),
+		expected: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rollup_expected_submission_interval_seconds",
+			Help:      "Expected interval between rollup data submissions in seconds",
+		}, labels),
+		stalled: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rollup_stalled",
+			Help:      "1 if rollup stopped posting data, otherwise 0",
+		}, labels),
+	}
+	registerer.MustRegister(m.delay, m.expected, m.stalled)
+	return m
+}
+
+func (m *metrics) observe(l Liveness) {
+	label := strconv.FormatUint(l.RollupId, 10)
+	if len(l.Rollup) > 0 {
+		label = base64.StdEncoding.EncodeToString(l.Rollup)
+	}
+
+	m.delay.WithLabelValues(label).Set(l.Delay().Seconds())
+	m.expected.WithLabelValues(label).Set(l.ExpectedInterval.Seconds())
+	if l.Stalled {
+		m.stalled.WithLabelValues(label).Set(1)
+	} else {
+		m.stalled.WithLabelValues(label).Set(0)
+	}
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package liveness
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultCheckInterval = 30 * time.Second
+	defaultWindow        = 24 * time.Hour
+	defaultMultiplier    = 3
+	defaultMinGap        = time.Minute
+	trackPeriod          = 30 * 24 * time.Hour
+	eventsBufferSize     = 1024
+)
+
+// Config - settings of liveness monitor. Expected intervals of applications are set in seconds by application slug and override intervals learned from statistics.
+type Config struct {
+	CheckInterval int            `validate:"omitempty,min=1" yaml:"check_interval"`
+	Window        int            `validate:"omitempty,min=1" yaml:"window"`
+	Multiplier    float64        `validate:"omitempty,min=1" yaml:"multiplier"`
+	MinGap        int            `validate:"omitempty,min=1" yaml:"min_gap"`
+	Apps          map[string]int `validate:"omitempty"       yaml:"apps"`
+}
+
+// Monitor - tracks the last data submission of each rollup and produces events when rollup stops or resumes posting data. Time is taken from the indexer head, so monitor does not raise alerts while indexer is catching up.
+type Monitor struct {
+	stats    storage.IStats
+	apps     storage.IApp
+	observer *bus.Observer
+	metrics  *metrics
+
+	checkInterval time.Duration
+	window        time.Duration
+	multiplier    float64
+	minGap        time.Duration
+	configured    map[string]time.Duration
+
+	mx        *sync.RWMutex
+	rollups   map[uint64]*Liveness
+	lastCheck time.Time
+	events    chan Event
+
+	wg *sync.WaitGroup
+}
+
+func NewMonitor(cfg Config, observer *bus.Observer, stats storage.IStats, apps storage.IApp, registerer prometheus.Registerer) *Monitor {
+	m := &Monitor{
+		stats:         stats,
+		apps:          apps,
+		observer:      observer,
+		checkInterval: defaultCheckInterval,
+		window:        defaultWindow,
+		multiplier:    defaultMultiplier,
+		minGap:        defaultMinGap,
+		configured:    make(map[string]time.Duration, len(cfg.Apps)),
+		mx:            new(sync.RWMutex),
+		rollups:       make(map[uint64]*Liveness),
+		events:        make(chan Event, eventsBufferSize),
+		wg:            new(sync.WaitGroup),
+	}
+	if cfg.CheckInterval > 0 {
+		m.checkInterval = time.Duration(cfg.CheckInterval) * time.Second
+	}
+	if cfg.Window > 0 {
+		m.window = time.Duration(cfg.Window) * time.Hour
+	}
+	if cfg.Multiplier > 0 {
+		m.multiplier = cfg.Multiplier
+	}
+	if cfg.MinGap > 0 {
+		m.minGap = time.Duration(cfg.MinGap) * time.Second
+	}
+	for slug, interval := range cfg.Apps {
+		m.configured[slug] = time.Duration(interval) * time.Second
+	}
+	if registerer != nil {
+		m.metrics = newMetrics(registerer)
+	}
+	return m
+}
+
+// Start - loads rollups submitted data for the last 30 days and starts listening of the indexer head
+func (m *Monitor) Start(ctx context.Context, repo storage.IState, indexerName string) error {
+	if err := m.loadConfigured(ctx); err != nil {
+		return errors.Wrap(err, "load configured applications")
+	}
+
+	state, err := repo.ByName(ctx, indexerName)
+	switch {
+	case err == nil:
+		cadence, err := m.stats.RollupCadence(ctx, state.LastTime.Add(-trackPeriod))
+		if err != nil {
+			return errors.Wrap(err, "receive rollup cadence")
+		}
+		m.update(cadence)
+		m.check(state.LastTime)
+	case !repo.IsNoRows(err):
+		return err
+	}
+
+	if m.observer == nil {
+		return nil
+	}
+
+	m.wg.Add(1)
+	go m.listen(ctx)
+	return nil
+}
+
+func (m *Monitor) Close() error {
+	m.wg.Wait()
+	return nil
+}
+
+// Events - returns channel of liveness events
+func (m *Monitor) Events() <-chan Event {
+	return m.events
+}
+
+// Get - returns liveness of the rollup
+func (m *Monitor) Get(rollupId uint64) (Liveness, bool) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	l, ok := m.rollups[rollupId]
+	if !ok {
+		return Liveness{}, false
+	}
+	return *l, true
+}
+
+func (m *Monitor) loadConfigured(ctx context.Context) error {
+	for slug, interval := range m.configured {
+		app, err := m.apps.BySlug(ctx, slug)
+		if err != nil {
+			if m.apps.IsNoRows(err) {
+				log.Warn().Str("slug", slug).Msg("unknown application in liveness config")
+				continue
+			}
+			return err
+		}
+
+		// configured interval is applied to every rollup of the application
+		rollups, err := m.apps.Rollups(ctx, app.Id)
+		if err != nil {
+			return err
+		}
+		for i := range rollups {
+			m.rollups[rollups[i].RollupId] = &Liveness{
+				RollupId:         rollups[i].RollupId,
+				ExpectedInterval: interval,
+				Configured:       true,
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Monitor) listen(ctx context.Context) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case state, ok := <-m.observer.Head():
+			if !ok {
+				return
+			}
+			if state.LastTime.Sub(m.lastCheck) < m.checkInterval {
+				continue
+			}
+			if err := m.refresh(ctx, state.LastTime); err != nil {
+				log.Err(err).Msg("check rollups liveness")
+			}
+		}
+	}
+}
+
+func (m *Monitor) refresh(ctx context.Context, now time.Time) error {
+	cadence, err := m.stats.RollupCadence(ctx, now.Add(-m.window))
+	if err != nil {
+		return err
+	}
+	m.update(cadence)
+	m.check(now)
+	return nil
+}
+
+// update - sets the last submission time of rollups and learns expected interval between submissions if it's not configured
+func (m *Monitor) update(cadence []storage.RollupCadence) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	for i := range cadence {
+		l, ok := m.rollups[cadence[i].RollupId]
+		if !ok {
+			l = &Liveness{
+				RollupId: cadence[i].RollupId,
+			}
+			m.rollups[cadence[i].RollupId] = l
+		}
+		if cadence[i].Rollup != nil {
+			l.Rollup = cadence[i].Rollup.AstriaId
+		}
+		if cadence[i].LastTime.After(l.LastActionTime) {
+			l.LastActionTime = cadence[i].LastTime
+		}
+		if !l.Configured && cadence[i].ActionsCount > 1 {
+			l.ExpectedInterval = cadence[i].LastTime.Sub(cadence[i].FirstTime) / time.Duration(cadence[i].ActionsCount-1)
+		}
+	}
+}
+
+// check - compares time passed since the last submission with threshold and emits events on status change
+func (m *Monitor) check(now time.Time) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.lastCheck = now
+	for _, l := range m.rollups {
+		l.CheckedAt = now
+		if l.Status() == StatusUnknown {
+			continue
+		}
+
+		threshold := m.threshold(l.ExpectedInterval)
+		delay := l.Delay()
+		switch {
+		case delay > threshold && !l.Stalled:
+			l.Stalled = true
+			l.StalledSince = l.LastActionTime.Add(threshold)
+			m.emit(EventRollupStalled, now, *l)
+		case delay <= threshold && l.Stalled:
+			l.Stalled = false
+			l.StalledSince = time.Time{}
+			m.emit(EventRollupResumed, now, *l)
+		}
+
+		if m.metrics != nil {
+			m.metrics.observe(*l)
+		}
+	}
+}
+
+// threshold - returns duration without submissions after which rollup is considered stalled
+func (m *Monitor) threshold(expected time.Duration) time.Duration {
+	threshold := time.Duration(float64(expected) * m.multiplier)
+	if threshold < m.minGap {
+		return m.minGap
+	}
+	return threshold
+}
+
+func (m *Monitor) emit(typ string, now time.Time, l Liveness) {
+	log.Info().
+		Str("type", typ).
+		Uint64("rollup_id", l.RollupId).
+		Time("last_action_time", l.LastActionTime).
+		Msg("rollup liveness changed")
+
+	select {
+	case m.events <- Event{Type: typ, Time: now, Liveness: l}:
+	default:
+		log.Warn().Str("type", typ).Uint64("rollup_id", l.RollupId).Msg("liveness events buffer is full")
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package liveness
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestMonitorLearnedCadence(t *testing.T) {
+	m := NewMonitor(Config{}, nil, nil, nil, nil)
+
+	m.update([]storage.RollupCadence{
+		{
+			RollupId:     1,
+			ActionsCount: 11,
+			FirstTime:    testTime,
+			LastTime:     testTime.Add(100 * time.Second),
+			Rollup:       &storage.Rollup{AstriaId: []byte{1, 2, 3}},
+		}, {
+			RollupId:     2,
+			ActionsCount: 1,
+			FirstTime:    testTime,
+			LastTime:     testTime,
+		},
+	})
+
+	m.check(testTime.Add(110 * time.Second))
+
+	l, ok := m.Get(1)
+	require.True(t, ok)
+	require.Equal(t, 10*time.Second, l.ExpectedInterval)
+	require.Equal(t, StatusLive, l.Status())
+	require.Equal(t, 10*time.Second, l.Delay())
+	require.Equal(t, []byte{1, 2, 3}, l.Rollup)
+
+	l, ok = m.Get(2)
+	require.True(t, ok)
+	require.Equal(t, StatusUnknown, l.Status())
+
+	_, ok = m.Get(3)
+	require.False(t, ok)
+	require.Len(t, m.Events(), 0)
+}
+
+func TestMonitorStalledAndResumed(t *testing.T) {
+	m := NewMonitor(Config{
+		Multiplier: 2,
+		MinGap:     10,
+	}, nil, nil, nil, nil)
+
+	m.update([]storage.RollupCadence{
+		{
+			RollupId:     1,
+			ActionsCount: 3,
+			FirstTime:    testTime,
+			LastTime:     testTime.Add(60 * time.Second),
+		},
+	})
+
+	// threshold is 2 * 30 seconds
+	m.check(testTime.Add(120 * time.Second))
+	l, _ := m.Get(1)
+	require.Equal(t, StatusLive, l.Status())
+	require.Len(t, m.Events(), 0)
+
+	m.check(testTime.Add(121 * time.Second))
+	l, _ = m.Get(1)
+	require.Equal(t, StatusStalled, l.Status())
+	require.Equal(t, testTime.Add(120*time.Second), l.StalledSince)
+	require.Len(t, m.Events(), 1)
+
+	event := <-m.Events()
+	require.Equal(t, EventRollupStalled, event.Type)
+	require.EqualValues(t, 1, event.RollupId)
+	require.Equal(t, testTime.Add(121*time.Second), event.Time)
+
+	// the second check of stalled rollup does not produce event
+	m.check(testTime.Add(200 * time.Second))
+	require.Len(t, m.Events(), 0)
+
+	m.update([]storage.RollupCadence{
+		{
+			RollupId:     1,
+			ActionsCount: 4,
+			FirstTime:    testTime,
+			LastTime:     testTime.Add(210 * time.Second),
+		},
+	})
+	m.check(testTime.Add(215 * time.Second))
+
+	l, _ = m.Get(1)
+	require.Equal(t, StatusLive, l.Status())
+	require.True(t, l.StalledSince.IsZero())
+	require.Len(t, m.Events(), 1)
+
+	event = <-m.Events()
+	require.Equal(t, EventRollupResumed, event.Type)
+	require.Equal(t, testTime.Add(210*time.Second), event.LastActionTime)
+}
+
+func TestMonitorMinGap(t *testing.T) {
+	m := NewMonitor(Config{MinGap: 60}, nil, nil, nil, nil)
+	require.Equal(t, time.Minute, m.threshold(time.Second))
+	require.Equal(t, 3*time.Minute, m.threshold(time.Minute))
+}
+
+func TestMonitorStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stats := mock.NewMockIStats(ctrl)
+	apps := mock.NewMockIApp(ctrl)
+	states := mock.NewMockIState(ctrl)
+	registry := prometheus.NewRegistry()
+
+	m := NewMonitor(Config{
+		Apps: map[string]int{
+			"app":     600,
+			"unknown": 10,
+		},
+	}, nil, stats, apps, registry)
+
+	apps.EXPECT().
+		BySlug(gomock.Any(), "app").
+		Return(storage.AppWithStats{App: storage.App{Id: 5, RollupId: 1}}, nil).
+		Times(1)
+	apps.EXPECT().
+		Rollups(gomock.Any(), uint64(5)).
+		Return([]storage.AppRollup{
+			{AppId: 5, RollupId: 1, Role: storage.AppRolePrimary},
+			{AppId: 5, RollupId: 2, Role: "testnet"},
+		}, nil).
+		Times(1)
+	apps.EXPECT().
+		BySlug(gomock.Any(), "unknown").
+		Return(storage.AppWithStats{}, sql.ErrNoRows).
+		Times(1)
+	apps.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	states.EXPECT().
+		ByName(gomock.Any(), "indexer").
+		Return(storage.State{LastTime: testTime}, nil).
+		Times(1)
+
+	stats.EXPECT().
+		RollupCadence(gomock.Any(), testTime.Add(-trackPeriod)).
+		Return([]storage.RollupCadence{
+			{
+				RollupId:     1,
+				ActionsCount: 100,
+				FirstTime:    testTime.Add(-time.Hour),
+				LastTime:     testTime.Add(-time.Hour),
+				Rollup:       &storage.Rollup{AstriaId: []byte{1}},
+			},
+		}, nil).
+		Times(1)
+
+	require.NoError(t, m.Start(context.Background(), states, "indexer"))
+	defer func() {
+		require.NoError(t, m.Close())
+	}()
+
+	l, ok := m.Get(1)
+	require.True(t, ok)
+	require.True(t, l.Configured)
+	require.Equal(t, 10*time.Minute, l.ExpectedInterval)
+	require.Equal(t, StatusStalled, l.Status())
+
+	// linked rollup of the application uses the configured interval too
+	l, ok = m.Get(2)
+	require.True(t, ok)
+	require.True(t, l.Configured)
+	require.Equal(t, 10*time.Minute, l.ExpectedInterval)
+	require.Len(t, m.Events(), 1)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Equal(t, 1.0, gaugeValue(t, families, "astria_api_rollup_stalled", "AQ=="))
+	require.Equal(t, 3600.0, gaugeValue(t, families, "astria_api_rollup_seconds_since_last_submission", "AQ=="))
+	require.Equal(t, 600.0, gaugeValue(t, families, "astria_api_rollup_expected_submission_interval_seconds", "AQ=="))
+}
+
+func gaugeValue(t *testing.T, families []*dto.MetricFamily, name, rollup string) float64 {
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "rollup" && label.GetValue() == rollup {
+					return metric.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	t.Fatalf("metric %s of rollup %s is not found", name, rollup)
+	return 0
+}
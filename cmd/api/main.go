@@ -49,7 +49,7 @@ func main() {
 			newProflier,
 			fx.Annotate(
 				newServer,
-				fx.ParamTags("", "", `group:"handlers"`),
+				fx.ParamTags("", "", "", `group:"handlers"`),
 			),
 			bus.NewDispatcher,
 			fx.Annotate(
@@ -57,6 +57,11 @@ func main() {
 				fx.ParamTags(`name:"cache_url"`),
 			),
 			newConstantCache,
+			newCacheInvalidator,
+			newFinality,
+			newLivenessMonitor,
+			newRateLimiter,
+			newTxDecoder,
 			newWebsocket,
 			newApp,
 
@@ -145,6 +150,22 @@ func main() {
 				postgres.NewMarket,
 				fx.As(new(storage.IMarket)),
 			),
+			fx.Annotate(
+				postgres.NewConstantHistory,
+				fx.As(new(storage.IConstantHistory)),
+			),
+			fx.Annotate(
+				postgres.NewRollbackEvent,
+				fx.As(new(storage.IRollbackEvent)),
+			),
+			fx.Annotate(
+				postgres.NewApiKey,
+				fx.As(new(storage.IApiKey)),
+			),
+			fx.Annotate(
+				postgres.NewApiKeyUsage,
+				fx.As(new(storage.IApiKeyUsage)),
+			),
 			fx.Annotate(
 				newCelestials,
 				fx.As(new(celestialsStorage.ICelestial)),
@@ -163,6 +184,9 @@ func main() {
 			AsHandler(handler.NewTxHandler),
 			AsHandler(handler.NewPriceHandler),
 			AsHandler(handler.NewActionHandler),
+			AsHandler(handler.NewFeeHandler),
+			AsHandler(handler.NewRollbackHandler),
+			AsHandler(handler.NewLivenessHandler),
 		),
 		fx.Invoke(func(*App) {}),
 	)
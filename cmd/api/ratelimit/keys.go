@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+const maxCachedKeys = 10_000
+
+type keyEntry struct {
+	key       *storage.ApiKey
+	expiresAt time.Time
+}
+
+// keys - short-living cache of API keys by raw value. Unknown keys are cached too, so invalid keys do not hit the database on every request.
+type keys struct {
+	repo    storage.IApiKey
+	ttl     time.Duration
+	mx      *sync.RWMutex
+	entries map[string]keyEntry
+}
+
+func newKeys(repo storage.IApiKey, ttl time.Duration) *keys {
+	return &keys{
+		repo:    repo,
+		ttl:     ttl,
+		mx:      new(sync.RWMutex),
+		entries: make(map[string]keyEntry),
+	}
+}
+
+// cached - returns API key by its raw value from the cache only. The second value is false if the key is not cached or the entry is expired.
+func (k *keys) cached(raw string, now time.Time) (*storage.ApiKey, bool) {
+	k.mx.RLock()
+	entry, ok := k.entries[storage.HashKey(raw)]
+	k.mx.RUnlock()
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.key, true
+}
+
+// get - returns API key by its raw value. Returns nil if key is not found.
+func (k *keys) get(ctx context.Context, raw string, now time.Time) (*storage.ApiKey, error) {
+	if key, ok := k.cached(raw, now); ok {
+		return key, nil
+	}
+
+	hash := storage.HashKey(raw)
+	entry := keyEntry{
+		expiresAt: now.Add(k.ttl),
+	}
+	key, err := k.repo.ByHash(ctx, hash)
+	switch {
+	case err == nil:
+		entry.key = &key
+	case !k.repo.IsNoRows(err):
+		return nil, err
+	}
+
+	k.mx.Lock()
+	if len(k.entries) >= maxCachedKeys {
+		k.entries = make(map[string]keyEntry)
+	}
+	k.entries[hash] = entry
+	k.mx.Unlock()
+	return entry.key, nil
+}
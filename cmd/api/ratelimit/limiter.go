@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// headers of API key limits
+const (
+	HeaderApiKey = "X-API-Key"
+
+	HeaderLimit          = "X-RateLimit-Limit"
+	HeaderRemaining      = "X-RateLimit-Remaining"
+	HeaderReset          = "X-RateLimit-Reset"
+	HeaderDailyLimit     = "X-RateLimit-Daily-Limit"
+	HeaderDailyRemaining = "X-RateLimit-Daily-Remaining"
+	HeaderDailyReset     = "X-RateLimit-Daily-Reset"
+)
+
+// AuthenticatedKey - context key of the authenticated API key. It is set only for valid keys, so requests with unknown keys are not treated as authenticated.
+const AuthenticatedKey = "api_key"
+
+const (
+	defaultFlushInterval = 30 * time.Second
+	defaultKeysTtl       = time.Minute
+	defaultRate          = 10
+	defaultDailyQuota    = 100_000
+
+	rateWindowTtl  = 2 * time.Second
+	quotaWindowTtl = 25 * time.Hour
+)
+
+// Config - settings of API keys. Intervals are set in seconds.
+type Config struct {
+	Enabled       bool `validate:"omitempty"       yaml:"enabled"`
+	Required      bool `validate:"omitempty"       yaml:"required"`
+	FlushInterval int  `validate:"omitempty,min=1" yaml:"flush_interval"`
+	KeysTtl       int  `validate:"omitempty,min=1" yaml:"keys_ttl"`
+
+	DefaultRate       int64 `validate:"omitempty,min=1" yaml:"default_rate"`
+	DefaultDailyQuota int64 `validate:"omitempty,min=1" yaml:"default_daily_quota"`
+}
+
+// Limiter - authenticates requests by API key and applies limits of the key tier. Per-second rate and daily quota are counted in fixed windows in the store, so limits are shared between API instances if the store is shared. Usage of keys is accumulated in memory and flushed to the database periodically.
+type Limiter struct {
+	store    Store
+	keys     *keys
+	usage    *usage
+	repo     storage.IApiKeyUsage
+	required bool
+
+	// defaultTier - limits of keys without tier
+	defaultTier *storage.ApiTier
+
+	flushInterval time.Duration
+
+	now func() time.Time
+	wg  *sync.WaitGroup
+}
+
+func NewLimiter(cfg Config, store Store, apiKeys storage.IApiKey, apiKeyUsage storage.IApiKeyUsage) *Limiter {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	keysTtl := defaultKeysTtl
+	if cfg.KeysTtl > 0 {
+		keysTtl = time.Duration(cfg.KeysTtl) * time.Second
+	}
+	l := &Limiter{
+		store:    store,
+		keys:     newKeys(apiKeys, keysTtl),
+		usage:    newUsage(),
+		repo:     apiKeyUsage,
+		required: cfg.Required,
+		defaultTier: &storage.ApiTier{
+			Rate:       defaultRate,
+			DailyQuota: defaultDailyQuota,
+		},
+		flushInterval: defaultFlushInterval,
+		now:           func() time.Time { return time.Now().UTC() },
+		wg:            new(sync.WaitGroup),
+	}
+	if cfg.FlushInterval > 0 {
+		l.flushInterval = time.Duration(cfg.FlushInterval) * time.Second
+	}
+	if cfg.DefaultRate > 0 {
+		l.defaultTier.Rate = cfg.DefaultRate
+	}
+	if cfg.DefaultDailyQuota > 0 {
+		l.defaultTier.DailyQuota = cfg.DefaultDailyQuota
+	}
+	return l
+}
+
+func (l *Limiter) Start(ctx context.Context) {
+	l.wg.Add(1)
+	go l.work(ctx)
+}
+
+// Close - waits for the worker and flushes the rest of usage
+func (l *Limiter) Close() error {
+	l.wg.Wait()
+	return l.flush(context.Background())
+}
+
+func (l *Limiter) work(ctx context.Context) {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.flush(ctx); err != nil {
+				log.Err(err).Msg("flush api key usage")
+			}
+		}
+	}
+}
+
+func (l *Limiter) flush(ctx context.Context) error {
+	usage := l.usage.take()
+	if len(usage) == 0 {
+		return nil
+	}
+	return l.repo.Add(ctx, usage...)
+}
+
+// IsAuthenticated - returns true if request was authenticated by valid API key
+func IsAuthenticated(c echo.Context) bool {
+	_, ok := c.Get(AuthenticatedKey).(*storage.ApiKey)
+	return ok
+}
+
+// Authenticate - marks request as authenticated if its API key is valid and already cached. It does not query the database, so it can be placed before IP rate limiter: unknown keys and keys missing in the cache are limited by IP before the database lookup in Middleware.
+func (l *Limiter) Authenticate(skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+
+			raw := c.Request().Header.Get(HeaderApiKey)
+			if raw == "" {
+				return next(c)
+			}
+
+			now := l.now()
+			if key, ok := l.keys.cached(raw, now); ok && key != nil && key.IsValid(now) {
+				c.Set(AuthenticatedKey, key)
+			}
+			return next(c)
+		}
+	}
+}
+
+// Middleware - authenticates request by API key and applies limits of the key tier. Valid key is stored in the context by AuthenticatedKey.
+func (l *Limiter) Middleware(skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+
+			raw := c.Request().Header.Get(HeaderApiKey)
+			if raw == "" {
+				if l.required {
+					return echo.NewHTTPError(http.StatusUnauthorized, "api key is required")
+				}
+				return next(c)
+			}
+
+			now := l.now()
+			key, ok := c.Get(AuthenticatedKey).(*storage.ApiKey)
+			if !ok {
+				var err error
+				key, err = l.keys.get(c.Request().Context(), raw, now)
+				if err != nil {
+					return errors.Wrap(err, "receive api key")
+				}
+				if key == nil || !key.IsValid(now) {
+					return echo.NewHTTPError(http.StatusUnauthorized, "invalid api key")
+				}
+				c.Set(AuthenticatedKey, key)
+			}
+
+			day := now.Truncate(24 * time.Hour)
+			if err := l.check(c, key, now, day); err != nil {
+				l.usage.add(key.Id, day, c.Path(), true)
+				return err
+			}
+			l.usage.add(key.Id, day, c.Path(), false)
+			return next(c)
+		}
+	}
+}
+
+// check - increments counters of the key and sets limit headers. Returns error if any limit is exceeded.
+func (l *Limiter) check(c echo.Context, key *storage.ApiKey, now, day time.Time) error {
+	tier := key.Tier
+	if tier == nil {
+		tier = l.defaultTier
+	}
+
+	ctx := c.Request().Context()
+	header := c.Response().Header()
+
+	if tier.Rate > 0 {
+		count, err := l.store.Increment(ctx, fmt.Sprintf("ratelimit:%d:%d", key.Id, now.Unix()), rateWindowTtl)
+		if err != nil {
+			// limits are not applied if the store is unavailable to keep API responsive
+			log.Err(err).Uint64("api_key_id", key.Id).Msg("increment rate counter")
+			return nil
+		}
+		header.Set(HeaderLimit, strconv.FormatInt(tier.Rate, 10))
+		header.Set(HeaderRemaining, strconv.FormatInt(max(tier.Rate-count, 0), 10))
+		header.Set(HeaderReset, strconv.FormatInt(now.Unix()+1, 10))
+		if count > tier.Rate {
+			header.Set(echo.HeaderRetryAfter, "1")
+			return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+		}
+	}
+
+	if tier.DailyQuota > 0 {
+		count, err := l.store.Increment(ctx, fmt.Sprintf("quota:%d:%s", key.Id, day.Format(time.DateOnly)), quotaWindowTtl)
+		if err != nil {
+			log.Err(err).Uint64("api_key_id", key.Id).Msg("increment quota counter")
+			return nil
+		}
+		reset := day.Add(24 * time.Hour)
+		header.Set(HeaderDailyLimit, strconv.FormatInt(tier.DailyQuota, 10))
+		header.Set(HeaderDailyRemaining, strconv.FormatInt(max(tier.DailyQuota-count, 0), 10))
+		header.Set(HeaderDailyReset, strconv.FormatInt(reset.Unix(), 10))
+		if count > tier.DailyQuota {
+			header.Set(echo.HeaderRetryAfter, strconv.FormatInt(int64(reset.Sub(now).Seconds())+1, 10))
+			return echo.NewHTTPError(http.StatusTooManyRequests, "daily quota exceeded")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/time/rate"
+)
+
+var testTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type limiterMocks struct {
+	apiKeys *mock.MockIApiKey
+	usage   *mock.MockIApiKeyUsage
+}
+
+func newTestLimiter(t *testing.T, cfg Config) (*Limiter, *echo.Echo, limiterMocks) {
+	ctrl := gomock.NewController(t)
+	m := limiterMocks{
+		apiKeys: mock.NewMockIApiKey(ctrl),
+		usage:   mock.NewMockIApiKeyUsage(ctrl),
+	}
+	limiter := NewLimiter(cfg, nil, m.apiKeys, m.usage)
+	limiter.now = func() time.Time { return testTime }
+
+	e := echo.New()
+	e.Use(limiter.Middleware(nil))
+	e.GET("/v1/block/:height", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	return limiter, e, m
+}
+
+func request(e *echo.Echo, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/v1/block/100", nil)
+	if key != "" {
+		req.Header.Set(HeaderApiKey, key)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLimiterWithoutKey(t *testing.T) {
+	_, e, _ := newTestLimiter(t, Config{})
+	rec := request(e, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get(HeaderLimit))
+
+	_, e, _ = newTestLimiter(t, Config{Required: true})
+	rec = request(e, "")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestLimiterInvalidKey(t *testing.T) {
+	_, e, m := newTestLimiter(t, Config{})
+
+	m.apiKeys.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("ak_unknown")).
+		Return(storage.ApiKey{}, sql.ErrNoRows).
+		Times(1)
+	m.apiKeys.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	// the second request is served from the keys cache
+	for range 2 {
+		rec := request(e, "ak_unknown")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+
+	expired := testTime.Add(-time.Hour)
+	m.apiKeys.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("ak_expired")).
+		Return(storage.ApiKey{Id: 2, Active: true, ExpiresAt: &expired}, nil).
+		Times(1)
+	rec := request(e, "ak_expired")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestLimiterInvalidKeyIsLimitedByIp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := limiterMocks{
+		apiKeys: mock.NewMockIApiKey(ctrl),
+		usage:   mock.NewMockIApiKeyUsage(ctrl),
+	}
+	limiter := NewLimiter(Config{}, nil, m.apiKeys, m.usage)
+	limiter.now = func() time.Time { return testTime }
+
+	// the same chain as in API server: API key limiter is split around IP limiter
+	e := echo.New()
+	e.Use(
+		limiter.Authenticate(nil),
+		middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+			Skipper: IsAuthenticated,
+			Store:   middleware.NewRateLimiterMemoryStore(rate.Limit(1)),
+		}),
+		limiter.Middleware(nil),
+	)
+	e.GET("/v1/block/:height", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	m.apiKeys.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("ak_unknown")).
+		Return(storage.ApiKey{}, sql.ErrNoRows).
+		Times(1)
+	m.apiKeys.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	rec := request(e, "ak_unknown")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// invalid key does not bypass IP limiter
+	rec = request(e, "ak_unknown")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	// the key which is not cached yet is limited by IP before the database lookup
+	rec = request(e, "ak_test")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	limiter.keys.entries[storage.HashKey("ak_test")] = keyEntry{
+		key: &storage.ApiKey{
+			Id:     1,
+			Active: true,
+			Tier:   &storage.ApiTier{Rate: 100},
+		},
+		expiresAt: testTime.Add(time.Minute),
+	}
+	for range 3 {
+		rec = request(e, "ak_test")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "100", rec.Header().Get(HeaderLimit))
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	limiter, e, m := newTestLimiter(t, Config{})
+
+	m.apiKeys.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("ak_test")).
+		Return(storage.ApiKey{
+			Id:     1,
+			Active: true,
+			Tier:   &storage.ApiTier{Rate: 2},
+		}, nil).
+		Times(1)
+
+	for i := range 2 {
+		rec := request(e, "ak_test")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "2", rec.Header().Get(HeaderLimit))
+		require.Equal(t, strconv.Itoa(1-i), rec.Header().Get(HeaderRemaining))
+		require.Equal(t, strconv.FormatInt(testTime.Unix()+1, 10), rec.Header().Get(HeaderReset))
+		require.Empty(t, rec.Header().Get(HeaderDailyLimit))
+	}
+
+	rec := request(e, "ak_test")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "0", rec.Header().Get(HeaderRemaining))
+	require.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// the next second opens a new window
+	limiter.now = func() time.Time { return testTime.Add(time.Second) }
+	rec = request(e, "ak_test")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "1", rec.Header().Get(HeaderRemaining))
+
+	m.usage.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, usage ...storage.ApiKeyUsage) error {
+			require.Len(t, usage, 1)
+			require.EqualValues(t, 1, usage[0].ApiKeyId)
+			require.Equal(t, "/v1/block/:height", usage[0].Endpoint)
+			require.Equal(t, testTime.Truncate(24*time.Hour), usage[0].Day)
+			require.EqualValues(t, 3, usage[0].Requests)
+			require.EqualValues(t, 1, usage[0].Rejected)
+			return nil
+		}).
+		Times(1)
+	require.NoError(t, limiter.flush(context.Background()))
+
+	// nothing to flush
+	require.NoError(t, limiter.flush(context.Background()))
+}
+
+func TestLimiterDailyQuota(t *testing.T) {
+	_, e, m := newTestLimiter(t, Config{})
+
+	m.apiKeys.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("ak_test")).
+		Return(storage.ApiKey{
+			Id:     1,
+			Active: true,
+			Tier:   &storage.ApiTier{DailyQuota: 1},
+		}, nil).
+		Times(1)
+
+	reset := strconv.FormatInt(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC).Unix(), 10)
+
+	rec := request(e, "ak_test")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get(HeaderLimit))
+	require.Equal(t, "1", rec.Header().Get(HeaderDailyLimit))
+	require.Equal(t, "0", rec.Header().Get(HeaderDailyRemaining))
+	require.Equal(t, reset, rec.Header().Get(HeaderDailyReset))
+
+	rec = request(e, "ak_test")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "43201", rec.Header().Get(echo.HeaderRetryAfter))
+}
+
+func TestLimiterDefaultTier(t *testing.T) {
+	_, e, m := newTestLimiter(t, Config{DefaultRate: 1})
+
+	m.apiKeys.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("ak_test")).
+		Return(storage.ApiKey{
+			Id:     1,
+			Active: true,
+		}, nil).
+		Times(1)
+
+	rec := request(e, "ak_test")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "1", rec.Header().Get(HeaderLimit))
+	require.Equal(t, strconv.Itoa(defaultDailyQuota), rec.Header().Get(HeaderDailyLimit))
+
+	rec = request(e, "ak_test")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	now := testTime
+	store.now = func() time.Time { return now }
+
+	for i := range 3 {
+		value, err := store.Increment(context.Background(), "key", time.Second)
+		require.NoError(t, err)
+		require.EqualValues(t, i+1, value)
+	}
+
+	now = now.Add(time.Second)
+	value, err := store.Increment(context.Background(), "key", time.Second)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, value)
+
+	now = now.Add(2 * time.Minute)
+	value, err = store.Increment(context.Background(), "other", time.Second)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, value)
+	require.Len(t, store.counters, 1)
+}
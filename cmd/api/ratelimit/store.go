@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
+)
+
+const cleanupInterval = time.Minute
+
+// Store - storage of request counters shared between limiter instances
+type Store interface {
+	// Increment - increments counter by the key and returns its new value. Counter is removed after ttl since its creation.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+}
+
+var _ Store = (*cache.ValKey)(nil)
+var _ Store = (*MemoryStore)(nil)
+
+type counter struct {
+	value     int64
+	expiresAt time.Time
+}
+
+// MemoryStore - in-memory counters which are used when shared cache is not configured. Expired counters are removed lazily.
+type MemoryStore struct {
+	mx          *sync.Mutex
+	counters    map[string]*counter
+	lastCleanup time.Time
+
+	now func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		mx:       new(sync.Mutex),
+		counters: make(map[string]*counter),
+		now:      time.Now,
+	}
+}
+
+func (s *MemoryStore) Increment(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastCleanup) > cleanupInterval {
+		for k, c := range s.counters {
+			if !now.Before(c.expiresAt) {
+				delete(s.counters, k)
+			}
+		}
+		s.lastCleanup = now
+	}
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.expiresAt) {
+		c = &counter{
+			expiresAt: now.Add(ttl),
+		}
+		s.counters[key] = c
+	}
+	c.value += 1
+	return c.value, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+type usageKey struct {
+	apiKeyId uint64
+	day      time.Time
+	endpoint string
+}
+
+// usage - accumulates requests of API keys in memory until they are flushed to the database
+type usage struct {
+	mx       *sync.Mutex
+	counters map[usageKey]*storage.ApiKeyUsage
+}
+
+func newUsage() *usage {
+	return &usage{
+		mx:       new(sync.Mutex),
+		counters: make(map[usageKey]*storage.ApiKeyUsage),
+	}
+}
+
+func (u *usage) add(apiKeyId uint64, day time.Time, endpoint string, rejected bool) {
+	key := usageKey{
+		apiKeyId: apiKeyId,
+		day:      day,
+		endpoint: endpoint,
+	}
+
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	counter, ok := u.counters[key]
+	if !ok {
+		counter = &storage.ApiKeyUsage{
+			ApiKeyId: apiKeyId,
+			Day:      day,
+			Endpoint: endpoint,
+		}
+		u.counters[key] = counter
+	}
+	if rejected {
+		counter.Rejected += 1
+	} else {
+		counter.Requests += 1
+	}
+}
+
+// take - returns accumulated counters and resets them
+func (u *usage) take() []storage.ApiKeyUsage {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	if len(u.counters) == 0 {
+		return nil
+	}
+
+	result := make([]storage.ApiKeyUsage, 0, len(u.counters))
+	for _, counter := range u.counters {
+		result = append(result, *counter)
+	}
+	u.counters = make(map[usageKey]*storage.ApiKeyUsage)
+	return result
+}
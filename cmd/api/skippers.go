@@ -13,16 +13,6 @@ func websocketSkipper(c echo.Context) bool {
 	return strings.Contains(c.Request().URL.Path, "ws")
 }
 
-func postSkipper(c echo.Context) bool {
-	if strings.Contains(c.Request().URL.Path, "blob") {
-		return true
-	}
-	if strings.Contains(c.Request().URL.Path, "auth/rollup") {
-		return true
-	}
-	return false
-}
-
 func gzipSkipper(c echo.Context) bool {
 	if strings.Contains(c.Request().URL.Path, "swagger") {
 		return true
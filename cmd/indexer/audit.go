@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/pkg/indexer/audit"
+	"github.com/celenium-io/astria-indexer/pkg/node/rpc"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditSample float64
+	auditLimit  int
+	auditRepair bool
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Check consistency of indexed balances and counters",
+	Long: `Compares balances, nonces and counters of addresses, rollups and state with values recalculated from balance updates, actions and transactions.
+Sampled addresses and bridges are compared with the node state by ABCI queries at the indexed head: --sample=1 checks all of them, --sample=0 disables node checks.
+With --repair found mismatches are fixed in a single database transaction. The indexer should be stopped while repair is running.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAudit(audit.Config{
+			SampleRate: auditSample,
+			Limit:      auditLimit,
+			Repair:     auditRepair,
+		})
+	},
+}
+
+func init() {
+	auditCmd.Flags().Float64Var(&auditSample, "sample", 0.01, "share of addresses and bridges compared with the node, from 0 to 1")
+	auditCmd.Flags().IntVar(&auditLimit, "limit", 0, "maximum count of mismatches of each kind received from the database. 0 means no limit")
+	auditCmd.Flags().BoolVar(&auditRepair, "repair", false, "fix found mismatches")
+
+	rootCmd.AddCommand(auditCmd)
+}
+
+func runAudit(cfg audit.Config) error {
+	if cfg.SampleRate < 0 || cfg.SampleRate > 1 {
+		return errors.Errorf("invalid sample rate: %f", cfg.SampleRate)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	indexerCfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	db, err := newDatabase(indexerCfg)
+	if err != nil {
+		return errors.Wrap(err, "create database")
+	}
+	defer db.Close()
+
+	api := rpc.NewAPI(indexerCfg.DataSources["sequencer_rpc"])
+	auditor := audit.New(
+		db.Transactable,
+		&api,
+		postgres.NewAudit(db),
+		postgres.NewState(db),
+		postgres.NewBridge(db),
+		indexerCfg.Indexer.Name,
+		cfg,
+	)
+
+	report, err := auditor.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range report.Mismatches {
+		fmt.Println(report.Mismatches[i].String())
+	}
+	fmt.Printf("height %d: found %d mismatches\n", report.Height, len(report.Mismatches))
+	if report.Repaired {
+		fmt.Println("mismatches are repaired")
+	}
+	return nil
+}
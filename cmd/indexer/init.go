@@ -19,7 +19,11 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+var configPath string
+
 func init() {
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "dipdup.yml", "path to YAML config file")
+
 	zerolog.CallerMarshalFunc = func(pc uintptr, file string, line int) string {
 		short := file
 		for i := len(file) - 1; i > 0; i-- {
@@ -41,17 +45,8 @@ func init() {
 }
 
 func loadConfig() (*config.Config, error) {
-	configPath := rootCmd.PersistentFlags().StringP("config", "c", "dipdup.yml", "path to YAML config file")
-	if err := rootCmd.Execute(); err != nil {
-		return nil, errors.Wrap(err, "command line execute")
-	}
-
-	if err := rootCmd.MarkFlagRequired("config"); err != nil {
-		return nil, errors.Wrap(err, "config command line arg is required")
-	}
-
 	var cfg config.Config
-	if err := dipdupCfg.Parse(*configPath, &cfg); err != nil {
+	if err := dipdupCfg.Parse(configPath, &cfg); err != nil {
 		return nil, errors.Wrap(err, "parsing config file")
 	}
 
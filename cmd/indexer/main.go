@@ -24,9 +24,19 @@ import (
 var rootCmd = &cobra.Command{
 	Use:   "indexer",
 	Short: "DipDup Verticals | Astria Indexer",
+	Run: func(cmd *cobra.Command, args []string) {
+		run()
+	},
 }
 
 func main() {
+	if err := rootCmd.Execute(); err != nil {
+		log.Err(err).Msg("command line execute")
+		os.Exit(1)
+	}
+}
+
+func run() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 
 	app := fx.New(
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/celenium-io/astria-indexer/pkg/indexer"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules/stopper"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reindexFrom    int64
+	reindexTo      int64
+	reindexDryRun  bool
+	reindexConfirm bool
+)
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Re-index blocks from the height up to the head without dropping the database",
+	Long: `DESTRUCTIVE: rolls back ALL indexed data from the head down to --from, then fetches and parses blocks from --from up to the previous head again and recalculates counters of affected addresses and rollups.
+Reindexing is always made up to the indexed head, so --to can only be used with --dry-run. The command requires --confirm to change the database. The indexer must be stopped while reindex is running.
+With --dry-run blocks from --from to --to are only parsed and compared with indexed data, the database is not changed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return reindex(types.Level(reindexFrom), types.Level(reindexTo), reindexDryRun, reindexConfirm)
+	},
+}
+
+func init() {
+	reindexCmd.Flags().Int64Var(&reindexFrom, "from", 0, "first height of the range")
+	reindexCmd.Flags().Int64Var(&reindexTo, "to", 0, "last height of the range for --dry-run. Indexed head is used if it's not set")
+	reindexCmd.Flags().BoolVar(&reindexDryRun, "dry-run", false, "report differences between indexed and re-parsed data without changes in the database")
+	reindexCmd.Flags().BoolVar(&reindexConfirm, "confirm", false, "confirm removal of all indexed data from --from up to the head")
+	if err := reindexCmd.MarkFlagRequired("from"); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(reindexCmd)
+}
+
+func reindex(from, to types.Level, dryRun, confirm bool) error {
+	if !dryRun {
+		if to > 0 {
+			return errors.New("--to is supported only with --dry-run: reindex is made up to the indexed head")
+		}
+		if !confirm {
+			return errors.New("reindex removes all indexed data from --from up to the head, pass --confirm to proceed")
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	db, err := newDatabase(cfg)
+	if err != nil {
+		return errors.Wrap(err, "create database")
+	}
+	defer db.Close()
+
+	idx, err := indexer.New(cfg, db, stopper.NewModule(cancel))
+	if err != nil {
+		return errors.Wrap(err, "create indexer")
+	}
+
+	if !dryRun {
+		return idx.Reindex(ctx, from)
+	}
+
+	changes, err := idx.ReindexDiff(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	for i := range changes {
+		fmt.Println(changes[i].String())
+	}
+	fmt.Printf("found %d changes\n", len(changes))
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/cmd/private_api/webhook"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
@@ -14,8 +16,11 @@ import (
 )
 
 type App struct {
-	e  *echo.Echo
-	db *postgres.Storage
+	e          *echo.Echo
+	db         *postgres.Storage
+	dispatcher *bus.Dispatcher
+	producer   *webhook.Producer
+	sender     *webhook.Sender
 }
 
 func newApp(
@@ -23,14 +28,24 @@ func newApp(
 	cfg *Config,
 	e *echo.Echo,
 	db *postgres.Storage,
+	dispatcher *bus.Dispatcher,
+	producer *webhook.Producer,
+	sender *webhook.Sender,
 ) *App {
 	app := &App{
-		e:  e,
-		db: db,
+		e:          e,
+		db:         db,
+		dispatcher: dispatcher,
+		producer:   producer,
+		sender:     sender,
 	}
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
+			dispatcher.Start(ctx)
+			producer.Start(ctx)
+			sender.Start(ctx)
+
 			if err := app.e.Start(cfg.ApiConfig.Bind); err != nil && errors.Is(err, http.ErrServerClosed) {
 				return errors.Wrap(err, "shutting down the server")
 			}
@@ -40,6 +55,21 @@ func newApp(
 			if err := app.e.Shutdown(ctx); err != nil {
 				return errors.Wrap(err, "closing server")
 			}
+			if app.sender != nil {
+				if err := app.sender.Close(); err != nil {
+					return errors.Wrap(err, "closing webhook sender")
+				}
+			}
+			if app.producer != nil {
+				if err := app.producer.Close(); err != nil {
+					return errors.Wrap(err, "closing webhook producer")
+				}
+			}
+			if app.dispatcher != nil {
+				if err := app.dispatcher.Close(); err != nil {
+					return errors.Wrap(err, "closing bus dispatcher")
+				}
+			}
 			if err := app.db.Close(); err != nil {
 				return errors.Wrap(err, "closing database")
 			}
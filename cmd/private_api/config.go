@@ -4,6 +4,7 @@
 package main
 
 import (
+	"github.com/celenium-io/astria-indexer/cmd/private_api/webhook"
 	indexerConfig "github.com/celenium-io/astria-indexer/pkg/indexer/config"
 	"github.com/dipdup-net/go-lib/config"
 )
@@ -16,7 +17,8 @@ type Config struct {
 }
 
 type ApiConfig struct {
-	Bind           string  `validate:"required,hostname_port" yaml:"bind"`
-	RateLimit      float64 `validate:"omitempty,min=0"        yaml:"rate_limit"`
-	RequestTimeout int     `validate:"omitempty,min=1"        yaml:"request_timeout"`
+	Bind           string         `validate:"required,hostname_port" yaml:"bind"`
+	RateLimit      float64        `validate:"omitempty,min=0"        yaml:"rate_limit"`
+	RequestTimeout int            `validate:"omitempty,min=1"        yaml:"request_timeout"`
+	Webhooks       webhook.Config `validate:"omitempty"              yaml:"webhooks"`
 }
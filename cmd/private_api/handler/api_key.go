@@ -0,0 +1,446 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	apiKeySize       = 24
+	apiKeyPrefix     = "ak_"
+	apiKeyPrefixSize = 8
+)
+
+type ApiKeyHandler struct {
+	keys  storage.IApiKey
+	tiers storage.IApiTier
+	usage storage.IApiKeyUsage
+	tx    sdk.Transactable
+	auth  *Auth
+}
+
+func NewApiKeyHandler(
+	keys storage.IApiKey,
+	tiers storage.IApiTier,
+	usage storage.IApiKeyUsage,
+	tx sdk.Transactable,
+	auth *Auth,
+) *ApiKeyHandler {
+	return &ApiKeyHandler{
+		keys:  keys,
+		tiers: tiers,
+		usage: usage,
+		tx:    tx,
+		auth:  auth,
+	}
+}
+
+var _ Handler = (*ApiKeyHandler)(nil)
+
+func (handler *ApiKeyHandler) InitRoutes(srvr *echo.Group) {
+	tier := srvr.Group("/tier", handler.auth.Middleware())
+	{
+		tier.POST("", handler.CreateTier)
+		tier.GET("", handler.ListTiers)
+		tier.PATCH("/:id", handler.UpdateTier)
+		tier.DELETE("/:id", handler.DeleteTier)
+	}
+
+	apiKey := srvr.Group("/api_key", handler.auth.Middleware())
+	{
+		apiKey.POST("", handler.Create)
+		apiKey.GET("", handler.List)
+		apiKey.GET("/:id", handler.Get)
+		apiKey.PATCH("/:id", handler.Update)
+		apiKey.DELETE("/:id", handler.Delete)
+		apiKey.GET("/:id/usage", handler.Usage)
+	}
+}
+
+type ApiTier struct {
+	Id         uint64 `json:"id"`
+	Name       string `json:"name"`
+	Rate       int64  `json:"rate"`
+	DailyQuota int64  `json:"daily_quota"`
+}
+
+func NewApiTier(tier storage.ApiTier) ApiTier {
+	return ApiTier{
+		Id:         tier.Id,
+		Name:       tier.Name,
+		Rate:       tier.Rate,
+		DailyQuota: tier.DailyQuota,
+	}
+}
+
+type ApiKey struct {
+	Id        uint64     `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	TierId    uint64     `json:"tier_id"`
+	Active    bool       `json:"active"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Key       string     `json:"key,omitempty"`
+}
+
+func NewApiKey(key storage.ApiKey) ApiKey {
+	return ApiKey{
+		Id:        key.Id,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		TierId:    key.TierId,
+		Active:    key.Active,
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+	}
+}
+
+type ApiKeyUsage struct {
+	Day      string `json:"day"`
+	Endpoint string `json:"endpoint"`
+	Requests int64  `json:"requests"`
+	Rejected int64  `json:"rejected"`
+}
+
+func NewApiKeyUsage(usage storage.ApiKeyUsage) ApiKeyUsage {
+	return ApiKeyUsage{
+		Day:      usage.Day.Format(time.DateOnly),
+		Endpoint: usage.Endpoint,
+		Requests: usage.Requests,
+		Rejected: usage.Rejected,
+	}
+}
+
+type createTierRequest struct {
+	Name       string `json:"name"        validate:"required,min=1"`
+	Rate       int64  `json:"rate"        validate:"omitempty,min=0"`
+	DailyQuota int64  `json:"daily_quota" validate:"omitempty,min=0"`
+}
+
+func (handler *ApiKeyHandler) CreateTier(c echo.Context) error {
+	req, err := bindAndValidate[createTierRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	tier := storage.ApiTier{
+		Name:       req.Name,
+		Rate:       req.Rate,
+		DailyQuota: req.DailyQuota,
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionCreate, storage.AuditEntityApiTier, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Add(ctx, &tier); err != nil {
+			return 0, nil, nil, err
+		}
+		return tier.Id, nil, NewApiTier(tier), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.tiers)
+	}
+	return c.JSON(http.StatusOK, NewApiTier(tier))
+}
+
+func (handler *ApiKeyHandler) ListTiers(c echo.Context) error {
+	tiers, err := handler.tiers.List(c.Request().Context(), 100, 0, sdk.SortOrderAsc)
+	if err != nil {
+		return handleError(c, err, handler.tiers)
+	}
+
+	response := make([]ApiTier, len(tiers))
+	for i := range tiers {
+		response[i] = NewApiTier(*tiers[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type updateTierRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+
+	Name       string `json:"name"        validate:"omitempty"`
+	Rate       *int64 `json:"rate"        validate:"omitempty,min=0"`
+	DailyQuota *int64 `json:"daily_quota" validate:"omitempty,min=0"`
+}
+
+func (handler *ApiKeyHandler) UpdateTier(c echo.Context) error {
+	req, err := bindAndValidate[updateTierRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	tier, err := handler.tiers.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.tiers)
+	}
+	before := NewApiTier(*tier)
+
+	if req.Name != "" {
+		tier.Name = req.Name
+	}
+	if req.Rate != nil {
+		tier.Rate = *req.Rate
+	}
+	if req.DailyQuota != nil {
+		tier.DailyQuota = *req.DailyQuota
+	}
+
+	err = runAudited(c, handler.tx, storage.AuditActionUpdate, storage.AuditEntityApiTier, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Update(ctx, tier); err != nil {
+			return 0, nil, nil, err
+		}
+		return tier.Id, before, NewApiTier(*tier), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.tiers)
+	}
+	return success(c)
+}
+
+type idRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+func (handler *ApiKeyHandler) DeleteTier(c echo.Context) error {
+	req, err := bindAndValidate[idRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	tier, err := handler.tiers.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.tiers)
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionDelete, storage.AuditEntityApiTier, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.DeleteApiTier(ctx, req.Id); err != nil {
+			return 0, nil, nil, err
+		}
+		return req.Id, NewApiTier(*tier), nil, nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.tiers)
+	}
+	return success(c)
+}
+
+type createApiKeyRequest struct {
+	Name      string     `json:"name"       validate:"required,min=1"`
+	TierId    uint64     `json:"tier_id"    validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty"`
+}
+
+// Create - generates new API key. The raw key is returned only once and only its hash is stored.
+func (handler *ApiKeyHandler) Create(c echo.Context) error {
+	req, err := bindAndValidate[createApiKeyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	if _, err := handler.tiers.GetByID(ctx, req.TierId); err != nil {
+		if handler.tiers.IsNoRows(err) {
+			return badRequestError(c, errUnknownTier)
+		}
+		return handleError(c, err, handler.tiers)
+	}
+
+	raw, err := newApiKey()
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+
+	key := storage.ApiKey{
+		Name:      req.Name,
+		Prefix:    raw[:apiKeyPrefixSize],
+		KeyHash:   storage.HashKey(raw),
+		TierId:    req.TierId,
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: req.ExpiresAt,
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionCreate, storage.AuditEntityApiKey, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Add(ctx, &key); err != nil {
+			return 0, nil, nil, err
+		}
+		return key.Id, nil, NewApiKey(key), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+
+	response := NewApiKey(key)
+	response.Key = raw
+	return c.JSON(http.StatusOK, response)
+}
+
+type listApiKeysRequest struct {
+	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (req *listApiKeysRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+}
+
+func (handler *ApiKeyHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listApiKeysRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	keys, err := handler.keys.List(c.Request().Context(), req.Limit, req.Offset, sdk.SortOrderAsc)
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+
+	response := make([]ApiKey, len(keys))
+	for i := range keys {
+		response[i] = NewApiKey(*keys[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (handler *ApiKeyHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[idRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	key, err := handler.keys.GetByID(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+	return c.JSON(http.StatusOK, NewApiKey(*key))
+}
+
+type updateApiKeyRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+
+	Name      string     `json:"name"       validate:"omitempty"`
+	TierId    uint64     `json:"tier_id"    validate:"omitempty,min=1"`
+	Active    *bool      `json:"active"     validate:"omitempty"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty"`
+}
+
+func (handler *ApiKeyHandler) Update(c echo.Context) error {
+	req, err := bindAndValidate[updateApiKeyRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	key, err := handler.keys.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+	before := NewApiKey(*key)
+
+	if req.Name != "" {
+		key.Name = req.Name
+	}
+	if req.TierId > 0 {
+		if _, err := handler.tiers.GetByID(ctx, req.TierId); err != nil {
+			if handler.tiers.IsNoRows(err) {
+				return badRequestError(c, errUnknownTier)
+			}
+			return handleError(c, err, handler.tiers)
+		}
+		key.TierId = req.TierId
+	}
+	if req.Active != nil {
+		key.Active = *req.Active
+	}
+	if req.ExpiresAt != nil {
+		key.ExpiresAt = req.ExpiresAt
+	}
+
+	err = runAudited(c, handler.tx, storage.AuditActionUpdate, storage.AuditEntityApiKey, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Update(ctx, key); err != nil {
+			return 0, nil, nil, err
+		}
+		return key.Id, before, NewApiKey(*key), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+	return success(c)
+}
+
+func (handler *ApiKeyHandler) Delete(c echo.Context) error {
+	req, err := bindAndValidate[idRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	key, err := handler.keys.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionDelete, storage.AuditEntityApiKey, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.DeleteApiKey(ctx, req.Id); err != nil {
+			return 0, nil, nil, err
+		}
+		return req.Id, NewApiKey(*key), nil, nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+	return success(c)
+}
+
+type apiKeyUsageRequest struct {
+	Id   uint64 `param:"id"   validate:"required,min=1"`
+	From int64  `query:"from" validate:"omitempty,min=1"`
+	To   int64  `query:"to"   validate:"omitempty,min=1"`
+}
+
+func (req *apiKeyUsageRequest) SetDefault() {
+	if req.To == 0 {
+		req.To = time.Now().UTC().Unix()
+	}
+	if req.From == 0 {
+		req.From = time.Unix(req.To, 0).AddDate(0, 0, -30).Unix()
+	}
+}
+
+func (handler *ApiKeyHandler) Usage(c echo.Context) error {
+	req, err := bindAndValidate[apiKeyUsageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	usage, err := handler.usage.ByApiKey(c.Request().Context(), req.Id, time.Unix(req.From, 0).UTC(), time.Unix(req.To, 0).UTC())
+	if err != nil {
+		return handleError(c, err, handler.keys)
+	}
+
+	response := make([]ApiKeyUsage, len(usage))
+	for i := range usage {
+		response[i] = NewApiKeyUsage(usage[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func newApiKey() (string, error) {
+	key := make([]byte, apiKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + hex.EncodeToString(key), nil
+}
@@ -4,9 +4,7 @@
 package handler
 
 import (
-	"context"
 	"encoding/base64"
-	"os"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
@@ -14,7 +12,6 @@ import (
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/gosimple/slug"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
 )
 
@@ -23,6 +20,7 @@ type AppHandler struct {
 	address storage.IAddress
 	rollup  storage.IRollup
 	tx      sdk.Transactable
+	auth    *Auth
 }
 
 func NewAppHandler(
@@ -30,30 +28,79 @@ func NewAppHandler(
 	address storage.IAddress,
 	rollup storage.IRollup,
 	tx sdk.Transactable,
+	auth *Auth,
 ) *AppHandler {
 	return &AppHandler{
 		apps:    apps,
 		address: address,
 		rollup:  rollup,
 		tx:      tx,
+		auth:    auth,
 	}
 }
 
 var _ Handler = (*AppHandler)(nil)
 
 func (handler *AppHandler) InitRoutes(srvr *echo.Group) {
-	keyMiddleware := middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
-		KeyLookup: "header:Authorization",
-		Validator: func(key string, c echo.Context) (bool, error) {
-			return key == os.Getenv("PRIVATE_API_AUTH_KEY"), nil
-		},
-	})
-
-	app := srvr.Group("/app")
+	app := srvr.Group("/app", handler.auth.Middleware(storage.OperatorRoleAppEditor))
 	{
-		app.POST("", handler.Create, keyMiddleware)
-		app.PATCH("/:id", handler.Update, keyMiddleware)
-		app.DELETE("/:id", handler.Delete, keyMiddleware)
+		app.POST("", handler.Create)
+		app.PATCH("/:id", handler.Update)
+		app.DELETE("/:id", handler.Delete)
+
+		app.GET("/:id/rollup", handler.Rollups)
+		app.POST("/:id/rollup", handler.LinkRollup)
+		app.DELETE("/:id/rollup/:rollup", handler.UnlinkRollup)
+
+		app.GET("/:id/bridge", handler.Bridges)
+		app.POST("/:id/bridge", handler.LinkBridge)
+		app.DELETE("/:id/bridge/:bridge", handler.UnlinkBridge)
+	}
+}
+
+type App struct {
+	Id             uint64   `json:"id"`
+	Group          string   `json:"group,omitempty"`
+	Name           string   `json:"name"`
+	Slug           string   `json:"slug"`
+	Description    string   `json:"description,omitempty"`
+	Website        string   `json:"website,omitempty"`
+	GitHub         string   `json:"github,omitempty"`
+	Twitter        string   `json:"twitter,omitempty"`
+	Logo           string   `json:"logo,omitempty"`
+	L2Beat         string   `json:"l2beat,omitempty"`
+	Explorer       string   `json:"explorer,omitempty"`
+	Stack          string   `json:"stack,omitempty"`
+	Links          []string `json:"links,omitempty"`
+	Category       string   `json:"category,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	VM             string   `json:"vm,omitempty"`
+	Provider       string   `json:"provider,omitempty"`
+	RollupId       uint64   `json:"rollup_id"`
+	NativeBridgeId uint64   `json:"native_bridge_id,omitempty"`
+}
+
+func NewApp(app storage.App) App {
+	return App{
+		Id:             app.Id,
+		Group:          app.Group,
+		Name:           app.Name,
+		Slug:           app.Slug,
+		Description:    app.Description,
+		Website:        app.Website,
+		GitHub:         app.Github,
+		Twitter:        app.Twitter,
+		Logo:           app.Logo,
+		L2Beat:         app.L2Beat,
+		Explorer:       app.Explorer,
+		Stack:          app.Stack,
+		Links:          app.Links,
+		Category:       app.Category.String(),
+		Type:           app.Type.String(),
+		VM:             app.VM,
+		Provider:       app.Provider,
+		RollupId:       app.RollupId,
+		NativeBridgeId: app.NativeBridgeId,
 	}
 }
 
@@ -83,14 +130,15 @@ func (handler *AppHandler) Create(c echo.Context) error {
 		return badRequestError(c, err)
 	}
 
-	if err := handler.createApp(c.Request().Context(), req); err != nil {
+	if err := handler.createApp(c, req); err != nil {
 		return handleError(c, err, handler.apps)
 	}
 
 	return success(c)
 }
 
-func (handler *AppHandler) createApp(ctx context.Context, req *createAppRequest) error {
+func (handler *AppHandler) createApp(c echo.Context, req *createAppRequest) error {
+	ctx := c.Request().Context()
 	tx, err := postgres.BeginTransaction(ctx, handler.tx)
 	if err != nil {
 		return err
@@ -141,6 +189,14 @@ func (handler *AppHandler) createApp(ctx context.Context, req *createAppRequest)
 		return tx.HandleError(ctx, err)
 	}
 
+	log, err := newAuditLog(c, storage.AuditActionCreate, storage.AuditEntityApp, app.Id, nil, NewApp(app))
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	if err := tx.RefreshLeaderboard(ctx); err != nil {
 		return tx.HandleError(ctx, err)
 	}
@@ -176,21 +232,23 @@ func (handler *AppHandler) Update(c echo.Context) error {
 		return badRequestError(c, err)
 	}
 
-	if err := handler.updateRollup(c.Request().Context(), req); err != nil {
+	if err := handler.updateRollup(c, req); err != nil {
 		return handleError(c, err, handler.apps)
 	}
 
 	return success(c)
 }
 
-func (handler *AppHandler) updateRollup(ctx context.Context, req *updateAppRequest) error {
+func (handler *AppHandler) updateRollup(c echo.Context, req *updateAppRequest) error {
+	ctx := c.Request().Context()
 	tx, err := postgres.BeginTransaction(ctx, handler.tx)
 	if err != nil {
 		return err
 	}
 
-	if _, err := handler.apps.GetByID(ctx, req.Id); err != nil {
-		return err
+	before, err := tx.GetApp(ctx, req.Id)
+	if err != nil {
+		return tx.HandleError(ctx, err)
 	}
 
 	app := storage.App{
@@ -240,6 +298,18 @@ func (handler *AppHandler) updateRollup(ctx context.Context, req *updateAppReque
 		return tx.HandleError(ctx, err)
 	}
 
+	after, err := tx.GetApp(ctx, req.Id)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	log, err := newAuditLog(c, storage.AuditActionUpdate, storage.AuditEntityApp, req.Id, NewApp(before), NewApp(after))
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	if err := tx.RefreshLeaderboard(ctx); err != nil {
 		return tx.HandleError(ctx, err)
 	}
@@ -257,22 +327,36 @@ func (handler *AppHandler) Delete(c echo.Context) error {
 		return badRequestError(c, err)
 	}
 
-	if err := handler.deleteRollup(c.Request().Context(), req.Id); err != nil {
+	if err := handler.deleteRollup(c, req.Id); err != nil {
 		return handleError(c, err, handler.apps)
 	}
 
 	return success(c)
 }
 
-func (handler *AppHandler) deleteRollup(ctx context.Context, id uint64) error {
+func (handler *AppHandler) deleteRollup(c echo.Context, id uint64) error {
+	ctx := c.Request().Context()
 	tx, err := postgres.BeginTransaction(ctx, handler.tx)
 	if err != nil {
 		return err
 	}
 
+	before, err := tx.GetApp(ctx, id)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	if err := tx.DeleteApp(ctx, id); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
+	log, err := newAuditLog(c, storage.AuditActionDelete, storage.AuditEntityApp, id, NewApp(before), nil)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	return tx.Flush(ctx)
 }
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type AppRollup struct {
+	Rollup string `json:"rollup"`
+	Role   string `json:"role"`
+}
+
+func NewAppRollup(link storage.AppRollup) AppRollup {
+	result := AppRollup{
+		Role: link.Role,
+	}
+	if link.Rollup != nil {
+		result.Rollup = base64.StdEncoding.EncodeToString(link.Rollup.AstriaId)
+	}
+	return result
+}
+
+type AppBridge struct {
+	Bridge string `json:"bridge"`
+	Role   string `json:"role"`
+}
+
+func NewAppBridge(link storage.AppBridge) AppBridge {
+	result := AppBridge{
+		Role: link.Role,
+	}
+	if link.Bridge != nil {
+		result.Bridge = link.Bridge.Hash
+	}
+	return result
+}
+
+type appLinksRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+func (handler *AppHandler) Rollups(c echo.Context) error {
+	req, err := bindAndValidate[appLinksRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	links, err := handler.apps.Rollups(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+
+	response := make([]AppRollup, len(links))
+	for i := range links {
+		response[i] = NewAppRollup(links[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (handler *AppHandler) Bridges(c echo.Context) error {
+	req, err := bindAndValidate[appLinksRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	links, err := handler.apps.Bridges(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.apps)
+	}
+
+	response := make([]AppBridge, len(links))
+	for i := range links {
+		response[i] = NewAppBridge(links[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type linkRollupRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Rollup string `json:"rollup" validate:"required,base64"`
+	Role   string `json:"role"   validate:"required,min=1,max=64,ne=primary"`
+}
+
+func (handler *AppHandler) LinkRollup(c echo.Context) error {
+	req, err := bindAndValidate[linkRollupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(req.Rollup)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	if err := handler.linkRollup(c, req.Id, hash, req.Role); err != nil {
+		return handleError(c, err, handler.apps)
+	}
+	return success(c)
+}
+
+func (handler *AppHandler) linkRollup(c echo.Context, appId uint64, hash []byte, role string) error {
+	ctx := c.Request().Context()
+	app, err := handler.apps.GetByID(ctx, appId)
+	if err != nil {
+		return err
+	}
+	rollup, err := handler.rollup.ByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if rollup.Id == app.RollupId {
+		return errors.Wrap(storage.ErrValidation, "rollup is the primary rollup of the application")
+	}
+
+	owner, err := handler.apps.IdByRollupId(ctx, rollup.Id)
+	switch {
+	case err == nil && owner != app.Id:
+		return errors.Wrapf(storage.ErrValidation, "rollup is already linked to application %d", owner)
+	case err != nil && !handler.apps.IsNoRows(err):
+		return err
+	}
+
+	link := storage.AppRollup{
+		AppId:    app.Id,
+		RollupId: rollup.Id,
+		Role:     role,
+		Rollup:   &rollup,
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, handler.tx)
+	if err != nil {
+		return err
+	}
+	if err := tx.SaveAppRollup(ctx, &link); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	log, err := newAuditLog(c, storage.AuditActionCreate, storage.AuditEntityAppRollup, app.Id, nil, NewAppRollup(link))
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RefreshLeaderboard(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return tx.Flush(ctx)
+}
+
+type unlinkRollupRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Rollup string `param:"rollup" validate:"required,base64url"`
+}
+
+func (handler *AppHandler) UnlinkRollup(c echo.Context) error {
+	req, err := bindAndValidate[unlinkRollupRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	hash, err := base64.URLEncoding.DecodeString(req.Rollup)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	if err := handler.unlinkRollup(c, req.Id, hash); err != nil {
+		return handleError(c, err, handler.apps)
+	}
+	return success(c)
+}
+
+func (handler *AppHandler) unlinkRollup(c echo.Context, appId uint64, hash []byte) error {
+	ctx := c.Request().Context()
+	rollup, err := handler.rollup.ByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+	links, err := handler.apps.Rollups(ctx, appId)
+	if err != nil {
+		return err
+	}
+
+	var before *storage.AppRollup
+	for i := range links {
+		if links[i].RollupId != rollup.Id {
+			continue
+		}
+		if links[i].Role == storage.AppRolePrimary {
+			return errors.Wrap(storage.ErrValidation, "primary rollup of the application can not be unlinked")
+		}
+		before = &links[i]
+		break
+	}
+	if before == nil {
+		return errors.Wrap(storage.ErrValidation, "rollup is not linked to the application")
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, handler.tx)
+	if err != nil {
+		return err
+	}
+	if err := tx.DeleteAppRollup(ctx, appId, rollup.Id); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	log, err := newAuditLog(c, storage.AuditActionDelete, storage.AuditEntityAppRollup, appId, NewAppRollup(*before), nil)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.RefreshLeaderboard(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return tx.Flush(ctx)
+}
+
+type linkBridgeRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Bridge string `json:"bridge" validate:"required,address"`
+	Role   string `json:"role"   validate:"required,min=1,max=64,ne=native"`
+}
+
+func (handler *AppHandler) LinkBridge(c echo.Context) error {
+	req, err := bindAndValidate[linkBridgeRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	if err := handler.linkBridge(c, req.Id, req.Bridge, req.Role); err != nil {
+		return handleError(c, err, handler.apps)
+	}
+	return success(c)
+}
+
+func (handler *AppHandler) linkBridge(c echo.Context, appId uint64, hash, role string) error {
+	ctx := c.Request().Context()
+	app, err := handler.apps.GetByID(ctx, appId)
+	if err != nil {
+		return err
+	}
+	addr, err := handler.address.ByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if !addr.IsBridge {
+		return errors.Wrapf(storage.ErrValidation, "address %s is not a bridge", hash)
+	}
+	if addr.Id == app.NativeBridgeId {
+		return errors.Wrap(storage.ErrValidation, "bridge is the native bridge of the application")
+	}
+
+	link := storage.AppBridge{
+		AppId:    app.Id,
+		BridgeId: addr.Id,
+		Role:     role,
+		Bridge:   &addr,
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, handler.tx)
+	if err != nil {
+		return err
+	}
+	if err := tx.SaveAppBridge(ctx, &link); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	log, err := newAuditLog(c, storage.AuditActionCreate, storage.AuditEntityAppBridge, app.Id, nil, NewAppBridge(link))
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return tx.Flush(ctx)
+}
+
+type unlinkBridgeRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Bridge string `param:"bridge" validate:"required,address"`
+}
+
+func (handler *AppHandler) UnlinkBridge(c echo.Context) error {
+	req, err := bindAndValidate[unlinkBridgeRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	if err := handler.unlinkBridge(c, req.Id, req.Bridge); err != nil {
+		return handleError(c, err, handler.apps)
+	}
+	return success(c)
+}
+
+func (handler *AppHandler) unlinkBridge(c echo.Context, appId uint64, hash string) error {
+	ctx := c.Request().Context()
+	links, err := handler.apps.Bridges(ctx, appId)
+	if err != nil {
+		return err
+	}
+
+	var before *storage.AppBridge
+	for i := range links {
+		if links[i].Bridge == nil || links[i].Bridge.Hash != hash {
+			continue
+		}
+		if links[i].Role == storage.AppRoleNative {
+			return errors.Wrap(storage.ErrValidation, "native bridge of the application can not be unlinked")
+		}
+		before = &links[i]
+		break
+	}
+	if before == nil {
+		return errors.Wrap(storage.ErrValidation, "bridge is not linked to the application")
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, handler.tx)
+	if err != nil {
+		return err
+	}
+	if err := tx.DeleteAppBridge(ctx, appId, before.BridgeId); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	log, err := newAuditLog(c, storage.AuditActionDelete, storage.AuditEntityAppBridge, appId, NewAppBridge(*before), nil)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return tx.Flush(ctx)
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const testBridgeHash = "astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p"
+
+var (
+	testRollup = storage.Rollup{
+		Id:       1,
+		AstriaId: bytes.Repeat([]byte{0x01}, 32),
+	}
+	testLinkedRollup = storage.Rollup{
+		Id:       2,
+		AstriaId: bytes.Repeat([]byte{0x02}, 32),
+	}
+	testApp = storage.App{
+		Id:             1,
+		Name:           "App 1",
+		Slug:           "app-1",
+		RollupId:       testRollup.Id,
+		NativeBridgeId: 10,
+	}
+)
+
+// AppLinkTestSuite -
+type AppLinkTestSuite struct {
+	suite.Suite
+	apps      *mock.MockIApp
+	rollups   *mock.MockIRollup
+	addresses *mock.MockIAddress
+	echo      *echo.Echo
+	ctrl      *gomock.Controller
+}
+
+// SetupTest -
+func (s *AppLinkTestSuite) SetupTest() {
+	s.T().Setenv("PRIVATE_API_AUTH_KEY", testRootKey)
+
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.apps = mock.NewMockIApp(s.ctrl)
+	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.addresses = mock.NewMockIAddress(s.ctrl)
+
+	auth := NewAuth(mock.NewMockIOperator(s.ctrl))
+	NewAppHandler(s.apps, s.addresses, s.rollups, nil, auth).InitRoutes(s.echo.Group("v1"))
+}
+
+// TearDownTest -
+func (s *AppLinkTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSuiteAppLink_Run(t *testing.T) {
+	suite.Run(t, new(AppLinkTestSuite))
+}
+
+func (s *AppLinkTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+testRootKey)
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *AppLinkTestSuite) TestRollups() {
+	s.apps.EXPECT().
+		Rollups(gomock.Any(), uint64(1)).
+		Return([]storage.AppRollup{
+			{AppId: 1, RollupId: 1, Role: storage.AppRolePrimary, Rollup: &testRollup},
+			{AppId: 1, RollupId: 2, Role: "testnet", Rollup: &testLinkedRollup},
+		}, nil).
+		Times(1)
+
+	rec := s.request(http.MethodGet, "/v1/app/1/rollup", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var links []AppRollup
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&links))
+	s.Require().Len(links, 2)
+	s.Require().Equal(base64.StdEncoding.EncodeToString(testRollup.AstriaId), links[0].Rollup)
+	s.Require().Equal(storage.AppRolePrimary, links[0].Role)
+	s.Require().Equal("testnet", links[1].Role)
+}
+
+func (s *AppLinkTestSuite) TestBridges() {
+	s.apps.EXPECT().
+		Bridges(gomock.Any(), uint64(1)).
+		Return([]storage.AppBridge{
+			{AppId: 1, BridgeId: 10, Role: storage.AppRoleNative, Bridge: &storage.Address{Hash: testBridgeHash}},
+		}, nil).
+		Times(1)
+
+	rec := s.request(http.MethodGet, "/v1/app/1/bridge", "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var links []AppBridge
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&links))
+	s.Require().Len(links, 1)
+	s.Require().Equal(testBridgeHash, links[0].Bridge)
+	s.Require().Equal(storage.AppRoleNative, links[0].Role)
+}
+
+func (s *AppLinkTestSuite) TestLinkRollupValidation() {
+	body := `{"rollup":"` + base64.StdEncoding.EncodeToString(testLinkedRollup.AstriaId) + `","role":"primary"}`
+	rec := s.request(http.MethodPost, "/v1/app/1/rollup", body)
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	body = `{"rollup":"` + base64.StdEncoding.EncodeToString(testRollup.AstriaId) + `","role":"mainnet"}`
+	s.apps.EXPECT().GetByID(gomock.Any(), uint64(1)).Return(&testApp, nil).Times(1)
+	s.rollups.EXPECT().ByHash(gomock.Any(), testRollup.AstriaId).Return(testRollup, nil).Times(1)
+
+	rec = s.request(http.MethodPost, "/v1/app/1/rollup", body)
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+	s.Require().Contains(rec.Body.String(), "primary rollup")
+}
+
+func (s *AppLinkTestSuite) TestLinkRollupOwnedByAnotherApp() {
+	s.apps.EXPECT().GetByID(gomock.Any(), uint64(1)).Return(&testApp, nil).Times(1)
+	s.rollups.EXPECT().ByHash(gomock.Any(), testLinkedRollup.AstriaId).Return(testLinkedRollup, nil).Times(1)
+	s.apps.EXPECT().IdByRollupId(gomock.Any(), testLinkedRollup.Id).Return(uint64(5), nil).Times(1)
+
+	body := `{"rollup":"` + base64.StdEncoding.EncodeToString(testLinkedRollup.AstriaId) + `","role":"testnet"}`
+	rec := s.request(http.MethodPost, "/v1/app/1/rollup", body)
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+	s.Require().Contains(rec.Body.String(), "already linked to application 5")
+}
+
+func (s *AppLinkTestSuite) TestLinkRollupUnknownApp() {
+	s.apps.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(nil, sql.ErrNoRows).Times(1)
+	s.apps.EXPECT().IsNoRows(sql.ErrNoRows).Return(true).Times(1)
+
+	body := `{"rollup":"` + base64.StdEncoding.EncodeToString(testLinkedRollup.AstriaId) + `","role":"testnet"}`
+	rec := s.request(http.MethodPost, "/v1/app/7/rollup", body)
+	s.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+}
+
+func (s *AppLinkTestSuite) TestUnlinkPrimaryRollup() {
+	s.rollups.EXPECT().ByHash(gomock.Any(), testRollup.AstriaId).Return(testRollup, nil).Times(1)
+	s.apps.EXPECT().
+		Rollups(gomock.Any(), uint64(1)).
+		Return([]storage.AppRollup{
+			{AppId: 1, RollupId: 1, Role: storage.AppRolePrimary, Rollup: &testRollup},
+		}, nil).
+		Times(1)
+
+	rec := s.request(http.MethodDelete, "/v1/app/1/rollup/"+base64.URLEncoding.EncodeToString(testRollup.AstriaId), "")
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+	s.Require().Contains(rec.Body.String(), "can not be unlinked")
+}
+
+func (s *AppLinkTestSuite) TestLinkBridgeValidation() {
+	s.apps.EXPECT().GetByID(gomock.Any(), uint64(1)).Return(&testApp, nil).Times(1)
+	s.addresses.EXPECT().
+		ByHash(gomock.Any(), testBridgeHash).
+		Return(storage.Address{Id: 11, Hash: testBridgeHash}, nil).
+		Times(1)
+
+	rec := s.request(http.MethodPost, "/v1/app/1/bridge", `{"bridge":"`+testBridgeHash+`","role":"usdc"}`)
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+	s.Require().Contains(rec.Body.String(), "is not a bridge")
+
+	rec = s.request(http.MethodPost, "/v1/app/1/bridge", `{"bridge":"`+testBridgeHash+`","role":"native"}`)
+	s.Require().Equal(http.StatusBadRequest, rec.Code, rec.Body.String())
+}
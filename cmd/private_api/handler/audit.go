@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type AuditHandler struct {
+	logs storage.IAuditLog
+	auth *Auth
+}
+
+func NewAuditHandler(
+	logs storage.IAuditLog,
+	auth *Auth,
+) *AuditHandler {
+	return &AuditHandler{
+		logs: logs,
+		auth: auth,
+	}
+}
+
+var _ Handler = (*AuditHandler)(nil)
+
+func (handler *AuditHandler) InitRoutes(srvr *echo.Group) {
+	srvr.GET("/audit", handler.List, handler.auth.Middleware())
+}
+
+type AuditLog struct {
+	Id           uint64          `json:"id"`
+	Time         time.Time       `json:"time"`
+	OperatorId   uint64          `json:"operator_id,omitempty"`
+	OperatorName string          `json:"operator_name"`
+	Action       string          `json:"action"`
+	Entity       string          `json:"entity"`
+	EntityId     uint64          `json:"entity_id"`
+	Before       json.RawMessage `json:"before,omitempty"`
+	After        json.RawMessage `json:"after,omitempty"`
+}
+
+func NewAuditLog(log storage.AuditLog) AuditLog {
+	result := AuditLog{
+		Id:           log.Id,
+		Time:         log.Time,
+		OperatorId:   log.OperatorId,
+		OperatorName: log.OperatorName,
+		Action:       log.Action,
+		Entity:       log.Entity,
+		EntityId:     log.EntityId,
+	}
+	if log.Before != "" {
+		result.Before = json.RawMessage(log.Before)
+	}
+	if log.After != "" {
+		result.After = json.RawMessage(log.After)
+	}
+	return result
+}
+
+type listAuditRequest struct {
+	Limit      int    `query:"limit"       validate:"omitempty,min=1,max=100"`
+	Offset     int    `query:"offset"      validate:"omitempty,min=0"`
+	Sort       string `query:"sort"        validate:"omitempty,oneof=asc desc"`
+	OperatorId uint64 `query:"operator_id" validate:"omitempty,min=1"`
+	Entity     string `query:"entity"      validate:"omitempty,oneof=app webhook webhook_delivery api_key api_tier operator"`
+	EntityId   uint64 `query:"entity_id"   validate:"omitempty,min=1"`
+	Action     string `query:"action"      validate:"omitempty,oneof=create update delete"`
+	From       int64  `query:"from"        validate:"omitempty,min=1"`
+	To         int64  `query:"to"          validate:"omitempty,min=1"`
+}
+
+func (req *listAuditRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = string(sdk.SortOrderDesc)
+	}
+}
+
+func (req *listAuditRequest) Filter() storage.AuditLogFilter {
+	fltrs := storage.AuditLogFilter{
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+		Sort:       sdk.SortOrder(req.Sort),
+		OperatorId: req.OperatorId,
+		Entity:     req.Entity,
+		EntityId:   req.EntityId,
+		Action:     req.Action,
+	}
+	if req.From > 0 {
+		fltrs.TimeFrom = time.Unix(req.From, 0).UTC()
+	}
+	if req.To > 0 {
+		fltrs.TimeTo = time.Unix(req.To, 0).UTC()
+	}
+	return fltrs
+}
+
+func (handler *AuditHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listAuditRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	logs, err := handler.logs.Filter(c.Request().Context(), req.Filter())
+	if err != nil {
+		return handleError(c, err, handler.logs)
+	}
+
+	response := make([]AuditLog, len(logs))
+	for i := range logs {
+		response[i] = NewAuditLog(logs[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// newAuditLog - creates audit record of the mutation made by the current operator. Before and after states are encoded to JSON if they are not nil.
+func newAuditLog(c echo.Context, action, entity string, entityId uint64, before, after any) (*storage.AuditLog, error) {
+	operator := currentOperator(c)
+	log := &storage.AuditLog{
+		Time:         time.Now().UTC(),
+		OperatorId:   operator.Id,
+		OperatorName: operator.Name,
+		Action:       action,
+		Entity:       entity,
+		EntityId:     entityId,
+	}
+	if before != nil {
+		data, err := json.Marshal(before)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode state before mutation")
+		}
+		log.Before = string(data)
+	}
+	if after != nil {
+		data, err := json.Marshal(after)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode state after mutation")
+		}
+		log.After = string(data)
+	}
+	return log, nil
+}
+
+// auditedMutation - changes the entity in the transaction and returns its identity with states before and after the change
+type auditedMutation func(ctx context.Context, tx storage.Transaction) (entityId uint64, before, after any, err error)
+
+// runAudited - executes the mutation and saves its audit record in the same database transaction, so the record exists only if the mutation is committed
+func runAudited(c echo.Context, transactable sdk.Transactable, action, entity string, mutation auditedMutation) error {
+	ctx := c.Request().Context()
+	tx, err := postgres.BeginTransaction(ctx, transactable)
+	if err != nil {
+		return err
+	}
+
+	entityId, before, after, err := mutation(ctx, tx)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	log, err := newAuditLog(c, action, entity, entityId, before, after)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveAuditLog(ctx, log); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	return tx.Flush(ctx)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/celenium-io/astria-indexer/internal/storage"
 	sentryecho "github.com/getsentry/sentry-go/echo"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
@@ -14,6 +15,7 @@ import (
 
 var (
 	errInvalidAddress = errors.New("invalid address")
+	errUnknownTier    = errors.New("unknown tier")
 	errCancelRequest  = "pq: canceling statement due to user request"
 )
 
@@ -57,12 +59,12 @@ func handleError(c echo.Context, err error, noRows NoRows) error {
 			Message: err.Error(),
 		})
 	}
+	if errors.Is(err, errInvalidAddress) || errors.Is(err, storage.ErrValidation) {
+		return badRequestError(c, err)
+	}
 	if noRows.IsNoRows(err) {
 		return c.NoContent(http.StatusNoContent)
 	}
-	if errors.Is(err, errInvalidAddress) {
-		return badRequestError(c, err)
-	}
 	return internalServerError(c, err)
 }
 
@@ -3,8 +3,76 @@
 
 package handler
 
-import "github.com/labstack/echo/v4"
+import (
+	"net/http"
+	"os"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	operatorContextKey = "operator"
+	rootOperatorName   = "root"
+)
 
 type Handler interface {
 	InitRoutes(srvr *echo.Group)
 }
+
+// Auth - authenticates requests by operator keys and checks operator roles. The key from PRIVATE_API_AUTH_KEY environment variable is treated as the root admin.
+type Auth struct {
+	operators storage.IOperator
+}
+
+func NewAuth(operators storage.IOperator) *Auth {
+	return &Auth{
+		operators: operators,
+	}
+}
+
+// Middleware - allows requests of operators having one of the roles. Only admins are allowed if roles are not passed.
+func (auth *Auth) Middleware(roles ...string) echo.MiddlewareFunc {
+	keyAuth := middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		KeyLookup: "header:Authorization",
+		Validator: auth.validate,
+	})
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return keyAuth(func(c echo.Context) error {
+			if !currentOperator(c).HasRole(roles...) {
+				return echo.NewHTTPError(http.StatusForbidden, "operator role is not allowed")
+			}
+			return next(c)
+		})
+	}
+}
+
+func (auth *Auth) validate(key string, c echo.Context) (bool, error) {
+	if key == os.Getenv("PRIVATE_API_AUTH_KEY") {
+		c.Set(operatorContextKey, storage.Operator{
+			Name:   rootOperatorName,
+			Role:   storage.OperatorRoleAdmin,
+			Active: true,
+		})
+		return true, nil
+	}
+
+	operator, err := auth.operators.ByHash(c.Request().Context(), storage.HashKey(key))
+	if err != nil {
+		if auth.operators.IsNoRows(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !operator.Active {
+		return false, nil
+	}
+	c.Set(operatorContextKey, operator)
+	return true, nil
+}
+
+func currentOperator(c echo.Context) storage.Operator {
+	operator, _ := c.Get(operatorContextKey).(storage.Operator)
+	return operator
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+)
+
+const operatorKeyPrefix = "op_"
+
+type OperatorHandler struct {
+	operators storage.IOperator
+	tx        sdk.Transactable
+	auth      *Auth
+}
+
+func NewOperatorHandler(
+	operators storage.IOperator,
+	tx sdk.Transactable,
+	auth *Auth,
+) *OperatorHandler {
+	return &OperatorHandler{
+		operators: operators,
+		tx:        tx,
+		auth:      auth,
+	}
+}
+
+var _ Handler = (*OperatorHandler)(nil)
+
+func (handler *OperatorHandler) InitRoutes(srvr *echo.Group) {
+	operator := srvr.Group("/operator", handler.auth.Middleware())
+	{
+		operator.POST("", handler.Create)
+		operator.GET("", handler.List)
+		operator.PATCH("/:id", handler.Update)
+	}
+}
+
+type Operator struct {
+	Id        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	Key       string    `json:"key,omitempty"`
+}
+
+func NewOperator(operator storage.Operator) Operator {
+	return Operator{
+		Id:        operator.Id,
+		Name:      operator.Name,
+		Role:      operator.Role,
+		Active:    operator.Active,
+		CreatedAt: operator.CreatedAt,
+	}
+}
+
+type createOperatorRequest struct {
+	Name string `json:"name" validate:"required,min=1"`
+	Role string `json:"role" validate:"required,operator_role"`
+}
+
+// Create - registers new operator. The raw key is returned only once and only its hash is stored.
+func (handler *OperatorHandler) Create(c echo.Context) error {
+	req, err := bindAndValidate[createOperatorRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	raw, err := newSecret()
+	if err != nil {
+		return handleError(c, err, handler.operators)
+	}
+	raw = operatorKeyPrefix + raw
+
+	operator := storage.Operator{
+		Name:      req.Name,
+		Role:      req.Role,
+		KeyHash:   storage.HashKey(raw),
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionCreate, storage.AuditEntityOperator, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Add(ctx, &operator); err != nil {
+			return 0, nil, nil, err
+		}
+		return operator.Id, nil, NewOperator(operator), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.operators)
+	}
+
+	response := NewOperator(operator)
+	response.Key = raw
+	return c.JSON(http.StatusOK, response)
+}
+
+type listOperatorsRequest struct {
+	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (req *listOperatorsRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+}
+
+func (handler *OperatorHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listOperatorsRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	operators, err := handler.operators.List(c.Request().Context(), req.Limit, req.Offset, sdk.SortOrderAsc)
+	if err != nil {
+		return handleError(c, err, handler.operators)
+	}
+
+	response := make([]Operator, len(operators))
+	for i := range operators {
+		response[i] = NewOperator(*operators[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type updateOperatorRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+
+	Role   string `json:"role"   validate:"omitempty,operator_role"`
+	Active *bool  `json:"active" validate:"omitempty"`
+}
+
+// Update - changes role of the operator or revokes its access. Operators are not deleted to keep audit trail readable.
+func (handler *OperatorHandler) Update(c echo.Context) error {
+	req, err := bindAndValidate[updateOperatorRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	operator, err := handler.operators.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.operators)
+	}
+	before := NewOperator(*operator)
+
+	if req.Role != "" {
+		operator.Role = req.Role
+	}
+	if req.Active != nil {
+		operator.Active = *req.Active
+	}
+
+	err = runAudited(c, handler.tx, storage.AuditActionUpdate, storage.AuditEntityOperator, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Update(ctx, operator); err != nil {
+			return 0, nil, nil, err
+		}
+		return operator.Id, before, NewOperator(*operator), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.operators)
+	}
+	return success(c)
+}
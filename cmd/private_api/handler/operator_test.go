@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	sdkMock "github.com/dipdup-net/indexer-sdk/pkg/storage/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const testRootKey = "root_key"
+
+var (
+	testAdmin = storage.Operator{
+		Id:     1,
+		Name:   "admin",
+		Role:   storage.OperatorRoleAdmin,
+		Active: true,
+	}
+	testEditor = storage.Operator{
+		Id:     2,
+		Name:   "editor",
+		Role:   storage.OperatorRoleAppEditor,
+		Active: true,
+	}
+)
+
+// OperatorTestSuite -
+type OperatorTestSuite struct {
+	suite.Suite
+	operators *mock.MockIOperator
+	audit     *mock.MockIAuditLog
+	tx        *sdkMock.MockTransaction
+	echo      *echo.Echo
+	ctrl      *gomock.Controller
+}
+
+// SetupTest -
+func (s *OperatorTestSuite) SetupTest() {
+	s.T().Setenv("PRIVATE_API_AUTH_KEY", testRootKey)
+
+	s.echo = echo.New()
+	s.echo.Validator = NewApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.operators = mock.NewMockIOperator(s.ctrl)
+	s.audit = mock.NewMockIAuditLog(s.ctrl)
+	s.tx = sdkMock.NewMockTransaction(s.ctrl)
+
+	transactable := sdkMock.NewMockTransactable(s.ctrl)
+	transactable.EXPECT().
+		BeginTransaction(gomock.Any()).
+		Return(s.tx, nil).
+		AnyTimes()
+
+	auth := NewAuth(s.operators)
+	v1 := s.echo.Group("v1")
+	NewOperatorHandler(s.operators, transactable, auth).InitRoutes(v1)
+	NewAuditHandler(s.audit, auth).InitRoutes(v1)
+}
+
+// TearDownTest -
+func (s *OperatorTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSuiteOperator_Run(t *testing.T) {
+	suite.Run(t, new(OperatorTestSuite))
+}
+
+func (s *OperatorTestSuite) request(method, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+key)
+	}
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *OperatorTestSuite) expectOperator(key string, operator storage.Operator) {
+	s.operators.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey(key)).
+		Return(operator, nil).
+		Times(1)
+}
+
+func (s *OperatorTestSuite) TestAuth() {
+	rec := s.request(http.MethodGet, "/v1/audit", "", "")
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	s.operators.EXPECT().
+		ByHash(gomock.Any(), storage.HashKey("unknown")).
+		Return(storage.Operator{}, sql.ErrNoRows).
+		Times(1)
+	s.operators.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+	rec = s.request(http.MethodGet, "/v1/audit", "unknown", "")
+	s.Require().Equal(http.StatusUnauthorized, rec.Code)
+
+	inactive := testAdmin
+	inactive.Active = false
+	s.expectOperator("op_inactive", inactive)
+	rec = s.request(http.MethodGet, "/v1/audit", "op_inactive", "")
+	s.Require().Equal(http.StatusUnauthorized, rec.Code)
+
+	s.expectOperator("op_editor", testEditor)
+	rec = s.request(http.MethodGet, "/v1/audit", "op_editor", "")
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *OperatorTestSuite) TestCreate() {
+	s.expectOperator("op_admin", testAdmin)
+
+	operatorCall := s.tx.EXPECT().
+		Add(gomock.Any(), gomock.AssignableToTypeOf(&storage.Operator{})).
+		DoAndReturn(func(_ context.Context, model any) error {
+			operator := model.(*storage.Operator)
+			s.Require().Equal("curator", operator.Name)
+			s.Require().Equal(storage.OperatorRoleAppEditor, operator.Role)
+			s.Require().True(operator.Active)
+			s.Require().Len(operator.KeyHash, 64)
+			operator.Id = 3
+			return nil
+		}).
+		Times(1)
+
+	auditCall := s.tx.EXPECT().
+		Add(gomock.Any(), gomock.AssignableToTypeOf(&storage.AuditLog{})).
+		DoAndReturn(func(_ context.Context, model any) error {
+			log := model.(*storage.AuditLog)
+			s.Require().EqualValues(1, log.OperatorId)
+			s.Require().Equal("admin", log.OperatorName)
+			s.Require().Equal(storage.AuditActionCreate, log.Action)
+			s.Require().Equal(storage.AuditEntityOperator, log.Entity)
+			s.Require().EqualValues(3, log.EntityId)
+			s.Require().Empty(log.Before)
+			s.Require().Contains(log.After, `"role":"app_editor"`)
+			s.Require().NotContains(log.After, "key")
+			return nil
+		}).
+		After(operatorCall).
+		Times(1)
+
+	s.tx.EXPECT().
+		Flush(gomock.Any()).
+		Return(nil).
+		After(auditCall).
+		Times(1)
+
+	rec := s.request(http.MethodPost, "/v1/operator", "op_admin", `{"name":"curator","role":"app_editor"}`)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+	var operator Operator
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&operator))
+	s.Require().EqualValues(3, operator.Id)
+	s.Require().True(strings.HasPrefix(operator.Key, operatorKeyPrefix))
+
+	rec = s.request(http.MethodPost, "/v1/operator", testRootKey, `{"name":"curator","role":"unknown"}`)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *OperatorTestSuite) TestUpdate() {
+	operator := testEditor
+	s.operators.EXPECT().
+		GetByID(gomock.Any(), uint64(2)).
+		Return(&operator, nil).
+		Times(1)
+
+	s.tx.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			s.Require().False(model.(*storage.Operator).Active)
+			return nil
+		}).
+		Times(1)
+
+	s.tx.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model any) error {
+			log := model.(*storage.AuditLog)
+			s.Require().Zero(log.OperatorId)
+			s.Require().Equal(rootOperatorName, log.OperatorName)
+			s.Require().Equal(storage.AuditActionUpdate, log.Action)
+			s.Require().JSONEq(`{"id":2,"name":"editor","role":"app_editor","active":true,"created_at":"0001-01-01T00:00:00Z"}`, log.Before)
+			s.Require().JSONEq(`{"id":2,"name":"editor","role":"app_editor","active":false,"created_at":"0001-01-01T00:00:00Z"}`, log.After)
+			return nil
+		}).
+		Times(1)
+
+	s.tx.EXPECT().
+		Flush(gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	rec := s.request(http.MethodPatch, "/v1/operator/2", testRootKey, `{"active":false}`)
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func (s *OperatorTestSuite) TestUpdateRollbackOnAuditError() {
+	operator := testEditor
+	s.operators.EXPECT().
+		GetByID(gomock.Any(), uint64(2)).
+		Return(&operator, nil).
+		Times(1)
+	s.operators.EXPECT().
+		IsNoRows(gomock.Any()).
+		Return(false).
+		Times(1)
+
+	s.tx.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	auditErr := errors.New("audit is unavailable")
+	s.tx.EXPECT().
+		Add(gomock.Any(), gomock.Any()).
+		Return(auditErr).
+		Times(1)
+
+	// mutation is not committed without its audit record
+	s.tx.EXPECT().
+		HandleError(gomock.Any(), auditErr).
+		Return(auditErr).
+		Times(1)
+
+	rec := s.request(http.MethodPatch, "/v1/operator/2", testRootKey, `{"active":false}`)
+	s.Require().Equal(http.StatusInternalServerError, rec.Code, rec.Body.String())
+}
+
+func (s *OperatorTestSuite) TestAuditList() {
+	s.audit.EXPECT().
+		Filter(gomock.Any(), storage.AuditLogFilter{
+			Limit:    10,
+			Sort:     sdk.SortOrderDesc,
+			Entity:   storage.AuditEntityApp,
+			EntityId: 1,
+			TimeFrom: time.Unix(1700000000, 0).UTC(),
+		}).
+		Return([]storage.AuditLog{
+			{
+				Id:           2,
+				Time:         time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+				OperatorId:   2,
+				OperatorName: "editor",
+				Action:       storage.AuditActionDelete,
+				Entity:       storage.AuditEntityApp,
+				EntityId:     1,
+				Before:       `{"id":1,"name":"App"}`,
+			},
+		}, nil).
+		Times(1)
+
+	rec := s.request(http.MethodGet, "/v1/audit?entity=app&entity_id=1&from=1700000000", testRootKey, "")
+	s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	s.Require().JSONEq(`[{
+		"id": 2,
+		"time": "2023-12-01T00:00:00Z",
+		"operator_id": 2,
+		"operator_name": "editor",
+		"action": "delete",
+		"entity": "app",
+		"entity_id": 1,
+		"before": {"id":1,"name":"App"}
+	}]`, rec.Body.String())
+
+	rec = s.request(http.MethodGet, "/v1/audit?entity=unknown", testRootKey, "")
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
@@ -5,8 +5,10 @@ package handler
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/celenium-io/astria-indexer/internal/astria"
+	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -17,6 +19,11 @@ type ApiValidator struct {
 }
 
 func NewApiValidator() *ApiValidator {
+	return &ApiValidator{validator: NewValidator()}
+}
+
+// NewValidator - creates validator with custom rules of the private API
+func NewValidator() *validator.Validate {
 	v := validator.New()
 	if err := v.RegisterValidation("address", addressValidator()); err != nil {
 		panic(err)
@@ -27,7 +34,13 @@ func NewApiValidator() *ApiValidator {
 	if err := v.RegisterValidation("app_type", appTypeValidator()); err != nil {
 		panic(err)
 	}
-	return &ApiValidator{validator: v}
+	if err := v.RegisterValidation("webhook_event", webhookEventValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("operator_role", operatorRoleValidator()); err != nil {
+		panic(err)
+	}
+	return v
 }
 
 func (v *ApiValidator) Validate(i interface{}) error {
@@ -60,3 +73,15 @@ func appTypeValidator() validator.Func {
 		return err == nil
 	}
 }
+
+func webhookEventValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return slices.Contains(storage.WebhookEvents, fl.Field().String())
+	}
+}
+
+func operatorRoleValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return slices.Contains(storage.OperatorRoles, fl.Field().String())
+	}
+}
@@ -0,0 +1,398 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+const secretSize = 32
+
+type WebhookHandler struct {
+	webhooks   storage.IWebhook
+	deliveries storage.IWebhookDelivery
+	tx         sdk.Transactable
+	auth       *Auth
+}
+
+func NewWebhookHandler(
+	webhooks storage.IWebhook,
+	deliveries storage.IWebhookDelivery,
+	tx sdk.Transactable,
+	auth *Auth,
+) *WebhookHandler {
+	return &WebhookHandler{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		tx:         tx,
+		auth:       auth,
+	}
+}
+
+var _ Handler = (*WebhookHandler)(nil)
+
+func (handler *WebhookHandler) InitRoutes(srvr *echo.Group) {
+	webhook := srvr.Group("/webhook", handler.auth.Middleware())
+	{
+		webhook.POST("", handler.Create)
+		webhook.GET("", handler.List)
+		webhook.GET("/dead_letter", handler.DeadLetters)
+		webhook.POST("/delivery/:id/retry", handler.Retry)
+		webhook.GET("/:id", handler.Get)
+		webhook.PATCH("/:id", handler.Update)
+		webhook.DELETE("/:id", handler.Delete)
+		webhook.GET("/:id/deliveries", handler.Deliveries)
+	}
+}
+
+type Webhook struct {
+	Id        uint64    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Url       string    `json:"url"`
+	Event     string    `json:"event"`
+	Filter    string    `json:"filter,omitempty"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+}
+
+func NewWebhook(webhook storage.Webhook) Webhook {
+	return Webhook{
+		Id:        webhook.Id,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+		Url:       webhook.Url,
+		Event:     webhook.Event,
+		Filter:    webhook.Filter,
+		Active:    webhook.Active,
+	}
+}
+
+type WebhookDelivery struct {
+	Id            uint64          `json:"id"`
+	WebhookId     uint64          `json:"webhook_id"`
+	Event         string          `json:"event"`
+	Height        pkgTypes.Level  `json:"height,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	ResponseCode  int             `json:"response_code,omitempty"`
+	LastError     string          `json:"last_error,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	DeliveredAt   *time.Time      `json:"delivered_at,omitempty"`
+}
+
+func NewWebhookDelivery(delivery storage.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		Id:            delivery.Id,
+		WebhookId:     delivery.WebhookId,
+		Event:         delivery.Event,
+		Height:        delivery.Height,
+		Payload:       json.RawMessage(delivery.Payload),
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		ResponseCode:  delivery.ResponseCode,
+		LastError:     delivery.LastError,
+		CreatedAt:     delivery.CreatedAt,
+		DeliveredAt:   delivery.DeliveredAt,
+	}
+}
+
+type createWebhookRequest struct {
+	Url    string `json:"url"    validate:"required,url"`
+	Event  string `json:"event"  validate:"required,webhook_event"`
+	Filter string `json:"filter" validate:"omitempty,address"`
+	Secret string `json:"secret" validate:"omitempty,min=16"`
+}
+
+func (handler *WebhookHandler) Create(c echo.Context) error {
+	req, err := bindAndValidate[createWebhookRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	if err := checkFilter(req.Event, req.Filter); err != nil {
+		return badRequestError(c, err)
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret, err = newSecret()
+		if err != nil {
+			return handleError(c, err, handler.webhooks)
+		}
+	}
+
+	now := time.Now().UTC()
+	webhook := storage.Webhook{
+		CreatedAt: now,
+		UpdatedAt: now,
+		Url:       req.Url,
+		Secret:    secret,
+		Event:     req.Event,
+		Filter:    req.Filter,
+		Active:    true,
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionCreate, storage.AuditEntityWebhook, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Add(ctx, &webhook); err != nil {
+			return 0, nil, nil, err
+		}
+		return webhook.Id, nil, NewWebhook(webhook), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	response := NewWebhook(webhook)
+	response.Secret = webhook.Secret
+	return c.JSON(http.StatusOK, response)
+}
+
+type listWebhooksRequest struct {
+	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (req *listWebhooksRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+}
+
+func (handler *WebhookHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[listWebhooksRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	webhooks, err := handler.webhooks.List(c.Request().Context(), req.Limit, req.Offset, sdk.SortOrderAsc)
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+
+	response := make([]Webhook, len(webhooks))
+	for i := range webhooks {
+		response[i] = NewWebhook(*webhooks[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+type webhookIdRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+func (handler *WebhookHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[webhookIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	webhook, err := handler.webhooks.GetByID(c.Request().Context(), req.Id)
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+	return c.JSON(http.StatusOK, NewWebhook(*webhook))
+}
+
+type updateWebhookRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+
+	Url    string  `json:"url"    validate:"omitempty,url"`
+	Filter *string `json:"filter" validate:"omitempty"`
+	Active *bool   `json:"active" validate:"omitempty"`
+}
+
+func (handler *WebhookHandler) Update(c echo.Context) error {
+	req, err := bindAndValidate[updateWebhookRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	webhook, err := handler.webhooks.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+	before := NewWebhook(*webhook)
+
+	if req.Url != "" {
+		webhook.Url = req.Url
+	}
+	if req.Filter != nil {
+		if *req.Filter != "" && !isAddress(*req.Filter) {
+			return badRequestError(c, errInvalidAddress)
+		}
+		if err := checkFilter(webhook.Event, *req.Filter); err != nil {
+			return badRequestError(c, err)
+		}
+		webhook.Filter = *req.Filter
+	}
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+	webhook.UpdatedAt = time.Now().UTC()
+
+	err = runAudited(c, handler.tx, storage.AuditActionUpdate, storage.AuditEntityWebhook, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Update(ctx, webhook); err != nil {
+			return 0, nil, nil, err
+		}
+		return webhook.Id, before, NewWebhook(*webhook), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+	return success(c)
+}
+
+func (handler *WebhookHandler) Delete(c echo.Context) error {
+	req, err := bindAndValidate[webhookIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	webhook, err := handler.webhooks.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+	err = runAudited(c, handler.tx, storage.AuditActionDelete, storage.AuditEntityWebhook, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.DeleteWebhook(ctx, req.Id); err != nil {
+			return 0, nil, nil, err
+		}
+		return req.Id, NewWebhook(*webhook), nil, nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.webhooks)
+	}
+	return success(c)
+}
+
+type webhookDeliveriesRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  int    `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+	Sort   string `query:"sort"   validate:"omitempty,oneof=asc desc"`
+}
+
+func (req *webhookDeliveriesRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	if req.Sort == "" {
+		req.Sort = string(sdk.SortOrderDesc)
+	}
+}
+
+func (handler *WebhookHandler) Deliveries(c echo.Context) error {
+	req, err := bindAndValidate[webhookDeliveriesRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	deliveries, err := handler.deliveries.ByWebhookId(c.Request().Context(), req.Id, req.Limit, req.Offset, sdk.SortOrder(req.Sort))
+	if err != nil {
+		return handleError(c, err, handler.deliveries)
+	}
+	return returnDeliveries(c, deliveries)
+}
+
+type deadLettersRequest struct {
+	Limit  int `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset int `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (req *deadLettersRequest) SetDefault() {
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+}
+
+func (handler *WebhookHandler) DeadLetters(c echo.Context) error {
+	req, err := bindAndValidate[deadLettersRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	deliveries, err := handler.deliveries.DeadLetters(c.Request().Context(), req.Limit, req.Offset)
+	if err != nil {
+		return handleError(c, err, handler.deliveries)
+	}
+	return returnDeliveries(c, deliveries)
+}
+
+func (handler *WebhookHandler) Retry(c echo.Context) error {
+	req, err := bindAndValidate[webhookIdRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	delivery, err := handler.deliveries.GetByID(ctx, req.Id)
+	if err != nil {
+		return handleError(c, err, handler.deliveries)
+	}
+	if delivery.Status != storage.WebhookDeliveryFailed {
+		return badRequestError(c, errors.Errorf("delivery %d is not failed", req.Id))
+	}
+	before := NewWebhookDelivery(*delivery)
+
+	delivery.Status = storage.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	delivery.NextAttemptAt = time.Now().UTC()
+
+	err = runAudited(c, handler.tx, storage.AuditActionUpdate, storage.AuditEntityWebhookDelivery, func(ctx context.Context, tx storage.Transaction) (uint64, any, any, error) {
+		if err := tx.Update(ctx, delivery); err != nil {
+			return 0, nil, nil, err
+		}
+		return delivery.Id, before, NewWebhookDelivery(*delivery), nil
+	})
+	if err != nil {
+		return handleError(c, err, handler.deliveries)
+	}
+	return success(c)
+}
+
+func returnDeliveries(c echo.Context, deliveries []storage.WebhookDelivery) error {
+	response := make([]WebhookDelivery, len(deliveries))
+	for i := range deliveries {
+		response[i] = NewWebhookDelivery(deliveries[i])
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func checkFilter(event, filter string) error {
+	if filter == "" {
+		return nil
+	}
+	switch event {
+	case storage.WebhookEventTransfer, storage.WebhookEventDeposit:
+		return nil
+	default:
+		return errors.Errorf("filter is not supported by %s event", event)
+	}
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
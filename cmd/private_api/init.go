@@ -11,7 +11,10 @@ import (
 	"time"
 
 	"cosmossdk.io/errors"
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
 	"github.com/celenium-io/astria-indexer/cmd/private_api/handler"
+	"github.com/celenium-io/astria-indexer/cmd/private_api/webhook"
+	models "github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -23,7 +26,11 @@ import (
 	"golang.org/x/time/rate"
 )
 
+var configPath string
+
 func init() {
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "dipdup.yml", "path to YAML config file")
+
 	zerolog.CallerMarshalFunc = func(pc uintptr, file string, line int) string {
 		short := file
 		for i := len(file) - 1; i > 0; i-- {
@@ -45,17 +52,8 @@ func init() {
 }
 
 func loadConfig() (*Config, error) {
-	configPath := rootCmd.PersistentFlags().StringP("config", "c", "dipdup.yml", "path to YAML config file")
-	if err := rootCmd.Execute(); err != nil {
-		return nil, errors.Wrap(err, "command line execute")
-	}
-
-	if err := rootCmd.MarkFlagRequired("config"); err != nil {
-		return nil, errors.Wrap(err, "config command line arg is required")
-	}
-
 	var cfg Config
-	if err := config.Parse(*configPath, &cfg); err != nil {
+	if err := config.Parse(configPath, &cfg); err != nil {
 		return nil, errors.Wrap(err, "parsing config file")
 	}
 
@@ -168,3 +166,27 @@ func newDatabase(cfg *Config) (*sdk.Storage, error) {
 func newTransactable(db *sdk.Storage) storage.Transactable {
 	return db.Transactable
 }
+
+func databaseConfig(cfg *Config) config.Database {
+	return cfg.Database
+}
+
+func newWebhookProducer(
+	dispatcher *bus.Dispatcher,
+	tx storage.Transactable,
+	states models.IWebhookState,
+	blocks models.IBlock,
+	webhooks models.IWebhook,
+	transfers models.ITransfer,
+	deposits models.IDeposit,
+	rollups models.IRollup,
+	actions models.IAction,
+	constants models.IConstantHistory,
+) *webhook.Producer {
+	observer := dispatcher.Observe(models.ChannelBlock, models.ChannelRollback)
+	return webhook.NewProducer(observer, tx, states, blocks, webhooks, transfers, deposits, rollups, actions, constants)
+}
+
+func newWebhookSender(cfg *Config, webhooks models.IWebhook, deliveries models.IWebhookDelivery) *webhook.Sender {
+	return webhook.NewSender(cfg.ApiConfig.Webhooks, webhooks, deliveries)
+}
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
 	"github.com/celenium-io/astria-indexer/cmd/private_api/handler"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
@@ -21,23 +22,45 @@ import (
 
 var rootCmd = &cobra.Command{
 	Use: "private_api",
+	Run: func(cmd *cobra.Command, args []string) {
+		run()
+	},
 }
 
 func main() {
+	if err := rootCmd.Execute(); err != nil {
+		log.Err(err).Msg("command line execute")
+		os.Exit(1)
+	}
+}
+
+func run() {
 	app := fx.New(
 		fx.WithLogger(fxlogger.WithZerolog(log.Logger)),
 		fx.StartTimeout(5*time.Minute),
 		fx.Provide(
 			loadConfig,
+			databaseConfig,
 
 			fx.Annotate(
 				newServer,
 				fx.ParamTags("", `group:"handlers"`),
 			),
 			newApp,
+			bus.NewDispatcher,
+			newWebhookProducer,
+			newWebhookSender,
 
 			newDatabase,
 			newTransactable,
+			fx.Annotate(
+				postgres.NewListenerFactory,
+				fx.As(new(storage.ListenerFactory)),
+			),
+			fx.Annotate(
+				postgres.NewAction,
+				fx.As(new(storage.IAction)),
+			),
 			fx.Annotate(
 				postgres.NewAddress,
 				fx.As(new(storage.IAddress)),
@@ -46,12 +69,65 @@ func main() {
 				postgres.NewApp,
 				fx.As(new(storage.IApp)),
 			),
+			fx.Annotate(
+				postgres.NewApiKey,
+				fx.As(new(storage.IApiKey)),
+			),
+			fx.Annotate(
+				postgres.NewApiKeyUsage,
+				fx.As(new(storage.IApiKeyUsage)),
+			),
+			fx.Annotate(
+				postgres.NewApiTier,
+				fx.As(new(storage.IApiTier)),
+			),
+			fx.Annotate(
+				postgres.NewAuditLog,
+				fx.As(new(storage.IAuditLog)),
+			),
+			fx.Annotate(
+				postgres.NewBlocks,
+				fx.As(new(storage.IBlock)),
+			),
+			fx.Annotate(
+				postgres.NewConstantHistory,
+				fx.As(new(storage.IConstantHistory)),
+			),
+			fx.Annotate(
+				postgres.NewDeposit,
+				fx.As(new(storage.IDeposit)),
+			),
+			fx.Annotate(
+				postgres.NewOperator,
+				fx.As(new(storage.IOperator)),
+			),
 			fx.Annotate(
 				postgres.NewRollup,
 				fx.As(new(storage.IRollup)),
 			),
+			fx.Annotate(
+				postgres.NewTransfer,
+				fx.As(new(storage.ITransfer)),
+			),
+			fx.Annotate(
+				postgres.NewWebhook,
+				fx.As(new(storage.IWebhook)),
+			),
+			fx.Annotate(
+				postgres.NewWebhookDelivery,
+				fx.As(new(storage.IWebhookDelivery)),
+			),
+			fx.Annotate(
+				postgres.NewWebhookState,
+				fx.As(new(storage.IWebhookState)),
+			),
 
+			handler.NewAuth,
+			AsHandler(handler.NewApiKeyHandler),
 			AsHandler(handler.NewAppHandler),
+			AsHandler(handler.NewAuditHandler),
+			AsHandler(handler.NewOperatorHandler),
+			AsHandler(handler.NewWebhookHandler),
 		),
 		fx.Invoke(func(*App) {}),
 	)
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/celenium-io/astria-indexer/cmd/private_api/handler"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var extensions = []string{".yml", ".yaml", ".json"}
+
+// App - description of application in the registry. One file describes one application. Rollup is the identity of application: it's used to match files with applications in the database. Rollups and Bridges are additional links of application, they replace links stored in the database.
+type App struct {
+	Group        string       `json:"group"         validate:"omitempty,min=1"        yaml:"group"`
+	Name         string       `json:"name"          validate:"required,min=1"         yaml:"name"`
+	Description  string       `json:"description"   validate:"required,min=1"         yaml:"description"`
+	Website      string       `json:"website"       validate:"omitempty,url"          yaml:"website"`
+	GitHub       string       `json:"github"        validate:"omitempty,url"          yaml:"github"`
+	Twitter      string       `json:"twitter"       validate:"omitempty,url"          yaml:"twitter"`
+	Logo         string       `json:"logo"          validate:"omitempty,url"          yaml:"logo"`
+	L2Beat       string       `json:"l2beat"        validate:"omitempty,url"          yaml:"l2beat"`
+	Explorer     string       `json:"explorer"      validate:"omitempty,url"          yaml:"explorer"`
+	Stack        string       `json:"stack"         validate:"omitempty"              yaml:"stack"`
+	Links        []string     `json:"links"         validate:"omitempty,dive,url"     yaml:"links"`
+	Category     string       `json:"category"      validate:"omitempty,app_category" yaml:"category"`
+	Type         string       `json:"type"          validate:"omitempty,app_type"     yaml:"type"`
+	VM           string       `json:"vm"            validate:"omitempty"              yaml:"vm"`
+	Provider     string       `json:"provider"      validate:"omitempty"              yaml:"provider"`
+	Rollup       string       `json:"rollup"        validate:"required,base64"        yaml:"rollup"`
+	NativeBridge string       `json:"native_bridge" validate:"omitempty,address"      yaml:"native_bridge"`
+	Rollups      []RollupLink `json:"rollups"       validate:"omitempty,dive"         yaml:"rollups"`
+	Bridges      []BridgeLink `json:"bridges"       validate:"omitempty,dive"         yaml:"bridges"`
+
+	File string `json:"-" yaml:"-"`
+}
+
+// RollupLink - additional rollup of application, e.g. testnet. Rollup may be linked only to one application.
+type RollupLink struct {
+	Rollup string `json:"rollup" validate:"required,base64"                  yaml:"rollup"`
+	Role   string `json:"role"   validate:"required,min=1,max=64,ne=primary" yaml:"role"`
+}
+
+// BridgeLink - additional bridge account of application
+type BridgeLink struct {
+	Bridge string `json:"bridge" validate:"required,address"                yaml:"bridge"`
+	Role   string `json:"role"   validate:"required,min=1,max=64,ne=native" yaml:"role"`
+}
+
+// Load - reads and validates all application files of the directory. Files with unknown fields are rejected to catch typos.
+func Load(dir string) ([]App, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read registry directory")
+	}
+
+	validator := handler.NewValidator()
+	apps := make([]App, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(extensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path) //nolint:gosec
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", entry.Name())
+		}
+
+		var app App
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&app); err != nil {
+			return nil, errors.Wrapf(err, "decode %s", entry.Name())
+		}
+		if err := validator.Struct(app); err != nil {
+			return nil, errors.Wrapf(err, "validate %s", entry.Name())
+		}
+		app.File = entry.Name()
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
@@ -0,0 +1,463 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testRollup  = "AQID"
+	testRollup2 = "BAUG"
+	testBridge  = "astria1lhpxecq5ffhq68dgu9s8y2g5h53jqw5cvudrkk"
+)
+
+func writeFile(t *testing.T, dir, name, data string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "app.yml", `
+name: Test App
+description: Application description
+website: https://example.com
+links:
+  - https://example.com/docs
+category: nft
+type: sovereign
+rollup: AQID
+native_bridge: astria1lhpxecq5ffhq68dgu9s8y2g5h53jqw5cvudrkk
+`)
+	writeFile(t, dir, "other.json", `{"name":"Other","description":"Other application","rollup":"BAUG"}`)
+	writeFile(t, dir, "README.md", "# registry")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested"), 0o700))
+
+	apps, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+
+	require.Equal(t, "app.yml", apps[0].File)
+	require.Equal(t, "Test App", apps[0].Name)
+	require.Equal(t, []string{"https://example.com/docs"}, apps[0].Links)
+	require.Equal(t, testBridge, apps[0].NativeBridge)
+
+	require.Equal(t, "other.json", apps[1].File)
+	require.Equal(t, testRollup2, apps[1].Rollup)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "unknown field",
+			data: "name: App\ndescription: desc\nrollup: AQID\ntwiter: https://x.com",
+		}, {
+			name: "invalid category",
+			data: "name: App\ndescription: desc\nrollup: AQID\ncategory: unknown",
+		}, {
+			name: "invalid type",
+			data: "name: App\ndescription: desc\nrollup: AQID\ntype: unknown",
+		}, {
+			name: "invalid rollup",
+			data: "name: App\ndescription: desc\nrollup: '!!!'",
+		}, {
+			name: "invalid bridge",
+			data: "name: App\ndescription: desc\nrollup: AQID\nnative_bridge: celestia1",
+		}, {
+			name: "without name",
+			data: "description: desc\nrollup: AQID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, dir, "app.yml", tt.data)
+
+			_, err := Load(dir)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "app.yml")
+		})
+	}
+}
+
+type syncerMocks struct {
+	apps      *mock.MockIApp
+	rollups   *mock.MockIRollup
+	addresses *mock.MockIAddress
+}
+
+func newTestSyncer(t *testing.T) (*Syncer, syncerMocks) {
+	ctrl := gomock.NewController(t)
+	m := syncerMocks{
+		apps:      mock.NewMockIApp(ctrl),
+		rollups:   mock.NewMockIRollup(ctrl),
+		addresses: mock.NewMockIAddress(ctrl),
+	}
+	return NewSyncer(m.apps, m.rollups, m.addresses, nil), m
+}
+
+func TestPlan(t *testing.T) {
+	syncer, m := newTestSyncer(t)
+
+	m.apps.EXPECT().
+		List(gomock.Any(), uint64(pageSize), uint64(0), sdk.SortOrderAsc).
+		Return([]*storage.App{
+			{
+				Id:          1,
+				Name:        "Test App",
+				Slug:        "test-app",
+				Description: "Old description",
+				Twitter:     "https://x.com/app",
+				Category:    types.AppCategoryNft,
+				RollupId:    10,
+			}, {
+				Id:          2,
+				Name:        "Unchanged",
+				Slug:        "unchanged",
+				Description: "Description",
+				RollupId:    20,
+			}, {
+				Id:          3,
+				Name:        "Removed",
+				Slug:        "removed",
+				Description: "Description",
+				RollupId:    30,
+			},
+		}, nil).
+		Times(1)
+
+	m.rollups.EXPECT().
+		ByHash(gomock.Any(), []byte{1, 2, 3}).
+		Return(storage.Rollup{Id: 10}, nil).
+		Times(1)
+	m.rollups.EXPECT().
+		ByHash(gomock.Any(), []byte{4, 5, 6}).
+		Return(storage.Rollup{Id: 20}, nil).
+		Times(1)
+	m.rollups.EXPECT().
+		ByHash(gomock.Any(), []byte{7, 8, 9}).
+		Return(storage.Rollup{Id: 40}, nil).
+		Times(1)
+
+	m.addresses.EXPECT().
+		ByHash(gomock.Any(), testBridge).
+		Return(storage.Address{Id: 100, IsBridge: true}, nil).
+		Times(1)
+
+	m.apps.EXPECT().
+		Rollups(gomock.Any(), gomock.Any()).
+		Return([]storage.AppRollup{}, nil).
+		Times(3)
+	m.apps.EXPECT().
+		Bridges(gomock.Any(), gomock.Any()).
+		Return([]storage.AppBridge{}, nil).
+		Times(3)
+
+	changes, err := syncer.Plan(context.Background(), []App{
+		{
+			File:         "test.yml",
+			Name:         "Test App",
+			Description:  "New description",
+			Category:     "nft",
+			Rollup:       testRollup,
+			NativeBridge: testBridge,
+		}, {
+			File:        "unchanged.yml",
+			Name:        "Unchanged",
+			Description: "Description",
+			Rollup:      testRollup2,
+		}, {
+			File:        "new.yml",
+			Name:        "New App",
+			Description: "Description",
+			Rollup:      "BwgJ",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	require.Equal(t, storage.AuditActionDelete, changes[0].Action)
+	require.Equal(t, "removed", changes[0].Slug())
+	require.Nil(t, changes[0].After)
+
+	require.Equal(t, storage.AuditActionUpdate, changes[1].Action)
+	require.Equal(t, "test-app", changes[1].Slug())
+	require.Equal(t, []string{"description", "twitter", "native_bridge"}, changes[1].Fields)
+	require.EqualValues(t, 1, changes[1].After.Id)
+	require.EqualValues(t, 100, changes[1].After.NativeBridgeId)
+
+	require.Equal(t, storage.AuditActionCreate, changes[2].Action)
+	require.Equal(t, "new-app", changes[2].Slug())
+	require.EqualValues(t, 40, changes[2].After.RollupId)
+	require.Zero(t, changes[2].After.Id)
+}
+
+func TestPlanLinks(t *testing.T) {
+	syncer, m := newTestSyncer(t)
+
+	m.apps.EXPECT().
+		List(gomock.Any(), uint64(pageSize), uint64(0), sdk.SortOrderAsc).
+		Return([]*storage.App{
+			{
+				Id:          1,
+				Name:        "Test App",
+				Slug:        "test-app",
+				Description: "Description",
+				RollupId:    10,
+			}, {
+				Id:          3,
+				Name:        "Removed",
+				Slug:        "removed",
+				Description: "Description",
+				RollupId:    30,
+			},
+		}, nil).
+		Times(1)
+
+	m.rollups.EXPECT().
+		ByHash(gomock.Any(), []byte{1, 2, 3}).
+		Return(storage.Rollup{Id: 10}, nil).
+		Times(1)
+	m.rollups.EXPECT().
+		ByHash(gomock.Any(), []byte{4, 5, 6}).
+		Return(storage.Rollup{Id: 20}, nil).
+		Times(1)
+	m.addresses.EXPECT().
+		ByHash(gomock.Any(), testBridge).
+		Return(storage.Address{Id: 100, IsBridge: true}, nil).
+		Times(1)
+
+	m.apps.EXPECT().
+		Rollups(gomock.Any(), uint64(1)).
+		Return([]storage.AppRollup{
+			{AppId: 1, RollupId: 10, Role: storage.AppRolePrimary},
+			{AppId: 1, RollupId: 20, Role: "devnet"},
+			{AppId: 1, RollupId: 21, Role: "devnet"},
+		}, nil).
+		Times(1)
+	m.apps.EXPECT().
+		Bridges(gomock.Any(), uint64(1)).
+		Return([]storage.AppBridge{}, nil).
+		Times(1)
+	m.apps.EXPECT().
+		Rollups(gomock.Any(), uint64(3)).
+		Return([]storage.AppRollup{
+			{AppId: 3, RollupId: 30, Role: storage.AppRolePrimary},
+			{AppId: 3, RollupId: 31, Role: "testnet"},
+		}, nil).
+		Times(1)
+	m.apps.EXPECT().
+		Bridges(gomock.Any(), uint64(3)).
+		Return([]storage.AppBridge{
+			{AppId: 3, BridgeId: 300, Role: "withdrawals"},
+		}, nil).
+		Times(1)
+
+	changes, err := syncer.Plan(context.Background(), []App{
+		{
+			File:        "test.yml",
+			Name:        "Test App",
+			Description: "Description",
+			Rollup:      testRollup,
+			Rollups: []RollupLink{
+				{Rollup: testRollup2, Role: "testnet"},
+			},
+			Bridges: []BridgeLink{
+				{Bridge: testBridge, Role: "withdrawals"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	require.Equal(t, storage.AuditActionDelete, changes[0].Action)
+	require.Equal(t, "removed", changes[0].Slug())
+	require.Len(t, changes[0].Links, 2)
+	require.Equal(t, storage.AuditActionDelete, changes[0].Links[0].Action)
+	require.EqualValues(t, 31, changes[0].Links[0].Rollup.RollupId)
+	require.Equal(t, storage.AuditActionDelete, changes[0].Links[1].Action)
+	require.EqualValues(t, 300, changes[0].Links[1].Bridge.BridgeId)
+
+	require.Equal(t, storage.AuditActionUpdate, changes[1].Action)
+	require.Empty(t, changes[1].Fields)
+	require.Len(t, changes[1].Links, 3)
+	require.Equal(t, storage.AuditActionDelete, changes[1].Links[0].Action)
+	require.EqualValues(t, 21, changes[1].Links[0].Rollup.RollupId)
+	require.Equal(t, storage.AuditActionUpdate, changes[1].Links[1].Action)
+	require.EqualValues(t, 20, changes[1].Links[1].Rollup.RollupId)
+	require.Equal(t, "testnet", changes[1].Links[1].Rollup.Role)
+	require.Equal(t, "devnet", changes[1].Links[1].PrevRole)
+	require.Equal(t, storage.AuditActionCreate, changes[1].Links[2].Action)
+	require.EqualValues(t, 100, changes[1].Links[2].Bridge.BridgeId)
+}
+
+func TestPlanErrors(t *testing.T) {
+	t.Run("unknown rollup", func(t *testing.T) {
+		syncer, m := newTestSyncer(t)
+		m.apps.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*storage.App{}, nil).
+			Times(1)
+		m.rollups.EXPECT().
+			ByHash(gomock.Any(), gomock.Any()).
+			Return(storage.Rollup{}, sql.ErrNoRows).
+			Times(1)
+		m.rollups.EXPECT().
+			IsNoRows(sql.ErrNoRows).
+			Return(true).
+			Times(1)
+
+		_, err := syncer.Plan(context.Background(), []App{
+			{File: "app.yml", Name: "App", Rollup: testRollup},
+		})
+		require.ErrorContains(t, err, "app.yml: unknown rollup AQID")
+	})
+
+	t.Run("not a bridge", func(t *testing.T) {
+		syncer, m := newTestSyncer(t)
+		m.apps.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*storage.App{}, nil).
+			Times(1)
+		m.rollups.EXPECT().
+			ByHash(gomock.Any(), gomock.Any()).
+			Return(storage.Rollup{Id: 1}, nil).
+			Times(1)
+		m.addresses.EXPECT().
+			ByHash(gomock.Any(), testBridge).
+			Return(storage.Address{Id: 1}, nil).
+			Times(1)
+
+		_, err := syncer.Plan(context.Background(), []App{
+			{File: "app.yml", Name: "App", Rollup: testRollup, NativeBridge: testBridge},
+		})
+		require.ErrorContains(t, err, "is not a bridge")
+	})
+
+	t.Run("linked primary rollup", func(t *testing.T) {
+		syncer, m := newTestSyncer(t)
+		m.apps.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*storage.App{}, nil).
+			Times(1)
+		m.rollups.EXPECT().
+			ByHash(gomock.Any(), gomock.Any()).
+			Return(storage.Rollup{Id: 1}, nil).
+			Times(2)
+
+		_, err := syncer.Plan(context.Background(), []App{
+			{File: "app.yml", Name: "App", Rollup: testRollup, Rollups: []RollupLink{{Rollup: testRollup, Role: "testnet"}}},
+		})
+		require.ErrorContains(t, err, "is the primary rollup of the application")
+	})
+
+	t.Run("duplicate rollup", func(t *testing.T) {
+		syncer, m := newTestSyncer(t)
+		m.apps.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*storage.App{}, nil).
+			Times(1)
+		m.rollups.EXPECT().
+			ByHash(gomock.Any(), gomock.Any()).
+			Return(storage.Rollup{Id: 1}, nil).
+			Times(2)
+
+		_, err := syncer.Plan(context.Background(), []App{
+			{File: "a.yml", Name: "A", Rollup: testRollup},
+			{File: "b.yml", Name: "B", Rollup: testRollup},
+		})
+		require.ErrorContains(t, err, "b.yml: rollup is already described in a.yml")
+	})
+}
+
+func TestCheckDeletions(t *testing.T) {
+	changes := func(actions ...string) []Change {
+		result := make([]Change, len(actions))
+		for i := range actions {
+			result[i].Action = actions[i]
+		}
+		return result
+	}
+	registry := func(count int) []App {
+		return make([]App, count)
+	}
+
+	t.Run("empty registry", func(t *testing.T) {
+		err := CheckDeletions(nil, changes(storage.AuditActionDelete, storage.AuditActionDelete))
+		require.ErrorIs(t, err, ErrUnsafeDeletion)
+		require.ErrorContains(t, err, "registry is empty")
+	})
+
+	t.Run("empty registry and database", func(t *testing.T) {
+		require.NoError(t, CheckDeletions(nil, nil))
+	})
+
+	t.Run("single deletion", func(t *testing.T) {
+		require.NoError(t, CheckDeletions(registry(1), changes(storage.AuditActionDelete)))
+	})
+
+	t.Run("under limit", func(t *testing.T) {
+		// 10 existing applications: 8 matched and 2 deleted
+		require.NoError(t, CheckDeletions(registry(9), changes(
+			storage.AuditActionDelete,
+			storage.AuditActionDelete,
+			storage.AuditActionUpdate,
+			storage.AuditActionCreate,
+		)))
+	})
+
+	t.Run("over limit", func(t *testing.T) {
+		// 10 existing applications: 7 matched and 3 deleted
+		err := CheckDeletions(registry(8), changes(
+			storage.AuditActionDelete,
+			storage.AuditActionDelete,
+			storage.AuditActionDelete,
+			storage.AuditActionCreate,
+		))
+		require.ErrorIs(t, err, ErrUnsafeDeletion)
+		require.ErrorContains(t, err, "3 of 10 applications would be deleted, limit is 2")
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	log, err := auditLog(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Change{
+		Action: storage.AuditActionDelete,
+		Before: &storage.App{Id: 3, Name: "Removed", Slug: "removed", RollupId: 30},
+	}, 3)
+	require.NoError(t, err)
+	require.Equal(t, OperatorName, log.OperatorName)
+	require.Equal(t, storage.AuditEntityApp, log.Entity)
+	require.EqualValues(t, 3, log.EntityId)
+	require.JSONEq(t, `{"id":3,"name":"Removed","slug":"removed","rollup_id":30}`, log.Before)
+	require.Empty(t, log.After)
+}
+
+func TestLinkAuditLog(t *testing.T) {
+	log, err := linkAuditLog(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), LinkChange{
+		Action:   storage.AuditActionUpdate,
+		Rollup:   &storage.AppRollup{AppId: 1, RollupId: 20, Role: "testnet"},
+		PrevRole: "devnet",
+	}, 1)
+	require.NoError(t, err)
+	require.Equal(t, storage.AuditEntityAppRollup, log.Entity)
+	require.EqualValues(t, 1, log.EntityId)
+	require.Contains(t, log.Before, `"role":"devnet"`)
+	require.Contains(t, log.After, `"role":"testnet"`)
+}
@@ -0,0 +1,619 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/private_api/handler"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+)
+
+// OperatorName - name of operator in audit log for changes made by synchronization
+const OperatorName = "sync-apps"
+
+const pageSize = 100
+
+// MaxDeletionPercent - share of existing applications which synchronization may delete without explicit permission. One application may always be deleted.
+const MaxDeletionPercent = 20
+
+// ErrUnsafeDeletion - synchronization deletes too many applications. Usually it means the registry directory is wrong or incomplete.
+var ErrUnsafeDeletion = errors.New("unsafe deletion of applications")
+
+// Change - difference between the registry and the database for one application
+type Change struct {
+	Action string
+	File   string
+	Before *storage.App
+	After  *storage.App
+	Fields []string
+	Links  []LinkChange
+}
+
+// LinkChange - difference of one additional rollup or bridge of application. Only one of Rollup and Bridge is set. PrevRole is set for updated links.
+type LinkChange struct {
+	Action   string
+	Rollup   *storage.AppRollup
+	Bridge   *storage.AppBridge
+	PrevRole string
+}
+
+// String - returns short description of the link change
+func (c LinkChange) String() string {
+	var sign string
+	switch c.Action {
+	case storage.AuditActionCreate:
+		sign = "+"
+	case storage.AuditActionUpdate:
+		sign = "~"
+	default:
+		sign = "-"
+	}
+	if c.Rollup != nil {
+		return fmt.Sprintf("%s rollup %s (%s)", sign, handler.NewAppRollup(*c.Rollup).Rollup, c.Rollup.Role)
+	}
+	return fmt.Sprintf("%s bridge %s (%s)", sign, handler.NewAppBridge(*c.Bridge).Bridge, c.Bridge.Role)
+}
+
+// links - additional rollups and bridges of application
+type links struct {
+	rollups []storage.AppRollup
+	bridges []storage.AppBridge
+}
+
+// Slug - returns slug of changed application
+func (c Change) Slug() string {
+	if c.After != nil {
+		return c.After.Slug
+	}
+	return c.Before.Slug
+}
+
+// Syncer - computes and applies difference between the registry and the app table
+type Syncer struct {
+	apps      storage.IApp
+	rollups   storage.IRollup
+	addresses storage.IAddress
+	tx        sdk.Transactable
+}
+
+func NewSyncer(apps storage.IApp, rollups storage.IRollup, addresses storage.IAddress, tx sdk.Transactable) *Syncer {
+	return &Syncer{
+		apps:      apps,
+		rollups:   rollups,
+		addresses: addresses,
+		tx:        tx,
+	}
+}
+
+// Plan - returns changes required to make the app table and application links equal to the registry. Applications absent in the registry are deleted with their links.
+func (s *Syncer) Plan(ctx context.Context, registry []App) ([]Change, error) {
+	existing, err := s.existing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		changes = make([]Change, 0)
+		seen    = make(map[uint64]string)
+		slugs   = make(map[string]string)
+	)
+	for i := range registry {
+		app, err := s.resolve(ctx, registry[i])
+		if err != nil {
+			return nil, errors.Wrap(err, registry[i].File)
+		}
+		after, err := s.resolveLinks(ctx, registry[i], app)
+		if err != nil {
+			return nil, errors.Wrap(err, registry[i].File)
+		}
+
+		rollupIds := []uint64{app.RollupId}
+		for j := range after.rollups {
+			rollupIds = append(rollupIds, after.rollups[j].RollupId)
+		}
+		for _, id := range rollupIds {
+			if file, ok := seen[id]; ok {
+				return nil, errors.Errorf("%s: rollup is already described in %s", registry[i].File, file)
+			}
+			seen[id] = registry[i].File
+		}
+		if file, ok := slugs[app.Slug]; ok {
+			return nil, errors.Errorf("%s: slug %s is already used in %s", registry[i].File, app.Slug, file)
+		}
+		slugs[app.Slug] = registry[i].File
+
+		before, ok := existing[app.RollupId]
+		if !ok {
+			changes = append(changes, Change{
+				Action: storage.AuditActionCreate,
+				File:   registry[i].File,
+				After:  &app,
+				Links:  diffLinks(links{}, after),
+			})
+			continue
+		}
+
+		app.Id = before.Id
+		current, err := s.links(ctx, before.Id)
+		if err != nil {
+			return nil, errors.Wrap(err, registry[i].File)
+		}
+
+		fields := diff(*before, app)
+		linkChanges := diffLinks(current, after)
+		if len(fields) > 0 || len(linkChanges) > 0 {
+			changes = append(changes, Change{
+				Action: storage.AuditActionUpdate,
+				File:   registry[i].File,
+				Before: before,
+				After:  &app,
+				Fields: fields,
+				Links:  linkChanges,
+			})
+		}
+	}
+
+	for _, app := range existing {
+		if _, ok := seen[app.RollupId]; ok {
+			continue
+		}
+		current, err := s.links(ctx, app.Id)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, Change{
+			Action: storage.AuditActionDelete,
+			Before: app,
+			Links:  diffLinks(current, links{}),
+		})
+	}
+
+	// deletions go first to release unique slugs and rollups
+	slices.SortStableFunc(changes, func(a, b Change) int {
+		return order(a.Action) - order(b.Action)
+	})
+	return changes, nil
+}
+
+// CheckDeletions - returns ErrUnsafeDeletion if the registry is empty but applications are deleted or if deleted applications exceed MaxDeletionPercent of existing ones. Changes have to be planned for the registry.
+func CheckDeletions(registry []App, changes []Change) error {
+	var created, deleted int
+	for i := range changes {
+		switch changes[i].Action {
+		case storage.AuditActionCreate:
+			created++
+		case storage.AuditActionDelete:
+			deleted++
+		}
+	}
+	if deleted == 0 {
+		return nil
+	}
+	if len(registry) == 0 {
+		return errors.Wrapf(ErrUnsafeDeletion, "registry is empty, all %d applications would be deleted", deleted)
+	}
+
+	// existing applications are either matched by the registry or deleted
+	existing := len(registry) - created + deleted
+	if limit := max(1, existing*MaxDeletionPercent/100); deleted > limit {
+		return errors.Wrapf(ErrUnsafeDeletion, "%d of %d applications would be deleted, limit is %d", deleted, existing, limit)
+	}
+	return nil
+}
+
+// Apply - applies changes in a single database transaction and records them to audit log. Removed links are deleted before applications are changed and new links are saved after, so rollup may be moved between applications by one synchronization.
+func (s *Syncer) Apply(ctx context.Context, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, s.tx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for i := range changes {
+		if err := applyLinks(ctx, tx, now, changes[i], true); err != nil {
+			return tx.HandleError(ctx, errors.Wrapf(err, "unlink %s", changes[i].Slug()))
+		}
+	}
+
+	for i := range changes {
+		var entityId uint64
+		switch changes[i].Action {
+		case storage.AuditActionCreate:
+			if err := tx.SaveApp(ctx, changes[i].After); err != nil {
+				return tx.HandleError(ctx, errors.Wrapf(err, "create %s", changes[i].Slug()))
+			}
+			entityId = changes[i].After.Id
+		case storage.AuditActionUpdate:
+			if len(changes[i].Fields) > 0 {
+				if err := tx.ReplaceApp(ctx, changes[i].After); err != nil {
+					return tx.HandleError(ctx, errors.Wrapf(err, "update %s", changes[i].Slug()))
+				}
+			}
+			entityId = changes[i].After.Id
+		case storage.AuditActionDelete:
+			if err := tx.DeleteApp(ctx, changes[i].Before.Id); err != nil {
+				return tx.HandleError(ctx, errors.Wrapf(err, "delete %s", changes[i].Slug()))
+			}
+			entityId = changes[i].Before.Id
+		}
+
+		if changes[i].Action == storage.AuditActionUpdate && len(changes[i].Fields) == 0 {
+			continue
+		}
+		log, err := auditLog(now, changes[i], entityId)
+		if err != nil {
+			return tx.HandleError(ctx, err)
+		}
+		if err := tx.SaveAuditLog(ctx, log); err != nil {
+			return tx.HandleError(ctx, err)
+		}
+	}
+
+	for i := range changes {
+		if err := applyLinks(ctx, tx, now, changes[i], false); err != nil {
+			return tx.HandleError(ctx, errors.Wrapf(err, "link %s", changes[i].Slug()))
+		}
+	}
+
+	if err := tx.RefreshLeaderboard(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	return tx.Flush(ctx)
+}
+
+// applyLinks - deletes removed links of the change if deleted is true, otherwise saves created and updated links
+func applyLinks(ctx context.Context, tx storage.Transaction, now time.Time, change Change, deleted bool) error {
+	for _, link := range change.Links {
+		if (link.Action == storage.AuditActionDelete) != deleted {
+			continue
+		}
+
+		var appId uint64
+		switch {
+		case change.After != nil:
+			appId = change.After.Id
+		case change.Before != nil:
+			appId = change.Before.Id
+		}
+
+		switch {
+		case link.Rollup != nil:
+			link.Rollup.AppId = appId
+			if deleted {
+				if err := tx.DeleteAppRollup(ctx, appId, link.Rollup.RollupId); err != nil {
+					return err
+				}
+			} else if err := tx.SaveAppRollup(ctx, link.Rollup); err != nil {
+				return err
+			}
+		case link.Bridge != nil:
+			link.Bridge.AppId = appId
+			if deleted {
+				if err := tx.DeleteAppBridge(ctx, appId, link.Bridge.BridgeId); err != nil {
+					return err
+				}
+			} else if err := tx.SaveAppBridge(ctx, link.Bridge); err != nil {
+				return err
+			}
+		}
+
+		log, err := linkAuditLog(now, link, appId)
+		if err != nil {
+			return err
+		}
+		if err := tx.SaveAuditLog(ctx, log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Syncer) existing(ctx context.Context) (map[uint64]*storage.App, error) {
+	existing := make(map[uint64]*storage.App)
+	for offset := uint64(0); ; offset += pageSize {
+		apps, err := s.apps.List(ctx, pageSize, offset, sdk.SortOrderAsc)
+		if err != nil {
+			return nil, errors.Wrap(err, "receive applications")
+		}
+		for i := range apps {
+			existing[apps[i].RollupId] = apps[i]
+		}
+		if len(apps) < pageSize {
+			return existing, nil
+		}
+	}
+}
+
+// resolve - converts registry description to the database model
+func (s *Syncer) resolve(ctx context.Context, app App) (storage.App, error) {
+	result := storage.App{
+		Group:       app.Group,
+		Name:        app.Name,
+		Slug:        slug.Make(app.Name),
+		Description: app.Description,
+		Website:     app.Website,
+		Github:      app.GitHub,
+		Twitter:     app.Twitter,
+		Logo:        app.Logo,
+		L2Beat:      app.L2Beat,
+		Explorer:    app.Explorer,
+		Stack:       app.Stack,
+		Links:       app.Links,
+		Provider:    app.Provider,
+		VM:          app.VM,
+		Type:        types.AppType(app.Type),
+		Category:    types.AppCategory(app.Category),
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(app.Rollup)
+	if err != nil {
+		return result, errors.Wrap(err, "decode rollup")
+	}
+	rollup, err := s.rollups.ByHash(ctx, hash)
+	if err != nil {
+		if s.rollups.IsNoRows(err) {
+			return result, errors.Errorf("unknown rollup %s", app.Rollup)
+		}
+		return result, errors.Wrap(err, "receive rollup")
+	}
+	result.RollupId = rollup.Id
+
+	if app.NativeBridge != "" {
+		address, err := s.addresses.ByHash(ctx, app.NativeBridge)
+		if err != nil {
+			if s.addresses.IsNoRows(err) {
+				return result, errors.Errorf("unknown address %s", app.NativeBridge)
+			}
+			return result, errors.Wrap(err, "receive native bridge")
+		}
+		if !address.IsBridge {
+			return result, errors.Errorf("address %s is not a bridge", app.NativeBridge)
+		}
+		result.NativeBridgeId = address.Id
+	}
+	return result, nil
+}
+
+// links - returns additional rollups and bridges of application stored in the database
+func (s *Syncer) links(ctx context.Context, appId uint64) (links, error) {
+	var result links
+
+	rollups, err := s.apps.Rollups(ctx, appId)
+	if err != nil {
+		return result, errors.Wrap(err, "receive rollups of application")
+	}
+	for i := range rollups {
+		if rollups[i].Role != storage.AppRolePrimary {
+			result.rollups = append(result.rollups, rollups[i])
+		}
+	}
+
+	bridges, err := s.apps.Bridges(ctx, appId)
+	if err != nil {
+		return result, errors.Wrap(err, "receive bridges of application")
+	}
+	for i := range bridges {
+		if bridges[i].Role != storage.AppRoleNative {
+			result.bridges = append(result.bridges, bridges[i])
+		}
+	}
+	return result, nil
+}
+
+// resolveLinks - converts additional rollups and bridges of registry description to the database models
+func (s *Syncer) resolveLinks(ctx context.Context, app App, resolved storage.App) (links, error) {
+	var result links
+
+	for i := range app.Rollups {
+		hash, err := base64.StdEncoding.DecodeString(app.Rollups[i].Rollup)
+		if err != nil {
+			return result, errors.Wrap(err, "decode linked rollup")
+		}
+		rollup, err := s.rollups.ByHash(ctx, hash)
+		if err != nil {
+			if s.rollups.IsNoRows(err) {
+				return result, errors.Errorf("unknown rollup %s", app.Rollups[i].Rollup)
+			}
+			return result, errors.Wrap(err, "receive linked rollup")
+		}
+		if rollup.Id == resolved.RollupId {
+			return result, errors.Errorf("rollup %s is the primary rollup of the application", app.Rollups[i].Rollup)
+		}
+		result.rollups = append(result.rollups, storage.AppRollup{
+			RollupId: rollup.Id,
+			Role:     app.Rollups[i].Role,
+			Rollup:   &rollup,
+		})
+	}
+
+	for i := range app.Bridges {
+		address, err := s.addresses.ByHash(ctx, app.Bridges[i].Bridge)
+		if err != nil {
+			if s.addresses.IsNoRows(err) {
+				return result, errors.Errorf("unknown address %s", app.Bridges[i].Bridge)
+			}
+			return result, errors.Wrap(err, "receive linked bridge")
+		}
+		if !address.IsBridge {
+			return result, errors.Errorf("address %s is not a bridge", app.Bridges[i].Bridge)
+		}
+		if address.Id == resolved.NativeBridgeId {
+			return result, errors.Errorf("bridge %s is the native bridge of the application", app.Bridges[i].Bridge)
+		}
+		if slices.ContainsFunc(result.bridges, func(b storage.AppBridge) bool { return b.BridgeId == address.Id }) {
+			return result, errors.Errorf("bridge %s is linked twice", app.Bridges[i].Bridge)
+		}
+		result.bridges = append(result.bridges, storage.AppBridge{
+			BridgeId: address.Id,
+			Role:     app.Bridges[i].Role,
+			Bridge:   &address,
+		})
+	}
+	return result, nil
+}
+
+// diffLinks - returns changes required to replace links before with links after
+func diffLinks(before, after links) []LinkChange {
+	changes := make([]LinkChange, 0)
+
+	for i := range before.rollups {
+		if !slices.ContainsFunc(after.rollups, func(l storage.AppRollup) bool { return l.RollupId == before.rollups[i].RollupId }) {
+			changes = append(changes, LinkChange{Action: storage.AuditActionDelete, Rollup: &before.rollups[i]})
+		}
+	}
+	for i := range after.rollups {
+		idx := slices.IndexFunc(before.rollups, func(l storage.AppRollup) bool { return l.RollupId == after.rollups[i].RollupId })
+		switch {
+		case idx < 0:
+			changes = append(changes, LinkChange{Action: storage.AuditActionCreate, Rollup: &after.rollups[i]})
+		case before.rollups[idx].Role != after.rollups[i].Role:
+			changes = append(changes, LinkChange{Action: storage.AuditActionUpdate, Rollup: &after.rollups[i], PrevRole: before.rollups[idx].Role})
+		}
+	}
+
+	for i := range before.bridges {
+		if !slices.ContainsFunc(after.bridges, func(l storage.AppBridge) bool { return l.BridgeId == before.bridges[i].BridgeId }) {
+			changes = append(changes, LinkChange{Action: storage.AuditActionDelete, Bridge: &before.bridges[i]})
+		}
+	}
+	for i := range after.bridges {
+		idx := slices.IndexFunc(before.bridges, func(l storage.AppBridge) bool { return l.BridgeId == after.bridges[i].BridgeId })
+		switch {
+		case idx < 0:
+			changes = append(changes, LinkChange{Action: storage.AuditActionCreate, Bridge: &after.bridges[i]})
+		case before.bridges[idx].Role != after.bridges[i].Role:
+			changes = append(changes, LinkChange{Action: storage.AuditActionUpdate, Bridge: &after.bridges[i], PrevRole: before.bridges[idx].Role})
+		}
+	}
+	return changes
+}
+
+// diff - returns names of changed fields
+func diff(before, after storage.App) []string {
+	fields := make([]string, 0)
+	check := func(name string, changed bool) {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+	check("group", before.Group != after.Group)
+	check("name", before.Name != after.Name)
+	check("slug", before.Slug != after.Slug)
+	check("description", before.Description != after.Description)
+	check("website", before.Website != after.Website)
+	check("github", before.Github != after.Github)
+	check("twitter", before.Twitter != after.Twitter)
+	check("logo", before.Logo != after.Logo)
+	check("l2beat", before.L2Beat != after.L2Beat)
+	check("explorer", before.Explorer != after.Explorer)
+	check("stack", before.Stack != after.Stack)
+	check("links", !slices.Equal(before.Links, after.Links))
+	check("category", before.Category != after.Category)
+	check("type", before.Type != after.Type)
+	check("vm", before.VM != after.VM)
+	check("provider", before.Provider != after.Provider)
+	check("native_bridge", before.NativeBridgeId != after.NativeBridgeId)
+	return fields
+}
+
+func order(action string) int {
+	switch action {
+	case storage.AuditActionDelete:
+		return 0
+	case storage.AuditActionUpdate:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func auditLog(now time.Time, change Change, entityId uint64) (*storage.AuditLog, error) {
+	log := &storage.AuditLog{
+		Time:         now,
+		OperatorName: OperatorName,
+		Action:       change.Action,
+		Entity:       storage.AuditEntityApp,
+		EntityId:     entityId,
+	}
+	if change.Before != nil {
+		data, err := json.Marshal(handler.NewApp(*change.Before))
+		if err != nil {
+			return nil, errors.Wrap(err, "encode state before mutation")
+		}
+		log.Before = string(data)
+	}
+	if change.After != nil {
+		data, err := json.Marshal(handler.NewApp(*change.After))
+		if err != nil {
+			return nil, errors.Wrap(err, "encode state after mutation")
+		}
+		log.After = string(data)
+	}
+	return log, nil
+}
+
+func linkAuditLog(now time.Time, change LinkChange, appId uint64) (*storage.AuditLog, error) {
+	log := &storage.AuditLog{
+		Time:         now,
+		OperatorName: OperatorName,
+		Action:       change.Action,
+		EntityId:     appId,
+	}
+
+	var before, after any
+	switch {
+	case change.Rollup != nil:
+		log.Entity = storage.AuditEntityAppRollup
+		link := handler.NewAppRollup(*change.Rollup)
+		after = link
+		if change.Action == storage.AuditActionUpdate {
+			link.Role = change.PrevRole
+			before = link
+		}
+	case change.Bridge != nil:
+		log.Entity = storage.AuditEntityAppBridge
+		link := handler.NewAppBridge(*change.Bridge)
+		after = link
+		if change.Action == storage.AuditActionUpdate {
+			link.Role = change.PrevRole
+			before = link
+		}
+	default:
+		return nil, errors.New("empty link change")
+	}
+	if change.Action == storage.AuditActionDelete {
+		before, after = after, nil
+	}
+
+	if before != nil {
+		data, err := json.Marshal(before)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode link before mutation")
+		}
+		log.Before = string(data)
+	}
+	if after != nil {
+		data, err := json.Marshal(after)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode link after mutation")
+		}
+		log.After = string(data)
+	}
+	return log, nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/celenium-io/astria-indexer/cmd/private_api/registry"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncAppsDir         string
+	syncAppsDryRun      bool
+	syncAppsAllowDelete bool
+)
+
+var syncAppsCmd = &cobra.Command{
+	Use:   "sync-apps",
+	Short: "Synchronize applications with the registry directory",
+	Long: `Reads one YAML or JSON file per application from the directory, validates them and compares with the app table.
+Applications are matched by rollup. Applications absent in the directory are deleted.
+Synchronization is aborted if the directory has no applications or more than 20% of existing applications would be deleted, unless --allow-delete is set.
+All changes are applied in a single database transaction and recorded to the audit log. With --dry-run changes are only printed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSyncApps(syncAppsDir, syncAppsDryRun, syncAppsAllowDelete)
+	},
+}
+
+func init() {
+	syncAppsCmd.Flags().StringVar(&syncAppsDir, "dir", "./apps", "path to directory with application files")
+	syncAppsCmd.Flags().BoolVar(&syncAppsDryRun, "dry-run", false, "print changes without applying them")
+	syncAppsCmd.Flags().BoolVar(&syncAppsAllowDelete, "allow-delete", false, "apply changes even if the registry is empty or many applications would be deleted")
+
+	rootCmd.AddCommand(syncAppsCmd)
+}
+
+func runSyncApps(dir string, dryRun, allowDelete bool) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	apps, err := registry.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	db, err := newDatabase(cfg)
+	if err != nil {
+		return errors.Wrap(err, "create database")
+	}
+	defer db.Close()
+
+	syncer := registry.NewSyncer(
+		postgres.NewApp(db),
+		postgres.NewRollup(db),
+		postgres.NewAddress(db),
+		db.Transactable,
+	)
+
+	changes, err := syncer.Plan(ctx, apps)
+	if err != nil {
+		return err
+	}
+
+	for i := range changes {
+		switch changes[i].Action {
+		case storage.AuditActionCreate:
+			fmt.Printf("+ %s (%s)\n", changes[i].Slug(), changes[i].File)
+		case storage.AuditActionUpdate:
+			fmt.Printf("~ %s (%s): %s\n", changes[i].Slug(), changes[i].File, strings.Join(changes[i].Fields, ", "))
+		case storage.AuditActionDelete:
+			fmt.Printf("- %s\n", changes[i].Slug())
+		}
+		for _, link := range changes[i].Links {
+			fmt.Printf("    %s\n", link)
+		}
+	}
+	fmt.Printf("%d applications in registry, %d changes\n", len(apps), len(changes))
+
+	if err := registry.CheckDeletions(apps, changes); err != nil && !allowDelete {
+		if !dryRun {
+			return errors.Wrap(err, "use --allow-delete to apply")
+		}
+		fmt.Printf("warning: %s\n", err)
+	}
+
+	if dryRun || len(changes) == 0 {
+		return nil
+	}
+	if err := syncer.Apply(ctx, changes); err != nil {
+		return err
+	}
+	fmt.Println("changes are applied")
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+// headers of webhook request
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Event - payload of webhook request
+type Event struct {
+	Type   string         `json:"event"`
+	Height pkgTypes.Level `json:"height,omitempty"`
+	Time   time.Time      `json:"time"`
+	Data   any            `json:"data"`
+}
+
+type TransferData struct {
+	Asset  string `json:"asset"`
+	Amount string `json:"amount"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+type DepositData struct {
+	TxHash                  string `json:"tx_hash"`
+	Bridge                  string `json:"bridge"`
+	Rollup                  []byte `json:"rollup"`
+	Asset                   string `json:"asset"`
+	Amount                  string `json:"amount"`
+	DestinationChainAddress string `json:"destination_chain_address"`
+}
+
+type NewRollupData struct {
+	Rollup []byte `json:"rollup"`
+}
+
+type ValidatorPowerData struct {
+	TxHash string `json:"tx_hash"`
+	Name   any    `json:"name"`
+	PubKey any    `json:"pubkey"`
+	Power  any    `json:"power"`
+}
+
+type FeeChangeData struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Sign - returns hex-encoded HMAC-SHA256 of the timestamp and the body joined by dot. Receivers must compute the same value with the webhook secret and compare it with the signature header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
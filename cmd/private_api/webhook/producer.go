@@ -0,0 +1,535 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	actionsPageSize = 100
+	cursorName      = "webhook_producer"
+)
+
+// Producer - converts indexed blocks to chain events and enqueues deliveries to subscribed webhooks.
+// Blocks are processed one by one from the persistent cursor, so blocks indexed while the producer is stopped or failed are processed later.
+// Deliveries of the block and the cursor are saved in one transaction under the advisory lock of the cursor, so several replicas of the producer do not enqueue the same block twice.
+type Producer struct {
+	observer  *bus.Observer
+	tx        sdk.Transactable
+	states    storage.IWebhookState
+	blocks    storage.IBlock
+	webhooks  storage.IWebhook
+	transfers storage.ITransfer
+	deposits  storage.IDeposit
+	rollups   storage.IRollup
+	actions   storage.IAction
+	constants storage.IConstantHistory
+
+	cursor *storage.WebhookState
+	wg     *sync.WaitGroup
+}
+
+func NewProducer(
+	observer *bus.Observer,
+	tx sdk.Transactable,
+	states storage.IWebhookState,
+	blocks storage.IBlock,
+	webhooks storage.IWebhook,
+	transfers storage.ITransfer,
+	deposits storage.IDeposit,
+	rollups storage.IRollup,
+	actions storage.IAction,
+	constants storage.IConstantHistory,
+) *Producer {
+	return &Producer{
+		observer:  observer,
+		tx:        tx,
+		states:    states,
+		blocks:    blocks,
+		webhooks:  webhooks,
+		transfers: transfers,
+		deposits:  deposits,
+		rollups:   rollups,
+		actions:   actions,
+		constants: constants,
+		wg:        new(sync.WaitGroup),
+	}
+}
+
+func (p *Producer) Start(ctx context.Context) {
+	if p.observer == nil {
+		return
+	}
+
+	p.wg.Add(1)
+	go p.listen(ctx)
+}
+
+func (p *Producer) Close() error {
+	p.wg.Wait()
+	return nil
+}
+
+func (p *Producer) listen(ctx context.Context) {
+	defer p.wg.Done()
+
+	if err := p.sync(ctx); err != nil {
+		log.Err(err).Msg("produce webhook events")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case block, ok := <-p.observer.Blocks():
+			if !ok {
+				return
+			}
+			if err := p.syncTo(ctx, block.Height); err != nil {
+				log.Err(err).Uint64("height", uint64(block.Height)).Msg("produce webhook events")
+			}
+		case event, ok := <-p.observer.Rollbacks():
+			if !ok {
+				return
+			}
+			if err := p.rollback(ctx, event); err != nil {
+				log.Err(err).Uint64("from_height", uint64(event.FromHeight)).Msg("rollback webhook events")
+			}
+		}
+	}
+}
+
+// initCursor - receives the persistent cursor. If it does not exist, producer starts from the current head.
+func (p *Producer) initCursor(ctx context.Context) error {
+	if p.cursor != nil {
+		return nil
+	}
+
+	cursor, err := p.states.ByName(ctx, cursorName)
+	switch {
+	case err == nil:
+	case p.states.IsNoRows(err):
+		cursor = storage.WebhookState{Name: cursorName}
+		block, err := p.blocks.Last(ctx)
+		switch {
+		case err == nil:
+			cursor.Height = block.Height
+		case !p.blocks.IsNoRows(err):
+			return errors.Wrap(err, "receive head")
+		}
+	default:
+		return errors.Wrap(err, "receive cursor")
+	}
+
+	p.cursor = &cursor
+	return nil
+}
+
+// sync - processes blocks from the cursor to the current head
+func (p *Producer) sync(ctx context.Context) error {
+	block, err := p.blocks.Last(ctx)
+	if err != nil {
+		if p.blocks.IsNoRows(err) {
+			return nil
+		}
+		return errors.Wrap(err, "receive head")
+	}
+	return p.syncTo(ctx, block.Height)
+}
+
+// syncTo - processes blocks from the cursor to the head. Processing stops at the first failed block which is retried on the next notification.
+func (p *Producer) syncTo(ctx context.Context, head pkgTypes.Level) error {
+	if err := p.initCursor(ctx); err != nil {
+		return err
+	}
+
+	for p.cursor.Height < head {
+		if ctx.Err() != nil {
+			return nil
+		}
+		height := p.cursor.Height + 1
+
+		block, err := p.blocks.ByHeight(ctx, height, false)
+		if err != nil {
+			return errors.Wrapf(err, "receive block %d", height)
+		}
+
+		deliveries, err := p.handleBlock(ctx, &block)
+		if err != nil {
+			return errors.Wrapf(err, "block %d", height)
+		}
+
+		if err := p.save(ctx, height, deliveries); err != nil {
+			return errors.Wrapf(err, "save deliveries of block %d", height)
+		}
+	}
+	return nil
+}
+
+func (p *Producer) save(ctx context.Context, height pkgTypes.Level, deliveries []*storage.WebhookDelivery) error {
+	tx, err := postgres.BeginTransaction(ctx, p.tx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	cursor := storage.WebhookState{
+		Name:      cursorName,
+		Height:    height,
+		UpdatedAt: time.Now().UTC(),
+	}
+	saved, err := saveBlockDeliveries(ctx, tx, &cursor, deliveries)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	p.cursor = saved
+	return nil
+}
+
+// rollback - cancels undelivered events of removed blocks and moves the cursor back. Removed blocks are processed again after the indexer reaches them.
+func (p *Producer) rollback(ctx context.Context, event *storage.RollbackEvent) error {
+	if event == nil || event.FromHeight == 0 {
+		return nil
+	}
+	if err := p.initCursor(ctx); err != nil {
+		return err
+	}
+
+	tx, err := postgres.BeginTransaction(ctx, p.tx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	cursor := storage.WebhookState{
+		Name:      cursorName,
+		Height:    event.FromHeight - 1,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if p.cursor.Height < cursor.Height {
+		cursor.Height = p.cursor.Height
+	}
+
+	if err := rollbackDeliveries(ctx, tx, &cursor, event.FromHeight); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	p.cursor = &cursor
+	return nil
+}
+
+// saveBlockDeliveries - saves deliveries of the block and moves the cursor to the block. If the stored cursor is not right before the block (another replica has already processed it or has rolled the cursor back), nothing is saved. It returns the cursor which producer has to continue from.
+func saveBlockDeliveries(ctx context.Context, tx storage.Transaction, cursor *storage.WebhookState, deliveries []*storage.WebhookDelivery) (*storage.WebhookState, error) {
+	stored, err := tx.LockWebhookState(ctx, cursor.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "lock cursor")
+	}
+	if stored != nil && stored.Height+1 != cursor.Height {
+		return stored, nil
+	}
+
+	if err := tx.SaveWebhookDeliveries(ctx, deliveries...); err != nil {
+		return nil, errors.Wrap(err, "deliveries")
+	}
+	if err := tx.SaveWebhookState(ctx, cursor); err != nil {
+		return nil, errors.Wrap(err, "cursor")
+	}
+	return cursor, nil
+}
+
+// rollbackDeliveries - cancels pending deliveries from the height and moves the cursor back. The stored cursor takes precedence over the local one because another replica may have moved it.
+func rollbackDeliveries(ctx context.Context, tx storage.Transaction, cursor *storage.WebhookState, fromHeight pkgTypes.Level) error {
+	stored, err := tx.LockWebhookState(ctx, cursor.Name)
+	if err != nil {
+		return errors.Wrap(err, "lock cursor")
+	}
+	if stored != nil {
+		cursor.Height = min(stored.Height, fromHeight-1)
+	}
+
+	if err := tx.CancelWebhookDeliveries(ctx, fromHeight); err != nil {
+		return errors.Wrap(err, "cancel deliveries")
+	}
+	if err := tx.SaveWebhookState(ctx, cursor); err != nil {
+		return errors.Wrap(err, "cursor")
+	}
+	return nil
+}
+
+// handleBlock - returns deliveries of all events of the block
+func (p *Producer) handleBlock(ctx context.Context, block *storage.Block) ([]*storage.WebhookDelivery, error) {
+	if block == nil {
+		return nil, nil
+	}
+
+	handlers := []struct {
+		name    string
+		handler func(context.Context, *storage.Block) ([]*storage.WebhookDelivery, error)
+	}{
+		{"transfers", p.handleTransfers},
+		{"deposits", p.handleDeposits},
+		{"rollups", p.handleRollups},
+		{"validators", p.handleValidators},
+		{"fees", p.handleFees},
+	}
+
+	deliveries := make([]*storage.WebhookDelivery, 0)
+	for i := range handlers {
+		items, err := handlers[i].handler(ctx, block)
+		if err != nil {
+			return nil, errors.Wrap(err, handlers[i].name)
+		}
+		deliveries = append(deliveries, items...)
+	}
+	return deliveries, nil
+}
+
+func (p *Producer) handleTransfers(ctx context.Context, block *storage.Block) ([]*storage.WebhookDelivery, error) {
+	webhooks, err := p.webhooks.ByEvent(ctx, storage.WebhookEventTransfer)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	transfers, err := p.transfers.ByHeight(ctx, block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*storage.WebhookDelivery, 0)
+	for i := range transfers {
+		data := TransferData{
+			Asset:  transfers[i].Asset,
+			Amount: transfers[i].Amount.String(),
+		}
+		if transfers[i].Source != nil {
+			data.From = transfers[i].Source.Hash
+		}
+		if transfers[i].Destination != nil {
+			data.To = transfers[i].Destination.Hash
+		}
+
+		event := Event{
+			Type:   storage.WebhookEventTransfer,
+			Height: block.Height,
+			Time:   block.Time,
+			Data:   data,
+		}
+		if deliveries, err = appendDeliveries(deliveries, webhooks, data.To, event); err != nil {
+			return nil, err
+		}
+	}
+	return deliveries, nil
+}
+
+func (p *Producer) handleDeposits(ctx context.Context, block *storage.Block) ([]*storage.WebhookDelivery, error) {
+	webhooks, err := p.webhooks.ByEvent(ctx, storage.WebhookEventDeposit)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	deposits, err := p.deposits.ByHeight(ctx, block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*storage.WebhookDelivery, 0)
+	for i := range deposits {
+		data := DepositData{
+			Asset:                   deposits[i].Asset,
+			Amount:                  deposits[i].Amount.String(),
+			DestinationChainAddress: deposits[i].DestinationChainAddress,
+		}
+		if deposits[i].Tx != nil {
+			data.TxHash = hex.EncodeToString(deposits[i].Tx.Hash)
+		}
+		if deposits[i].Bridge != nil && deposits[i].Bridge.Address != nil {
+			data.Bridge = deposits[i].Bridge.Address.Hash
+		}
+		if deposits[i].Rollup != nil {
+			data.Rollup = deposits[i].Rollup.AstriaId
+		}
+
+		event := Event{
+			Type:   storage.WebhookEventDeposit,
+			Height: block.Height,
+			Time:   block.Time,
+			Data:   data,
+		}
+		if deliveries, err = appendDeliveries(deliveries, webhooks, data.Bridge, event); err != nil {
+			return nil, err
+		}
+	}
+	return deliveries, nil
+}
+
+func (p *Producer) handleRollups(ctx context.Context, block *storage.Block) ([]*storage.WebhookDelivery, error) {
+	webhooks, err := p.webhooks.ByEvent(ctx, storage.WebhookEventNewRollup)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	rollups, err := p.rollups.ByFirstHeight(ctx, block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*storage.WebhookDelivery, 0)
+	for i := range rollups {
+		event := Event{
+			Type:   storage.WebhookEventNewRollup,
+			Height: block.Height,
+			Time:   block.Time,
+			Data: NewRollupData{
+				Rollup: rollups[i].AstriaId,
+			},
+		}
+		if deliveries, err = appendDeliveries(deliveries, webhooks, "", event); err != nil {
+			return nil, err
+		}
+	}
+	return deliveries, nil
+}
+
+func (p *Producer) handleValidators(ctx context.Context, block *storage.Block) ([]*storage.WebhookDelivery, error) {
+	if !block.ActionTypes.Has(types.ActionTypeValidatorUpdateBits) {
+		return nil, nil
+	}
+
+	webhooks, err := p.webhooks.ByEvent(ctx, storage.WebhookEventValidatorPower)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	deliveries := make([]*storage.WebhookDelivery, 0)
+	for offset := 0; ; offset += actionsPageSize {
+		actions, err := p.actions.ByBlock(ctx, block.Height, actionsPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range actions {
+			if actions[i].Type != types.ActionTypeValidatorUpdate {
+				continue
+			}
+			data := ValidatorPowerData{
+				Name:   actions[i].Data["name"],
+				PubKey: actions[i].Data["pubkey"],
+				Power:  actions[i].Data["power"],
+			}
+			if actions[i].Tx != nil {
+				data.TxHash = hex.EncodeToString(actions[i].Tx.Hash)
+			}
+
+			event := Event{
+				Type:   storage.WebhookEventValidatorPower,
+				Height: block.Height,
+				Time:   block.Time,
+				Data:   data,
+			}
+			if deliveries, err = appendDeliveries(deliveries, webhooks, "", event); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(actions) < actionsPageSize {
+			break
+		}
+	}
+	return deliveries, nil
+}
+
+func (p *Producer) handleFees(ctx context.Context, block *storage.Block) ([]*storage.WebhookDelivery, error) {
+	if !block.ActionTypes.Has(types.ActionTypeFeeChangeBits) {
+		return nil, nil
+	}
+
+	webhooks, err := p.webhooks.ByEvent(ctx, storage.WebhookEventFeeChange)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	history, err := p.constants.ByHeight(ctx, block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*storage.WebhookDelivery, 0)
+	for i := range history {
+		if !isFeeConstant(history[i].Module, history[i].Name) {
+			continue
+		}
+
+		event := Event{
+			Type:   storage.WebhookEventFeeChange,
+			Height: block.Height,
+			Time:   block.Time,
+			Data: FeeChangeData{
+				Name:  history[i].Name,
+				Value: history[i].Value,
+			},
+		}
+		if deliveries, err = appendDeliveries(deliveries, webhooks, "", event); err != nil {
+			return nil, err
+		}
+	}
+	return deliveries, nil
+}
+
+func isFeeConstant(module types.ModuleName, name string) bool {
+	if module != types.ModuleNameGeneric {
+		return false
+	}
+	return strings.HasSuffix(name, "_base") || strings.HasSuffix(name, "_multiplier")
+}
+
+func appendDeliveries(deliveries []*storage.WebhookDelivery, webhooks []storage.Webhook, address string, event Event) ([]*storage.WebhookDelivery, error) {
+	var payload []byte
+	now := time.Now().UTC()
+
+	for i := range webhooks {
+		if !webhooks[i].Match(address) {
+			continue
+		}
+		if payload == nil {
+			raw, err := json.Marshal(event)
+			if err != nil {
+				return nil, errors.Wrap(err, "marshal event")
+			}
+			payload = raw
+		}
+
+		deliveries = append(deliveries, &storage.WebhookDelivery{
+			WebhookId:     webhooks[i].Id,
+			Event:         event.Type,
+			Height:        event.Height,
+			Payload:       string(payload),
+			Status:        storage.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	}
+	return deliveries, nil
+}
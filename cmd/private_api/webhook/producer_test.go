@@ -0,0 +1,437 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type producerMocks struct {
+	states    *mock.MockIWebhookState
+	blocks    *mock.MockIBlock
+	webhooks  *mock.MockIWebhook
+	transfers *mock.MockITransfer
+	deposits  *mock.MockIDeposit
+	rollups   *mock.MockIRollup
+	actions   *mock.MockIAction
+	constants *mock.MockIConstantHistory
+}
+
+func newTestProducer(t *testing.T) (*Producer, producerMocks) {
+	ctrl := gomock.NewController(t)
+	m := producerMocks{
+		states:    mock.NewMockIWebhookState(ctrl),
+		blocks:    mock.NewMockIBlock(ctrl),
+		webhooks:  mock.NewMockIWebhook(ctrl),
+		transfers: mock.NewMockITransfer(ctrl),
+		deposits:  mock.NewMockIDeposit(ctrl),
+		rollups:   mock.NewMockIRollup(ctrl),
+		actions:   mock.NewMockIAction(ctrl),
+		constants: mock.NewMockIConstantHistory(ctrl),
+	}
+	return NewProducer(nil, nil, m.states, m.blocks, m.webhooks, m.transfers, m.deposits, m.rollups, m.actions, m.constants), m
+}
+
+func TestProducerTransfers(t *testing.T) {
+	producer, m := newTestProducer(t)
+	block := &storage.Block{Height: 100, Time: testTime}
+
+	m.webhooks.EXPECT().
+		ByEvent(gomock.Any(), storage.WebhookEventTransfer).
+		Return([]storage.Webhook{
+			{Id: 1, Event: storage.WebhookEventTransfer, Filter: "astria_to", Active: true},
+			{Id: 2, Event: storage.WebhookEventTransfer, Active: true},
+			{Id: 3, Event: storage.WebhookEventTransfer, Filter: "astria_other", Active: true},
+		}, nil).
+		Times(1)
+
+	m.transfers.EXPECT().
+		ByHeight(gomock.Any(), block.Height).
+		Return([]storage.Transfer{
+			{
+				Height:      100,
+				Asset:       "nria",
+				Amount:      decimal.RequireFromString("1000"),
+				Source:      &storage.Address{Hash: "astria_from"},
+				Destination: &storage.Address{Hash: "astria_to"},
+			},
+		}, nil).
+		Times(1)
+
+	deliveries, err := producer.handleTransfers(context.Background(), block)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.EqualValues(t, 1, deliveries[0].WebhookId)
+	require.EqualValues(t, 2, deliveries[1].WebhookId)
+
+	for i := range deliveries {
+		require.Equal(t, storage.WebhookEventTransfer, deliveries[i].Event)
+		require.Equal(t, storage.WebhookDeliveryPending, deliveries[i].Status)
+		require.EqualValues(t, 100, deliveries[i].Height)
+		require.False(t, deliveries[i].NextAttemptAt.IsZero())
+	}
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal([]byte(deliveries[0].Payload), &event))
+	require.Equal(t, storage.WebhookEventTransfer, event["event"])
+	require.EqualValues(t, 100, event["height"])
+	data, ok := event["data"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "1000", data["amount"])
+	require.Equal(t, "astria_from", data["from"])
+	require.Equal(t, "astria_to", data["to"])
+}
+
+func TestProducerWithoutWebhooks(t *testing.T) {
+	producer, m := newTestProducer(t)
+	block := &storage.Block{
+		Height:      100,
+		Time:        testTime,
+		ActionTypes: types.ActionTypeValidatorUpdateBits,
+	}
+
+	m.webhooks.EXPECT().
+		ByEvent(gomock.Any(), gomock.Any()).
+		Return([]storage.Webhook{}, nil).
+		Times(4)
+
+	deliveries, err := producer.handleBlock(context.Background(), block)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 0)
+}
+
+func TestProducerDeposits(t *testing.T) {
+	producer, m := newTestProducer(t)
+	block := &storage.Block{Height: 100, Time: testTime}
+
+	m.webhooks.EXPECT().
+		ByEvent(gomock.Any(), storage.WebhookEventDeposit).
+		Return([]storage.Webhook{
+			{Id: 1, Event: storage.WebhookEventDeposit, Filter: "astria_bridge", Active: true},
+		}, nil).
+		Times(1)
+
+	m.deposits.EXPECT().
+		ByHeight(gomock.Any(), block.Height).
+		Return([]storage.Deposit{
+			{
+				Asset:  "nria",
+				Amount: decimal.RequireFromString("10"),
+				Bridge: &storage.Bridge{Address: &storage.Address{Hash: "astria_bridge"}},
+				Rollup: &storage.Rollup{AstriaId: []byte{1, 2, 3}},
+				Tx:     &storage.Tx{Hash: []byte{0xab}},
+			}, {
+				Asset:  "nria",
+				Amount: decimal.RequireFromString("20"),
+				Bridge: &storage.Bridge{Address: &storage.Address{Hash: "astria_other"}},
+			},
+		}, nil).
+		Times(1)
+
+	deliveries, err := producer.handleDeposits(context.Background(), block)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.JSONEq(t, `{
+			"event": "deposit",
+			"height": 100,
+			"time": "2025-01-01T00:00:00Z",
+			"data": {
+				"tx_hash": "ab",
+				"bridge": "astria_bridge",
+				"rollup": "AQID",
+				"asset": "nria",
+				"amount": "10",
+				"destination_chain_address": ""
+			}
+		}`, deliveries[0].Payload)
+}
+
+func TestProducerValidators(t *testing.T) {
+	producer, m := newTestProducer(t)
+	block := &storage.Block{
+		Height:      100,
+		Time:        testTime,
+		ActionTypes: types.ActionTypeValidatorUpdateBits,
+	}
+
+	m.webhooks.EXPECT().
+		ByEvent(gomock.Any(), storage.WebhookEventValidatorPower).
+		Return([]storage.Webhook{
+			{Id: 1, Event: storage.WebhookEventValidatorPower, Active: true},
+		}, nil).
+		Times(1)
+
+	m.actions.EXPECT().
+		ByBlock(gomock.Any(), block.Height, actionsPageSize, 0).
+		Return([]storage.ActionWithTx{
+			{
+				Action: storage.Action{
+					Type: types.ActionTypeTransfer,
+				},
+			}, {
+				Action: storage.Action{
+					Type: types.ActionTypeValidatorUpdate,
+					Data: map[string]any{
+						"name":   "validator",
+						"power":  10.0,
+						"pubkey": "AQID",
+					},
+				},
+				Tx: &storage.Tx{Hash: []byte{0xab}},
+			},
+		}, nil).
+		Times(1)
+
+	deliveries, err := producer.handleValidators(context.Background(), block)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.JSONEq(t, `{
+			"event": "validator_power",
+			"height": 100,
+			"time": "2025-01-01T00:00:00Z",
+			"data": {
+				"tx_hash": "ab",
+				"name": "validator",
+				"pubkey": "AQID",
+				"power": 10
+			}
+		}`, deliveries[0].Payload)
+}
+
+func TestProducerFeeChange(t *testing.T) {
+	producer, m := newTestProducer(t)
+	block := &storage.Block{
+		Height:      100,
+		Time:        testTime,
+		ActionTypes: types.ActionTypeFeeChangeBits,
+	}
+
+	m.webhooks.EXPECT().
+		ByEvent(gomock.Any(), storage.WebhookEventFeeChange).
+		Return([]storage.Webhook{
+			{Id: 1, Event: storage.WebhookEventFeeChange, Active: true},
+		}, nil).
+		Times(1)
+
+	m.constants.EXPECT().
+		ByHeight(gomock.Any(), block.Height).
+		Return([]storage.ConstantHistory{
+			{
+				Height: 100,
+				Module: types.ModuleNameGeneric,
+				Name:   "transfer_base",
+				Value:  "12",
+			}, {
+				Height: 100,
+				Module: types.ModuleNameGeneric,
+				Name:   "authority_sudo_address",
+				Value:  "astria1",
+			}, {
+				Height: 100,
+				Module: types.ModuleNameBlock,
+				Name:   "max_bytes",
+				Value:  "100",
+			},
+		}, nil).
+		Times(1)
+
+	deliveries, err := producer.handleFees(context.Background(), block)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, storage.WebhookEventFeeChange, deliveries[0].Event)
+	require.EqualValues(t, 100, deliveries[0].Height)
+	require.JSONEq(t, `{
+		"event": "fee_change",
+		"height": 100,
+		"time": "2025-01-01T00:00:00Z",
+		"data": {
+			"name": "transfer_base",
+			"value": "12"
+		}
+	}`, deliveries[0].Payload)
+}
+
+func TestProducerFeeChangeWithoutAction(t *testing.T) {
+	producer, _ := newTestProducer(t)
+
+	deliveries, err := producer.handleFees(context.Background(), &storage.Block{Height: 100, Time: testTime})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 0)
+}
+
+func TestProducerInitCursor(t *testing.T) {
+	t.Run("stored cursor", func(t *testing.T) {
+		producer, m := newTestProducer(t)
+
+		m.states.EXPECT().
+			ByName(gomock.Any(), cursorName).
+			Return(storage.WebhookState{Id: 1, Name: cursorName, Height: 90}, nil).
+			Times(1)
+
+		require.NoError(t, producer.initCursor(context.Background()))
+		require.EqualValues(t, 90, producer.cursor.Height)
+
+		// cursor is received once
+		require.NoError(t, producer.initCursor(context.Background()))
+	})
+
+	t.Run("starts from head", func(t *testing.T) {
+		producer, m := newTestProducer(t)
+
+		m.states.EXPECT().
+			ByName(gomock.Any(), cursorName).
+			Return(storage.WebhookState{}, sql.ErrNoRows).
+			Times(1)
+		m.states.EXPECT().
+			IsNoRows(sql.ErrNoRows).
+			Return(true).
+			Times(1)
+		m.blocks.EXPECT().
+			Last(gomock.Any()).
+			Return(storage.Block{Height: 120}, nil).
+			Times(1)
+
+		require.NoError(t, producer.initCursor(context.Background()))
+		require.Equal(t, cursorName, producer.cursor.Name)
+		require.EqualValues(t, 120, producer.cursor.Height)
+	})
+}
+
+func TestSaveBlockDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tx := mock.NewMockTransaction(ctrl)
+
+	cursor := &storage.WebhookState{Name: cursorName, Height: 100}
+	deliveries := []*storage.WebhookDelivery{
+		{WebhookId: 1, Height: 100},
+		{WebhookId: 2, Height: 100},
+	}
+
+	gomock.InOrder(
+		tx.EXPECT().
+			LockWebhookState(gomock.Any(), cursorName).
+			Return(&storage.WebhookState{Id: 1, Name: cursorName, Height: 99}, nil).
+			Times(1),
+		tx.EXPECT().
+			SaveWebhookDeliveries(gomock.Any(), deliveries[0], deliveries[1]).
+			Return(nil).
+			Times(1),
+		tx.EXPECT().
+			SaveWebhookState(gomock.Any(), cursor).
+			Return(nil).
+			Times(1),
+	)
+
+	saved, err := saveBlockDeliveries(context.Background(), tx, cursor, deliveries)
+	require.NoError(t, err)
+	require.Equal(t, cursor, saved)
+}
+
+func TestSaveBlockDeliveriesFirstCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tx := mock.NewMockTransaction(ctrl)
+
+	cursor := &storage.WebhookState{Name: cursorName, Height: 100}
+
+	gomock.InOrder(
+		tx.EXPECT().
+			LockWebhookState(gomock.Any(), cursorName).
+			Return(nil, nil).
+			Times(1),
+		tx.EXPECT().
+			SaveWebhookDeliveries(gomock.Any()).
+			Return(nil).
+			Times(1),
+		tx.EXPECT().
+			SaveWebhookState(gomock.Any(), cursor).
+			Return(nil).
+			Times(1),
+	)
+
+	saved, err := saveBlockDeliveries(context.Background(), tx, cursor, nil)
+	require.NoError(t, err)
+	require.Equal(t, cursor, saved)
+}
+
+func TestSaveBlockDeliveriesProcessedByAnotherReplica(t *testing.T) {
+	for _, height := range []pkgTypes.Level{100, 120, 80} {
+		ctrl := gomock.NewController(t)
+		tx := mock.NewMockTransaction(ctrl)
+
+		stored := &storage.WebhookState{Id: 1, Name: cursorName, Height: height}
+		tx.EXPECT().
+			LockWebhookState(gomock.Any(), cursorName).
+			Return(stored, nil).
+			Times(1)
+
+		cursor := &storage.WebhookState{Name: cursorName, Height: 100}
+		saved, err := saveBlockDeliveries(context.Background(), tx, cursor, []*storage.WebhookDelivery{
+			{WebhookId: 1, Height: 100},
+		})
+		require.NoError(t, err, height)
+		require.Equal(t, stored, saved, height)
+	}
+}
+
+func TestRollbackDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tx := mock.NewMockTransaction(ctrl)
+
+	cursor := &storage.WebhookState{Name: cursorName, Height: 89}
+
+	gomock.InOrder(
+		tx.EXPECT().
+			LockWebhookState(gomock.Any(), cursorName).
+			Return(&storage.WebhookState{Id: 1, Name: cursorName, Height: 95}, nil).
+			Times(1),
+		tx.EXPECT().
+			CancelWebhookDeliveries(gomock.Any(), pkgTypes.Level(90)).
+			Return(nil).
+			Times(1),
+		tx.EXPECT().
+			SaveWebhookState(gomock.Any(), cursor).
+			Return(nil).
+			Times(1),
+	)
+
+	require.NoError(t, rollbackDeliveries(context.Background(), tx, cursor, 90))
+	require.EqualValues(t, 89, cursor.Height)
+}
+
+func TestRollbackDeliveriesUsesStoredCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tx := mock.NewMockTransaction(ctrl)
+
+	// local cursor of the replica is behind the stored one
+	cursor := &storage.WebhookState{Name: cursorName, Height: 70}
+
+	gomock.InOrder(
+		tx.EXPECT().
+			LockWebhookState(gomock.Any(), cursorName).
+			Return(&storage.WebhookState{Id: 1, Name: cursorName, Height: 85}, nil).
+			Times(1),
+		tx.EXPECT().
+			CancelWebhookDeliveries(gomock.Any(), pkgTypes.Level(90)).
+			Return(nil).
+			Times(1),
+		tx.EXPECT().
+			SaveWebhookState(gomock.Any(), cursor).
+			Return(nil).
+			Times(1),
+	)
+
+	require.NoError(t, rollbackDeliveries(context.Background(), tx, cursor, 90))
+	require.EqualValues(t, 85, cursor.Height)
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultTimeout      = 10 * time.Second
+	defaultMaxAttempts  = 10
+	defaultBatchSize    = 100
+	defaultBackoff      = 10 * time.Second
+	maxBackoff          = time.Hour
+)
+
+// Config - settings of webhook deliveries. Intervals are set in seconds.
+type Config struct {
+	PollInterval int `validate:"omitempty,min=1"         yaml:"poll_interval"`
+	Timeout      int `validate:"omitempty,min=1"         yaml:"timeout"`
+	MaxAttempts  int `validate:"omitempty,min=1"         yaml:"max_attempts"`
+	BatchSize    int `validate:"omitempty,min=1,max=100" yaml:"batch_size"`
+	Backoff      int `validate:"omitempty,min=1"         yaml:"backoff"`
+}
+
+// Sender - polls the persistent queue and delivers events to webhooks. Failed attempts are retried with exponential backoff. Delivery is marked as failed after the last attempt and can be found in dead-letter list.
+type Sender struct {
+	webhooks   storage.IWebhook
+	deliveries storage.IWebhookDelivery
+	client     *http.Client
+
+	pollInterval time.Duration
+	maxAttempts  int
+	batchSize    int
+	backoff      time.Duration
+	lease        time.Duration
+
+	now func() time.Time
+	wg  *sync.WaitGroup
+}
+
+func NewSender(cfg Config, webhooks storage.IWebhook, deliveries storage.IWebhookDelivery) *Sender {
+	s := &Sender{
+		webhooks:     webhooks,
+		deliveries:   deliveries,
+		client:       &http.Client{Timeout: defaultTimeout},
+		pollInterval: defaultPollInterval,
+		maxAttempts:  defaultMaxAttempts,
+		batchSize:    defaultBatchSize,
+		backoff:      defaultBackoff,
+		now:          func() time.Time { return time.Now().UTC() },
+		wg:           new(sync.WaitGroup),
+	}
+	if cfg.PollInterval > 0 {
+		s.pollInterval = time.Duration(cfg.PollInterval) * time.Second
+	}
+	if cfg.Timeout > 0 {
+		s.client.Timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	if cfg.MaxAttempts > 0 {
+		s.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BatchSize > 0 {
+		s.batchSize = cfg.BatchSize
+	}
+	if cfg.Backoff > 0 {
+		s.backoff = time.Duration(cfg.Backoff) * time.Second
+	}
+	// claimed batch is sent sequentially, so lock lasts until the worst-case end of the batch
+	s.lease = s.client.Timeout*time.Duration(s.batchSize) + s.pollInterval
+	return s
+}
+
+func (s *Sender) Start(ctx context.Context) {
+	s.wg.Add(1)
+	go s.work(ctx)
+}
+
+func (s *Sender) Close() error {
+	s.wg.Wait()
+	return nil
+}
+
+func (s *Sender) work(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.process(ctx); err != nil {
+				log.Err(err).Msg("process webhook deliveries")
+			}
+		}
+	}
+}
+
+// process - delivers all pending events which are due
+func (s *Sender) process(ctx context.Context) error {
+	webhooks := make(map[uint64]*storage.Webhook)
+
+	for {
+		now := s.now()
+		deliveries, err := s.deliveries.Pending(ctx, now, now.Add(s.lease), s.batchSize)
+		if err != nil {
+			return errors.Wrap(err, "receive pending deliveries")
+		}
+
+		for i := range deliveries {
+			webhook, ok := webhooks[deliveries[i].WebhookId]
+			if !ok {
+				webhook, err = s.webhooks.GetByID(ctx, deliveries[i].WebhookId)
+				if err != nil {
+					if !s.webhooks.IsNoRows(err) {
+						return errors.Wrapf(err, "receive webhook %d", deliveries[i].WebhookId)
+					}
+					webhook = nil
+				}
+				webhooks[deliveries[i].WebhookId] = webhook
+			}
+
+			s.attempt(ctx, webhook, &deliveries[i])
+			if err := s.deliveries.Update(ctx, &deliveries[i]); err != nil {
+				return errors.Wrapf(err, "update delivery %d", deliveries[i].Id)
+			}
+		}
+
+		if len(deliveries) < s.batchSize {
+			return nil
+		}
+	}
+}
+
+// attempt - sends delivery to webhook and updates its status
+func (s *Sender) attempt(ctx context.Context, webhook *storage.Webhook, delivery *storage.WebhookDelivery) {
+	delivery.Attempts += 1
+
+	switch {
+	case webhook == nil:
+		delivery.Status = storage.WebhookDeliveryFailed
+		delivery.LastError = "webhook is not found"
+		return
+	case !webhook.Active:
+		delivery.Status = storage.WebhookDeliveryFailed
+		delivery.LastError = "webhook is not active"
+		return
+	}
+
+	code, err := s.send(ctx, webhook, delivery)
+	delivery.ResponseCode = code
+	if err == nil {
+		now := s.now()
+		delivery.Status = storage.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= s.maxAttempts {
+		delivery.Status = storage.WebhookDeliveryFailed
+		log.Warn().
+			Uint64("delivery_id", delivery.Id).
+			Uint64("webhook_id", webhook.Id).
+			Str("error", delivery.LastError).
+			Msg("webhook delivery moved to dead letters")
+		return
+	}
+	delivery.NextAttemptAt = s.now().Add(s.delay(delivery.Attempts))
+}
+
+func (s *Sender) send(ctx context.Context, webhook *storage.Webhook, delivery *storage.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := s.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(delivery.Id, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return resp.StatusCode, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// delay - returns exponential backoff before the next attempt
+func (s *Sender) delay(attempts int) time.Duration {
+	delay := s.backoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
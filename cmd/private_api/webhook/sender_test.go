@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestSender(t *testing.T, cfg Config) (*Sender, *mock.MockIWebhook, *mock.MockIWebhookDelivery) {
+	ctrl := gomock.NewController(t)
+	webhooks := mock.NewMockIWebhook(ctrl)
+	deliveries := mock.NewMockIWebhookDelivery(ctrl)
+	sender := NewSender(cfg, webhooks, deliveries)
+	sender.now = func() time.Time { return testTime }
+	return sender, webhooks, deliveries
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign("secret", 1700000000, []byte(`{"event":"transfer"}`))
+	require.Equal(t, "sha256=f1c1e8bb6f049d88e0becf34bc56d6e441d0ed94396a97c9ff93bf4aaa53002c", signature)
+	require.NotEqual(t, signature, Sign("other", 1700000000, []byte(`{"event":"transfer"}`)))
+	require.NotEqual(t, signature, Sign("secret", 1700000001, []byte(`{"event":"transfer"}`)))
+}
+
+func TestSenderDelivered(t *testing.T) {
+	payload := `{"event":"transfer","height":100}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, payload, string(body))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, storage.WebhookEventTransfer, r.Header.Get(HeaderEvent))
+		require.Equal(t, "7", r.Header.Get(HeaderDelivery))
+
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		require.Equal(t, testTime.Unix(), timestamp)
+		require.Equal(t, Sign("secret", timestamp, body), r.Header.Get(HeaderSignature))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender, webhooks, deliveries := newTestSender(t, Config{})
+
+	deliveries.EXPECT().
+		Pending(gomock.Any(), testTime, testTime.Add(sender.lease), defaultBatchSize).
+		Return([]storage.WebhookDelivery{
+			{
+				Id:        7,
+				WebhookId: 1,
+				Event:     storage.WebhookEventTransfer,
+				Payload:   payload,
+				Status:    storage.WebhookDeliveryPending,
+			},
+		}, nil).
+		Times(1)
+
+	webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(1)).
+		Return(&storage.Webhook{
+			Id:     1,
+			Url:    server.URL,
+			Secret: "secret",
+			Active: true,
+		}, nil).
+		Times(1)
+
+	deliveries.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery *storage.WebhookDelivery) error {
+			require.Equal(t, storage.WebhookDeliveryDelivered, delivery.Status)
+			require.Equal(t, 1, delivery.Attempts)
+			require.Equal(t, http.StatusNoContent, delivery.ResponseCode)
+			require.NotNil(t, delivery.DeliveredAt)
+			require.Empty(t, delivery.LastError)
+			return nil
+		}).
+		Times(1)
+
+	require.NoError(t, sender.process(context.Background()))
+}
+
+func TestSenderRetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sender, _, _ := newTestSender(t, Config{MaxAttempts: 3, Backoff: 5})
+	webhook := &storage.Webhook{Id: 1, Url: server.URL, Secret: "secret", Active: true}
+	delivery := &storage.WebhookDelivery{Id: 1, WebhookId: 1, Payload: "{}", Status: storage.WebhookDeliveryPending}
+
+	sender.attempt(context.Background(), webhook, delivery)
+	require.Equal(t, storage.WebhookDeliveryPending, delivery.Status)
+	require.Equal(t, 1, delivery.Attempts)
+	require.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+	require.Equal(t, "unexpected response status: 500", delivery.LastError)
+	require.Equal(t, testTime.Add(5*time.Second), delivery.NextAttemptAt)
+
+	sender.attempt(context.Background(), webhook, delivery)
+	require.Equal(t, storage.WebhookDeliveryPending, delivery.Status)
+	require.Equal(t, testTime.Add(10*time.Second), delivery.NextAttemptAt)
+
+	sender.attempt(context.Background(), webhook, delivery)
+	require.Equal(t, storage.WebhookDeliveryFailed, delivery.Status)
+	require.Equal(t, 3, delivery.Attempts)
+	require.Nil(t, delivery.DeliveredAt)
+}
+
+func TestSenderInactiveWebhook(t *testing.T) {
+	sender, _, _ := newTestSender(t, Config{})
+
+	delivery := &storage.WebhookDelivery{Id: 1, WebhookId: 1, Status: storage.WebhookDeliveryPending}
+	sender.attempt(context.Background(), &storage.Webhook{Id: 1}, delivery)
+	require.Equal(t, storage.WebhookDeliveryFailed, delivery.Status)
+	require.Equal(t, "webhook is not active", delivery.LastError)
+
+	delivery = &storage.WebhookDelivery{Id: 2, WebhookId: 2, Status: storage.WebhookDeliveryPending}
+	sender.attempt(context.Background(), nil, delivery)
+	require.Equal(t, storage.WebhookDeliveryFailed, delivery.Status)
+	require.Equal(t, "webhook is not found", delivery.LastError)
+}
+
+func TestSenderUnknownWebhook(t *testing.T) {
+	sender, webhooks, deliveries := newTestSender(t, Config{})
+
+	deliveries.EXPECT().
+		Pending(gomock.Any(), testTime, testTime.Add(sender.lease), defaultBatchSize).
+		Return([]storage.WebhookDelivery{
+			{Id: 1, WebhookId: 5, Status: storage.WebhookDeliveryPending},
+			{Id: 2, WebhookId: 5, Status: storage.WebhookDeliveryPending},
+		}, nil).
+		Times(1)
+
+	webhooks.EXPECT().
+		GetByID(gomock.Any(), uint64(5)).
+		Return(nil, sql.ErrNoRows).
+		Times(1)
+	webhooks.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	deliveries.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery *storage.WebhookDelivery) error {
+			require.Equal(t, storage.WebhookDeliveryFailed, delivery.Status)
+			return nil
+		}).
+		Times(2)
+
+	require.NoError(t, sender.process(context.Background()))
+}
+
+func TestSenderDelay(t *testing.T) {
+	sender := NewSender(Config{}, nil, nil)
+	require.Equal(t, 10*time.Second, sender.delay(1))
+	require.Equal(t, 20*time.Second, sender.delay(2))
+	require.Equal(t, 80*time.Second, sender.delay(4))
+	require.Equal(t, time.Hour, sender.delay(20))
+}
@@ -4,5 +4,6 @@ CREATE OR REPLACE PROCEDURE refresh_materialized_view(job_id INT, config JSONB)
     $$
     BEGIN
         REFRESH MATERIALIZED VIEW leaderboard;
+        REFRESH MATERIALIZED VIEW new_addresses_by_day;
     END
     $$;
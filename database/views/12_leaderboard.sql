@@ -1,6 +1,7 @@
 CREATE MATERIALIZED VIEW IF NOT EXISTS leaderboard AS
 	select 
         app.*,
+        agg.rollup_ids,
         agg.size,
         agg.min_size,
         agg.max_size,
@@ -10,7 +11,8 @@ CREATE MATERIALIZED VIEW IF NOT EXISTS leaderboard AS
         agg.first_time
     from (
         select
-            rollup_id,
+            links.app_id,
+            array_agg(distinct links.rollup_id) as rollup_ids,
             sum(size) as size, 
             min(min_size) as min_size,
             max(max_size) as max_size,
@@ -19,8 +21,13 @@ CREATE MATERIALIZED VIEW IF NOT EXISTS leaderboard AS
             max(last_time) as last_time, 
             min(first_time) as first_time
         from rollup_stats_by_month
-        group by rollup_id
+        inner join (
+            select id as app_id, rollup_id from app
+            union
+            select app_id, rollup_id from app_rollup
+        ) as links on links.rollup_id = rollup_stats_by_month.rollup_id
+        group by links.app_id
     ) as agg
-    inner join app on app.rollup_id = agg.rollup_id;
+    inner join app on app.id = agg.app_id;
 
-CALL add_job_refresh_materialized_view();
\ No newline at end of file
+CALL add_job_refresh_materialized_view();
@@ -0,0 +1,15 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS fee_action_stats_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 hour'::interval, time) AS ts,
+		fee.action_type as action_type,
+		fee.asset as asset,
+		count(*) as fee_count,
+		sum(amount) as amount,
+		min(amount) as min_amount,
+		max(amount) as max_amount
+	from fee
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('fee_action_stats_by_hour', INTERVAL '1 minute', INTERVAL '1 minute');
@@ -0,0 +1,16 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS fee_rollup_stats_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 hour'::interval, time) AS ts,
+		fee.rollup_id as rollup_id,
+		fee.asset as asset,
+		count(*) as fee_count,
+		sum(amount) as amount,
+		min(amount) as min_amount,
+		max(amount) as max_amount
+	from fee
+	where fee.rollup_id > 0
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('fee_rollup_stats_by_hour', INTERVAL '1 minute', INTERVAL '1 minute');
@@ -0,0 +1,15 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS fee_rollup_stats_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 day'::interval, fee_rollup_stats_by_hour.ts) AS ts,
+		fee_rollup_stats_by_hour.rollup_id as rollup_id,
+		fee_rollup_stats_by_hour.asset as asset,
+		sum(fee_count) as fee_count,
+		sum(amount) as amount,
+		min(min_amount) as min_amount,
+		max(max_amount) as max_amount
+	from fee_rollup_stats_by_hour
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('fee_rollup_stats_by_day', INTERVAL '1 minute', INTERVAL '1 minute');
@@ -0,0 +1,15 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS fee_rollup_stats_by_month
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 month'::interval, fee_rollup_stats_by_day.ts) AS ts,
+		fee_rollup_stats_by_day.rollup_id as rollup_id,
+		fee_rollup_stats_by_day.asset as asset,
+		sum(fee_count) as fee_count,
+		sum(amount) as amount,
+		min(min_amount) as min_amount,
+		max(max_amount) as max_amount
+	from fee_rollup_stats_by_day
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('fee_rollup_stats_by_month', INTERVAL '1 minute', INTERVAL '1 hour');
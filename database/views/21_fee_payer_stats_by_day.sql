@@ -0,0 +1,13 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS fee_payer_stats_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 day'::interval, time) AS ts,
+		fee.payer_id as payer_id,
+		fee.asset as asset,
+		count(*) as fee_count,
+		sum(amount) as amount
+	from fee
+	group by 1, 2, 3
+	order by 1 desc;
+
+CALL add_view_refresh_job('fee_payer_stats_by_day', INTERVAL '1 minute', INTERVAL '1 hour');
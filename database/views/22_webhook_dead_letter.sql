@@ -0,0 +1,4 @@
+CREATE OR REPLACE VIEW webhook_dead_letter AS
+	select *
+	from webhook_delivery
+	where webhook_delivery.status = 'failed';
@@ -0,0 +1,11 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS signer_stats_by_week
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 week'::interval, signer_stats_by_day.ts) AS ts,
+		signer_stats_by_day.signer_id as signer_id,
+		sum(tx_count) as tx_count
+	from signer_stats_by_day
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('signer_stats_by_week', INTERVAL '1 minute', INTERVAL '1 hour');
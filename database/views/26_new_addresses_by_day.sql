@@ -0,0 +1,10 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS new_addresses_by_day AS
+	select 
+		time_bucket('1 day'::interval, block.time) AS ts,
+		count(*) as addresses_count
+	from address
+	inner join block on block.height = address.height
+	group by 1
+	order by 1 desc;
+
+CALL add_job_refresh_materialized_view();
@@ -0,0 +1,11 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS action_stats_by_day
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 day'::interval, action_stats_by_hour.ts) AS ts,
+		action_stats_by_hour.action_type as action_type,
+		sum(actions_count) as actions_count
+	from action_stats_by_hour
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('action_stats_by_day', INTERVAL '1 minute', INTERVAL '1 hour');
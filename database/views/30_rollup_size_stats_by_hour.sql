@@ -0,0 +1,15 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS rollup_size_stats_by_hour
+WITH (timescaledb.continuous, timescaledb.materialized_only=false) AS
+	select 
+		time_bucket('1 hour'::interval, time) AS ts,
+		rollup_action.rollup_id as rollup_id,
+		count(*) as count,
+		min(size) as min_size,
+		max(size) as max_size,
+		percentile_agg(size) as size_pct
+	from rollup_action
+	where action_type = 'rollup_data_submission'
+	group by 1, 2
+	order by 1 desc;
+
+CALL add_view_refresh_job('rollup_size_stats_by_hour', INTERVAL '1 minute', INTERVAL '1 minute');
@@ -22,8 +22,10 @@ type IAction interface {
 	ByTxId(ctx context.Context, txId uint64, limit, offset int) ([]Action, error)
 	ByBlock(ctx context.Context, height pkgTypes.Level, limit, offset int) ([]ActionWithTx, error)
 	ByAddress(ctx context.Context, addressId uint64, filters AddressActionsFilter) ([]AddressAction, error)
-	ByRollup(ctx context.Context, rollupId uint64, limit, offset int, sort storage.SortOrder) ([]RollupAction, error)
+	ByRollup(ctx context.Context, rollupId uint64, fltrs RollupActionsFilter) ([]RollupAction, error)
 	ByRollupAndBridge(ctx context.Context, rollupId uint64, fltrs RollupAndBridgeActionsFilter) ([]ActionWithTx, error)
+	WithdrawalsByDestination(ctx context.Context, rollupId uint64, destination string, exact bool, limit, offset int, sort storage.SortOrder) ([]ActionWithTx, error)
+	WithdrawalTotalsByDestination(ctx context.Context, rollupId uint64, destination string, exact bool) ([]AssetTotal, error)
 }
 
 type AddressActionsFilter struct {
@@ -31,6 +33,14 @@ type AddressActionsFilter struct {
 	Offset      int
 	Sort        storage.SortOrder
 	ActionTypes types.ActionTypeMask
+	MaxHeight   pkgTypes.Level
+}
+
+type RollupActionsFilter struct {
+	Limit     int
+	Offset    int
+	Sort      storage.SortOrder
+	MaxHeight pkgTypes.Level
 }
 
 type RollupAndBridgeActionsFilter struct {
@@ -42,6 +52,7 @@ type RollupAndBridgeActionsFilter struct {
 	ActionTypes   types.ActionTypeMask
 	From          time.Time
 	To            time.Time
+	MaxHeight     pkgTypes.Level
 }
 
 type ActionWithTx struct {
@@ -55,13 +66,14 @@ type ActionWithTx struct {
 type Action struct {
 	bun.BaseModel `bun:"action" comment:"Table with actions"`
 
-	Id       uint64           `bun:"id,pk,notnull,autoincrement" comment:"Unique internal id"`
-	Height   pkgTypes.Level   `bun:",notnull"                    comment:"The number (height) of this block"`
-	Time     time.Time        `bun:"time,pk,notnull"             comment:"The time of block"`
-	Position int64            `bun:"position"                    comment:"Position in transaction"`
-	Type     types.ActionType `bun:",type:action_type"           comment:"Action type"`
-	TxId     uint64           `bun:"tx_id"                       comment:"Parent transaction id"`
-	Data     map[string]any   `bun:"data,type:jsonb"             comment:"Action data"`
+	Id                  uint64           `bun:"id,pk,notnull,autoincrement"    comment:"Unique internal id"`
+	Height              pkgTypes.Level   `bun:",notnull"                       comment:"The number (height) of this block"`
+	Time                time.Time        `bun:"time,pk,notnull"                comment:"The time of block"`
+	Position            int64            `bun:"position"                       comment:"Position in transaction"`
+	Type                types.ActionType `bun:",type:action_type"              comment:"Action type"`
+	TxId                uint64           `bun:"tx_id"                          comment:"Parent transaction id"`
+	Data                map[string]any   `bun:"data,type:jsonb"                comment:"Action data"`
+	RollupReturnAddress string           `bun:"rollup_return_address,nullzero" comment:"Rollup-side sender of ICS20 withdrawal from rollup. It's taken from withdrawal memo"`
 
 	// Rollup         *Rollup          `bun:"-"`
 	Addresses      []*AddressAction `bun:"-"`
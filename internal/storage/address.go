@@ -17,6 +17,8 @@ type AddressListFilter struct {
 	Offset int
 	Sort   storage.SortOrder
 	Asset  string
+
+	MaxHeight types.Level
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -24,7 +26,9 @@ type IAddress interface {
 	storage.Table[*Address]
 
 	ByHash(ctx context.Context, hash string) (Address, error)
+	ByHashes(ctx context.Context, hashes []string) ([]Address, error)
 	ListWithBalance(ctx context.Context, fltrs AddressListFilter) ([]Address, error)
+	ByHeight(ctx context.Context, height types.Level) ([]Address, error)
 }
 
 // Address -
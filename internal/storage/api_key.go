@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IApiKey interface {
+	storage.Table[*ApiKey]
+
+	ByHash(ctx context.Context, hash string) (ApiKey, error)
+}
+
+// ApiKey - key of the public API partner. Only SHA-256 hash of the key is stored.
+type ApiKey struct {
+	bun.BaseModel `bun:"api_key" comment:"Table with API keys"`
+
+	Id        uint64     `bun:"id,pk,notnull,autoincrement"  comment:"Unique internal identity"`
+	Name      string     `bun:"name,notnull,type:text"       comment:"Name of the key owner"`
+	Prefix    string     `bun:"prefix,notnull,type:text"     comment:"First symbols of the key for identification"`
+	KeyHash   string     `bun:"key_hash,unique:api_key_hash" comment:"SHA-256 hash of the key"`
+	TierId    uint64     `bun:"tier_id,notnull"              comment:"Tier internal identity"`
+	Active    bool       `bun:"active,notnull"               comment:"Is key active"`
+	CreatedAt time.Time  `bun:"created_at,notnull"           comment:"Creation time"`
+	ExpiresAt *time.Time `bun:"expires_at"                   comment:"Expiration time of the key. Null if key does not expire"`
+
+	Tier *ApiTier `bun:"rel:belongs-to,join:tier_id=id"`
+}
+
+// TableName -
+func (ApiKey) TableName() string {
+	return "api_key"
+}
+
+// IsValid - checks whether key is active and not expired at the time
+func (key ApiKey) IsValid(now time.Time) bool {
+	return key.Active && (key.ExpiresAt == nil || key.ExpiresAt.After(now))
+}
+
+// HashKey - returns hex-encoded SHA-256 hash of the raw key. It's used for API keys and operator credentials.
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
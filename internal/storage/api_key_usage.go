@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IApiKeyUsage interface {
+	Add(ctx context.Context, usage ...ApiKeyUsage) error
+	ByApiKey(ctx context.Context, apiKeyId uint64, from, to time.Time) ([]ApiKeyUsage, error)
+}
+
+// ApiKeyUsage - count of requests made with API key to the endpoint during the day
+type ApiKeyUsage struct {
+	bun.BaseModel `bun:"api_key_usage" comment:"Table with daily usage of API keys"`
+
+	ApiKeyId uint64    `bun:"api_key_id,pk"         comment:"API key internal identity"`
+	Day      time.Time `bun:"day,pk,type:date"      comment:"Day of usage"`
+	Endpoint string    `bun:"endpoint,pk,type:text" comment:"Route of the endpoint"`
+	Requests int64     `bun:"requests"              comment:"Count of served requests"`
+	Rejected int64     `bun:"rejected"              comment:"Count of requests rejected by limits"`
+}
+
+// TableName -
+func (ApiKeyUsage) TableName() string {
+	return "api_key_usage"
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IApiTier interface {
+	storage.Table[*ApiTier]
+}
+
+// ApiTier - limits of API keys. Zero value of limit means that the limit is not applied.
+type ApiTier struct {
+	bun.BaseModel `bun:"api_tier" comment:"Table with tiers of API keys"`
+
+	Id         uint64 `bun:"id,pk,notnull,autoincrement"         comment:"Unique internal identity"`
+	Name       string `bun:"name,unique:api_tier_name,type:text" comment:"Tier name"`
+	Rate       int64  `bun:"rate"                                comment:"Count of allowed requests per second"`
+	DailyQuota int64  `bun:"daily_quota"                         comment:"Count of allowed requests per day"`
+}
+
+// TableName -
+func (ApiTier) TableName() string {
+	return "api_tier"
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/uptrace/bun"
 )
@@ -27,6 +28,11 @@ type IApp interface {
 	Leaderboard(ctx context.Context, fltrs LeaderboardFilters) ([]AppWithStats, error)
 	BySlug(ctx context.Context, slug string) (AppWithStats, error)
 	ByRollupId(ctx context.Context, rollupId uint64) (AppWithStats, error)
+	IdByRollupId(ctx context.Context, rollupId uint64) (uint64, error)
+	Rollups(ctx context.Context, appId uint64) ([]AppRollup, error)
+	Bridges(ctx context.Context, appId uint64) ([]AppBridge, error)
+	Report(ctx context.Context, rollupIds []uint64, fltrs AppReportFilter) (AppReport, error)
+	ReportSeries(ctx context.Context, rollupIds []uint64, req SeriesRequest) ([]AppReportItem, error)
 }
 
 type App struct {
@@ -87,6 +93,7 @@ type AppWithStats struct {
 }
 
 type AppStats struct {
+	RollupIds       []uint64  `bun:"rollup_ids,array"`
 	Size            int64     `bun:"size"`
 	MinSize         int64     `bun:"min_size"`
 	MaxSize         int64     `bun:"max_size"`
@@ -95,3 +102,56 @@ type AppStats struct {
 	LastActionTime  time.Time `bun:"last_time"`
 	FirstActionTime time.Time `bun:"first_time"`
 }
+
+type AppReportFilter struct {
+	From         time.Time
+	To           time.Time
+	GapThreshold time.Duration
+	GapsLimit    int
+}
+
+// AppReport - cost and efficiency of data submissions of all application rollups for the period
+type AppReport struct {
+	Size           int64     `bun:"size"`
+	ActionsCount   int64     `bun:"actions_count"`
+	FirstTime      time.Time `bun:"first_time"`
+	LastTime       time.Time `bun:"last_time"`
+	AvgInterval    float64   `bun:"avg_interval"`
+	IntervalJitter float64   `bun:"interval_jitter"`
+
+	Fees    []AppReportFee    `bun:"-"`
+	Gaps    []AppReportGap    `bun:"-"`
+	Signers []AppReportSigner `bun:"-"`
+}
+
+type AppReportFee struct {
+	Asset    string `bun:"asset"`
+	Amount   string `bun:"amount"`
+	FeeCount int64  `bun:"fee_count"`
+}
+
+// AppReportGap - pause between two consecutive data submissions which is longer than threshold
+type AppReportGap struct {
+	FromTime   time.Time      `bun:"from_time"`
+	ToTime     time.Time      `bun:"to_time"`
+	FromHeight pkgTypes.Level `bun:"from_height"`
+	ToHeight   pkgTypes.Level `bun:"to_height"`
+	Duration   float64        `bun:"duration"`
+}
+
+type AppReportSigner struct {
+	SignerId     uint64   `bun:"signer_id"`
+	ActionsCount int64    `bun:"actions_count"`
+	Size         int64    `bun:"size"`
+	Signer       *Address `bun:"rel:belongs-to"`
+}
+
+type AppReportItem struct {
+	Time           time.Time `bun:"ts"`
+	Size           int64     `bun:"size"`
+	ActionsCount   int64     `bun:"actions_count"`
+	AvgInterval    float64   `bun:"avg_interval"`
+	IntervalJitter float64   `bun:"interval_jitter"`
+
+	Fees []AppReportFee `bun:"-"`
+}
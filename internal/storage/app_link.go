@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"github.com/uptrace/bun"
+)
+
+// Roles of rollup and bridge which are stored in the app table itself
+const (
+	AppRolePrimary = "primary"
+	AppRoleNative  = "native"
+)
+
+// AppRollup - additional rollup of application. Rollup may be linked only to one application.
+type AppRollup struct {
+	bun.BaseModel `bun:"app_rollup" comment:"Table with additional rollups of applications"`
+
+	AppId    uint64 `bun:"app_id,pk"                                comment:"Application internal id"`
+	RollupId uint64 `bun:"rollup_id,pk,unique:app_rollup_rollup_id" comment:"Rollup internal id"`
+	Role     string `bun:"role"                                     comment:"Rollup role label, e.g. mainnet or testnet"`
+
+	Rollup *Rollup `bun:"rel:belongs-to,join:rollup_id=id"`
+}
+
+func (AppRollup) TableName() string {
+	return "app_rollup"
+}
+
+// AppBridge - additional bridge account of application
+type AppBridge struct {
+	bun.BaseModel `bun:"app_bridge" comment:"Table with additional bridge accounts of applications"`
+
+	AppId    uint64 `bun:"app_id,pk"    comment:"Application internal id"`
+	BridgeId uint64 `bun:"bridge_id,pk" comment:"Bridge address internal id"`
+	Role     string `bun:"role"         comment:"Bridge role label, e.g. asset name"`
+
+	Bridge *Address `bun:"rel:belongs-to,join:bridge_id=id"`
+}
+
+func (AppBridge) TableName() string {
+	return "app_bridge"
+}
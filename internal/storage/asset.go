@@ -13,6 +13,7 @@ import (
 
 type IAsset interface {
 	List(ctx context.Context, limit int, offset int, sortBy string, order sdk.SortOrder) ([]Asset, error)
+	ByDenom(ctx context.Context, denom string) (Asset, error)
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// BalanceMismatch - balance which is not equal to the sum of its balance updates
+type BalanceMismatch struct {
+	AddressId uint64          `bun:"address_id"`
+	Hash      string          `bun:"hash"`
+	Currency  string          `bun:"currency"`
+	Total     decimal.Decimal `bun:"total"`
+	Expected  decimal.Decimal `bun:"expected"`
+}
+
+// AddressMismatch - address which counters are not equal to the values recalculated from actions and transactions
+type AddressMismatch struct {
+	Id                    uint64 `bun:"id"`
+	Hash                  string `bun:"hash"`
+	ActionsCount          int64  `bun:"actions_count"`
+	ExpectedActionsCount  int64  `bun:"expected_actions_count"`
+	SignedTxCount         int64  `bun:"signed_tx_count"`
+	ExpectedSignedTxCount int64  `bun:"expected_signed_tx_count"`
+	Nonce                 uint32 `bun:"nonce"`
+	ExpectedNonce         uint32 `bun:"expected_nonce"`
+}
+
+// RollupMismatch - rollup which counters are not equal to the values recalculated from rollup actions and bridges
+type RollupMismatch struct {
+	Id                   uint64 `bun:"id"`
+	AstriaId             []byte `bun:"astria_id"`
+	ActionsCount         int64  `bun:"actions_count"`
+	ExpectedActionsCount int64  `bun:"expected_actions_count"`
+	Size                 int64  `bun:"size"`
+	ExpectedSize         int64  `bun:"expected_size"`
+	BridgeCount          int64  `bun:"bridge_count"`
+	ExpectedBridgeCount  int64  `bun:"expected_bridge_count"`
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IAudit interface {
+	BalanceMismatches(ctx context.Context, limit int) ([]BalanceMismatch, error)
+	AddressMismatches(ctx context.Context, limit int) ([]AddressMismatch, error)
+	RollupMismatches(ctx context.Context, limit int) ([]RollupMismatch, error)
+	ExpectedState(ctx context.Context, name string) (State, error)
+	Addresses(ctx context.Context, fromId uint64, limit int) ([]Address, error)
+}
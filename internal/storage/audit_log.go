@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+// types of audited mutations
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+// entities changed through the private API
+const (
+	AuditEntityApp             = "app"
+	AuditEntityAppRollup       = "app_rollup"
+	AuditEntityAppBridge       = "app_bridge"
+	AuditEntityWebhook         = "webhook"
+	AuditEntityWebhookDelivery = "webhook_delivery"
+	AuditEntityApiKey          = "api_key"
+	AuditEntityApiTier         = "api_tier"
+	AuditEntityOperator        = "operator"
+)
+
+type AuditLogFilter struct {
+	Limit      int
+	Offset     int
+	Sort       storage.SortOrder
+	OperatorId uint64
+	Entity     string
+	EntityId   uint64
+	Action     string
+	TimeFrom   time.Time
+	TimeTo     time.Time
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IAuditLog interface {
+	storage.Table[*AuditLog]
+
+	Filter(ctx context.Context, fltrs AuditLogFilter) ([]AuditLog, error)
+}
+
+// AuditLog - record of mutation made through the private API. Before and after contain JSON state of the entity.
+type AuditLog struct {
+	bun.BaseModel `bun:"audit_log" comment:"Table with audit trail of the private API"`
+
+	Id           uint64    `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Time         time.Time `bun:"time,notnull"                comment:"Time of the mutation"`
+	OperatorId   uint64    `bun:"operator_id"                 comment:"Operator internal identity. Zero for the root key"`
+	OperatorName string    `bun:"operator_name,type:text"     comment:"Operator name at the moment of the mutation"`
+	Action       string    `bun:"action,type:text"            comment:"Type of mutation"`
+	Entity       string    `bun:"entity,type:text"            comment:"Changed entity"`
+	EntityId     uint64    `bun:"entity_id"                   comment:"Changed entity internal identity"`
+	Before       string    `bun:"before,type:jsonb,nullzero"  comment:"State of the entity before the mutation"`
+	After        string    `bun:"after,type:jsonb,nullzero"   comment:"State of the entity after the mutation"`
+}
+
+// TableName -
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
@@ -20,10 +20,20 @@ type IBlock interface {
 
 	Last(ctx context.Context) (Block, error)
 	ByHeight(ctx context.Context, height pkgTypes.Level, withStats bool) (Block, error)
+	ByHeights(ctx context.Context, heights []pkgTypes.Level, withStats bool) ([]Block, error)
 	ByHash(ctx context.Context, hash []byte) (Block, error)
 	ByProposer(ctx context.Context, proposerId uint64, limit, offset int, order storage.SortOrder) ([]Block, error)
 	ListWithStats(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*Block, error)
 	ByIdWithRelations(ctx context.Context, id uint64) (Block, error)
+	Filter(ctx context.Context, fltrs BlockListFilter) ([]*Block, error)
+}
+
+type BlockListFilter struct {
+	Limit     uint64
+	Offset    uint64
+	Sort      storage.SortOrder
+	MaxHeight pkgTypes.Level
+	WithStats bool
 }
 
 // Block -
@@ -57,6 +67,7 @@ type Block struct {
 	Validators      map[string]*Validator     `bun:"-"` // internal field for updating validators
 	BlockSignatures []BlockSignature          `bun:"-"` // internal field for saving block signatures
 	Constants       []*Constant               `bun:"-"` // internal field for updating constants
+	ConstantHistory []*ConstantHistory        `bun:"-"` // internal field for saving history of constants
 	Bridges         []*Bridge                 `bun:"-"` // internal field for saving bridges
 	Transfers       []*Transfer               `bun:"-"` // internal field for saving transfers
 	MarketUpdates   []MarketUpdate            `bun:"-"` // internal field for saving market updates
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/uptrace/bun"
 )
 
@@ -15,6 +16,7 @@ type IConstant interface {
 	Get(ctx context.Context, module types.ModuleName, name string) (Constant, error)
 	ByModule(ctx context.Context, module types.ModuleName) ([]Constant, error)
 	All(ctx context.Context) ([]Constant, error)
+	AtHeight(ctx context.Context, height pkgTypes.Level) ([]Constant, error)
 	IsNoRows(err error) bool
 }
 
@@ -29,3 +31,11 @@ type Constant struct {
 func (Constant) TableName() string {
 	return "constant"
 }
+
+// AllowedFeeAssetPrefix - prefix of generic constants which flag assets allowed for fee payment. Value of constant is "true" or "false".
+const AllowedFeeAssetPrefix = "allowed_fee_asset:"
+
+// AllowedFeeAssetConstant - returns name of constant for the fee asset
+func AllowedFeeAssetConstant(asset string) string {
+	return AllowedFeeAssetPrefix + asset
+}
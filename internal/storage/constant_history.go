@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IConstantHistory interface {
+	storage.Table[*ConstantHistory]
+
+	ByName(ctx context.Context, module types.ModuleName, name string, limit, offset int, sort storage.SortOrder) ([]ConstantHistory, error)
+	ByHeight(ctx context.Context, height pkgTypes.Level) ([]ConstantHistory, error)
+}
+
+// ConstantHistory - change of the constant value by action
+type ConstantHistory struct {
+	bun.BaseModel `bun:"constant_history" comment:"Table with history of constant changes"`
+
+	Id        uint64           `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal identity"                                json:"id"`
+	Height    pkgTypes.Level   `bun:"height,notnull"                  comment:"The number (height) of this block"                       json:"height"`
+	Time      time.Time        `bun:"time,notnull"                    comment:"The time of block"                                       json:"time"`
+	ActionId  uint64           `bun:"action_id"                       comment:"Action which changed constant"                           json:"action_id"`
+	Module    types.ModuleName `bun:"module,notnull,type:module_name" comment:"Module name which declares constant"                     json:"module"`
+	Name      string           `bun:"name,notnull,type:text"          comment:"Constant name"                                           json:"name"`
+	Value     string           `bun:"value,type:text"                 comment:"Constant value after the change"                         json:"value"`
+	PrevValue *string          `bun:"prev_value,type:text"            comment:"Constant value before the change. Null if it was created" json:"prev_value"`
+
+	Action *Action `bun:"-" json:"-"` // internal field for filling action id
+}
+
+// TableName -
+func (ConstantHistory) TableName() string {
+	return "constant_history"
+}
@@ -19,6 +19,16 @@ type IDeposit interface {
 
 	ByBridgeId(ctx context.Context, bridgeId uint64, limit, offset int, sort storage.SortOrder) ([]Deposit, error)
 	ByRollupId(ctx context.Context, rollupId uint64, limit, offset int, sort storage.SortOrder) ([]Deposit, error)
+	ByHeight(ctx context.Context, height pkgTypes.Level) ([]Deposit, error)
+	ByDestination(ctx context.Context, rollupId uint64, destination string, limit, offset int, sort storage.SortOrder) ([]Deposit, error)
+	TotalsByDestination(ctx context.Context, rollupId uint64, destination string) ([]AssetTotal, error)
+}
+
+// AssetTotal - sum and count of transfers of the asset
+type AssetTotal struct {
+	Asset  string          `bun:"asset"`
+	Amount decimal.Decimal `bun:"amount"`
+	Count  int64           `bun:"count"`
 }
 
 type Deposit struct {
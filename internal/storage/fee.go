@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/shopspring/decimal"
@@ -33,10 +34,12 @@ type Fee struct {
 	ActionId uint64          `bun:"action_id"                   comment:"Connected action id"`
 	TxId     uint64          `bun:"tx_id"                       comment:"Connected transaction id"`
 	PayerId  uint64          `bun:"payer_id"                    comment:"Who paid fee"`
+	RollupId uint64          `bun:"rollup_id"                   comment:"Rollup which data submission paid the fee"`
 
-	ActionType string   `bun:"-"`
-	Payer      *Address `bun:"rel:belongs-to"`
-	Tx         *Tx      `bun:"rel:belongs-to"`
+	ActionType types.ActionType `bun:"action_type,type:action_type" comment:"Action type"`
+
+	Payer *Address `bun:"rel:belongs-to"`
+	Tx    *Tx      `bun:"rel:belongs-to"`
 }
 
 func (Fee) TableName() string {
@@ -18,11 +18,13 @@ const (
 	ChannelHead     = "head"
 	ChannelTx       = "tx"
 	ChannelConstant = "constant"
+	ChannelRollback = "rollback"
 )
 
 var Models = []any{
 	&State{},
 	&Constant{},
+	&ConstantHistory{},
 	&Balance{},
 	&BalanceUpdate{},
 	&Address{},
@@ -41,9 +43,20 @@ var Models = []any{
 	&Transfer{},
 	&Deposit{},
 	&App{},
+	&AppRollup{},
+	&AppBridge{},
 	&Price{},
 	&Market{},
 	&MarketProvider{},
+	&RollbackEvent{},
+	&Webhook{},
+	&WebhookDelivery{},
+	&WebhookState{},
+	&ApiTier{},
+	&ApiKey{},
+	&ApiKeyUsage{},
+	&Operator{},
+	&AuditLog{},
 	&celestials.Celestial{},
 	&celestials.CelestialState{},
 }
@@ -60,6 +73,7 @@ type Transaction interface {
 	SaveBlockSignatures(ctx context.Context, signs ...BlockSignature) error
 	SaveBridges(ctx context.Context, bridges ...*Bridge) (int64, error)
 	SaveConstants(ctx context.Context, constants ...Constant) error
+	SaveConstantHistory(ctx context.Context, history ...*ConstantHistory) error
 	SaveRollupActions(ctx context.Context, actions ...*RollupAction) error
 	SaveRollupAddresses(ctx context.Context, addresses ...*RollupAddress) error
 	SaveRollups(ctx context.Context, rollups ...*Rollup) (int64, error)
@@ -73,7 +87,20 @@ type Transaction interface {
 	SaveMarkets(ctx context.Context, markets ...MarketUpdate) error
 	SaveMarketProviders(ctx context.Context, providers ...MarketProviderUpdate) error
 	UpdateApp(ctx context.Context, app *App) error
+	ReplaceApp(ctx context.Context, app *App) error
 	DeleteApp(ctx context.Context, appId uint64) error
+	SaveAppRollup(ctx context.Context, link *AppRollup) error
+	DeleteAppRollup(ctx context.Context, appId, rollupId uint64) error
+	SaveAppBridge(ctx context.Context, link *AppBridge) error
+	DeleteAppBridge(ctx context.Context, appId, bridgeId uint64) error
+	SaveAuditLog(ctx context.Context, log *AuditLog) error
+	DeleteApiTier(ctx context.Context, id uint64) error
+	DeleteApiKey(ctx context.Context, id uint64) error
+	DeleteWebhook(ctx context.Context, id uint64) error
+	SaveWebhookDeliveries(ctx context.Context, deliveries ...*WebhookDelivery) error
+	LockWebhookState(ctx context.Context, name string) (*WebhookState, error)
+	SaveWebhookState(ctx context.Context, cursor *WebhookState) error
+	CancelWebhookDeliveries(ctx context.Context, fromHeight types.Level) error
 	RetentionBlockSignatures(ctx context.Context, height types.Level) error
 
 	RollbackActions(ctx context.Context, height types.Level) (actions []Action, err error)
@@ -84,6 +111,7 @@ type Transaction interface {
 	RollbackBlockSignatures(ctx context.Context, height types.Level) (err error)
 	RollbackBlockStats(ctx context.Context, height types.Level) (stats BlockStats, err error)
 	RollbackBlock(ctx context.Context, height types.Level) error
+	RollbackConstantHistory(ctx context.Context, height types.Level) ([]ConstantHistory, error)
 	RollbackBridges(ctx context.Context, height types.Level) (int, error)
 	RollbackRollupActions(ctx context.Context, height types.Level) (rollupActions []RollupAction, err error)
 	RollbackRollupAddresses(ctx context.Context, height types.Level) (err error)
@@ -95,8 +123,15 @@ type Transaction interface {
 	RollbackTransfers(ctx context.Context, height types.Level) (err error)
 	RollbackPrices(ctx context.Context, height types.Level) (err error)
 	UpdateAddresses(ctx context.Context, address ...*Address) error
+	SetAddressesNonce(ctx context.Context, address ...*Address) error
 	UpdateConstants(ctx context.Context, constants ...*Constant) error
+	DeleteConstants(ctx context.Context, constants ...*Constant) error
 	UpdateRollups(ctx context.Context, rollups ...*Rollup) error
+	RecalculateAddresses(ctx context.Context, fromHeight types.Level) error
+	RecalculateRollups(ctx context.Context, fromHeight types.Level) error
+	RecalculateAddressesById(ctx context.Context, ids ...uint64) error
+	RecalculateRollupsById(ctx context.Context, ids ...uint64) error
+	RecalculateState(ctx context.Context, name string) error
 
 	LastBlock(ctx context.Context) (block Block, err error)
 	State(ctx context.Context, name string) (state State, err error)
@@ -106,6 +141,8 @@ type Transaction interface {
 	Validators(ctx context.Context) ([]Validator, error)
 	GetBridgeIdByAddressId(ctx context.Context, id uint64) (uint64, error)
 	GetAddressId(ctx context.Context, hash string) (uint64, error)
+	GetConstants(ctx context.Context, constants ...*Constant) ([]Constant, error)
+	GetApp(ctx context.Context, id uint64) (App, error)
 	RefreshLeaderboard(ctx context.Context) error
 }
 
@@ -163,18 +163,18 @@ func (c *MockIActionByIdCall) DoAndReturn(f func(context.Context, uint64) (stora
 }
 
 // ByRollup mocks base method.
-func (m *MockIAction) ByRollup(ctx context.Context, rollupId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.RollupAction, error) {
+func (m *MockIAction) ByRollup(ctx context.Context, rollupId uint64, fltrs storage.RollupActionsFilter) ([]storage.RollupAction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ByRollup", ctx, rollupId, limit, offset, sort)
+	ret := m.ctrl.Call(m, "ByRollup", ctx, rollupId, fltrs)
 	ret0, _ := ret[0].([]storage.RollupAction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ByRollup indicates an expected call of ByRollup.
-func (mr *MockIActionMockRecorder) ByRollup(ctx, rollupId, limit, offset, sort any) *MockIActionByRollupCall {
+func (mr *MockIActionMockRecorder) ByRollup(ctx, rollupId, fltrs any) *MockIActionByRollupCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRollup", reflect.TypeOf((*MockIAction)(nil).ByRollup), ctx, rollupId, limit, offset, sort)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByRollup", reflect.TypeOf((*MockIAction)(nil).ByRollup), ctx, rollupId, fltrs)
 	return &MockIActionByRollupCall{Call: call}
 }
 
@@ -190,13 +190,13 @@ func (c *MockIActionByRollupCall) Return(arg0 []storage.RollupAction, arg1 error
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockIActionByRollupCall) Do(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.RollupAction, error)) *MockIActionByRollupCall {
+func (c *MockIActionByRollupCall) Do(f func(context.Context, uint64, storage.RollupActionsFilter) ([]storage.RollupAction, error)) *MockIActionByRollupCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockIActionByRollupCall) DoAndReturn(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.RollupAction, error)) *MockIActionByRollupCall {
+func (c *MockIActionByRollupCall) DoAndReturn(f func(context.Context, uint64, storage.RollupActionsFilter) ([]storage.RollupAction, error)) *MockIActionByRollupCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -548,3 +548,81 @@ func (c *MockIActionUpdateCall) DoAndReturn(f func(context.Context, *storage.Act
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WithdrawalTotalsByDestination mocks base method.
+func (m *MockIAction) WithdrawalTotalsByDestination(ctx context.Context, rollupId uint64, destination string, exact bool) ([]storage.AssetTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawalTotalsByDestination", ctx, rollupId, destination, exact)
+	ret0, _ := ret[0].([]storage.AssetTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawalTotalsByDestination indicates an expected call of WithdrawalTotalsByDestination.
+func (mr *MockIActionMockRecorder) WithdrawalTotalsByDestination(ctx, rollupId, destination, exact any) *MockIActionWithdrawalTotalsByDestinationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawalTotalsByDestination", reflect.TypeOf((*MockIAction)(nil).WithdrawalTotalsByDestination), ctx, rollupId, destination, exact)
+	return &MockIActionWithdrawalTotalsByDestinationCall{Call: call}
+}
+
+// MockIActionWithdrawalTotalsByDestinationCall wrap *gomock.Call
+type MockIActionWithdrawalTotalsByDestinationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIActionWithdrawalTotalsByDestinationCall) Return(arg0 []storage.AssetTotal, arg1 error) *MockIActionWithdrawalTotalsByDestinationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIActionWithdrawalTotalsByDestinationCall) Do(f func(context.Context, uint64, string, bool) ([]storage.AssetTotal, error)) *MockIActionWithdrawalTotalsByDestinationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIActionWithdrawalTotalsByDestinationCall) DoAndReturn(f func(context.Context, uint64, string, bool) ([]storage.AssetTotal, error)) *MockIActionWithdrawalTotalsByDestinationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithdrawalsByDestination mocks base method.
+func (m *MockIAction) WithdrawalsByDestination(ctx context.Context, rollupId uint64, destination string, exact bool, limit, offset int, sort storage0.SortOrder) ([]storage.ActionWithTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawalsByDestination", ctx, rollupId, destination, exact, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.ActionWithTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawalsByDestination indicates an expected call of WithdrawalsByDestination.
+func (mr *MockIActionMockRecorder) WithdrawalsByDestination(ctx, rollupId, destination, exact, limit, offset, sort any) *MockIActionWithdrawalsByDestinationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawalsByDestination", reflect.TypeOf((*MockIAction)(nil).WithdrawalsByDestination), ctx, rollupId, destination, exact, limit, offset, sort)
+	return &MockIActionWithdrawalsByDestinationCall{Call: call}
+}
+
+// MockIActionWithdrawalsByDestinationCall wrap *gomock.Call
+type MockIActionWithdrawalsByDestinationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIActionWithdrawalsByDestinationCall) Return(arg0 []storage.ActionWithTx, arg1 error) *MockIActionWithdrawalsByDestinationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIActionWithdrawalsByDestinationCall) Do(f func(context.Context, uint64, string, bool, int, int, storage0.SortOrder) ([]storage.ActionWithTx, error)) *MockIActionWithdrawalsByDestinationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIActionWithdrawalsByDestinationCall) DoAndReturn(f func(context.Context, uint64, string, bool, int, int, storage0.SortOrder) ([]storage.ActionWithTx, error)) *MockIActionWithdrawalsByDestinationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
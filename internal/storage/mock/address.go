@@ -17,6 +17,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -83,6 +84,84 @@ func (c *MockIAddressByHashCall) DoAndReturn(f func(context.Context, string) (st
 	return c
 }
 
+// ByHashes mocks base method.
+func (m *MockIAddress) ByHashes(ctx context.Context, hashes []string) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHashes", ctx, hashes)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHashes indicates an expected call of ByHashes.
+func (mr *MockIAddressMockRecorder) ByHashes(ctx, hashes any) *MockIAddressByHashesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHashes", reflect.TypeOf((*MockIAddress)(nil).ByHashes), ctx, hashes)
+	return &MockIAddressByHashesCall{Call: call}
+}
+
+// MockIAddressByHashesCall wrap *gomock.Call
+type MockIAddressByHashesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressByHashesCall) Return(arg0 []storage.Address, arg1 error) *MockIAddressByHashesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressByHashesCall) Do(f func(context.Context, []string) ([]storage.Address, error)) *MockIAddressByHashesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressByHashesCall) DoAndReturn(f func(context.Context, []string) ([]storage.Address, error)) *MockIAddressByHashesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByHeight mocks base method.
+func (m *MockIAddress) ByHeight(ctx context.Context, height types.Level) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIAddressMockRecorder) ByHeight(ctx, height any) *MockIAddressByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIAddress)(nil).ByHeight), ctx, height)
+	return &MockIAddressByHeightCall{Call: call}
+}
+
+// MockIAddressByHeightCall wrap *gomock.Call
+type MockIAddressByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAddressByHeightCall) Return(arg0 []storage.Address, arg1 error) *MockIAddressByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAddressByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Address, error)) *MockIAddressByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAddressByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Address, error)) *MockIAddressByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockIAddress) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Address, error) {
 	m.ctrl.T.Helper()
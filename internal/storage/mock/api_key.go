@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=api_key.go -destination=mock/api_key.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiKey is a mock of IApiKey interface.
+type MockIApiKey struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyMockRecorder
+}
+
+// MockIApiKeyMockRecorder is the mock recorder for MockIApiKey.
+type MockIApiKeyMockRecorder struct {
+	mock *MockIApiKey
+}
+
+// NewMockIApiKey creates a new mock instance.
+func NewMockIApiKey(ctrl *gomock.Controller) *MockIApiKey {
+	mock := &MockIApiKey{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKey) EXPECT() *MockIApiKeyMockRecorder {
+	return m.recorder
+}
+
+// ByHash mocks base method.
+func (m *MockIApiKey) ByHash(ctx context.Context, hash string) (storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHash", ctx, hash)
+	ret0, _ := ret[0].(storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHash indicates an expected call of ByHash.
+func (mr *MockIApiKeyMockRecorder) ByHash(ctx, hash any) *MockIApiKeyByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHash", reflect.TypeOf((*MockIApiKey)(nil).ByHash), ctx, hash)
+	return &MockIApiKeyByHashCall{Call: call}
+}
+
+// MockIApiKeyByHashCall wrap *gomock.Call
+type MockIApiKeyByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyByHashCall) Return(arg0 storage.ApiKey, arg1 error) *MockIApiKeyByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyByHashCall) Do(f func(context.Context, string) (storage.ApiKey, error)) *MockIApiKeyByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyByHashCall) DoAndReturn(f func(context.Context, string) (storage.ApiKey, error)) *MockIApiKeyByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIApiKey) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIApiKeyMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIApiKeyCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIApiKey)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIApiKeyCursorListCall{Call: call}
+}
+
+// MockIApiKeyCursorListCall wrap *gomock.Call
+type MockIApiKeyCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyCursorListCall) Return(arg0 []*storage.ApiKey, arg1 error) *MockIApiKeyCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ApiKey, error)) *MockIApiKeyCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ApiKey, error)) *MockIApiKeyCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIApiKey) GetByID(ctx context.Context, id uint64) (*storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIApiKeyMockRecorder) GetByID(ctx, id any) *MockIApiKeyGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIApiKey)(nil).GetByID), ctx, id)
+	return &MockIApiKeyGetByIDCall{Call: call}
+}
+
+// MockIApiKeyGetByIDCall wrap *gomock.Call
+type MockIApiKeyGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyGetByIDCall) Return(arg0 *storage.ApiKey, arg1 error) *MockIApiKeyGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyGetByIDCall) Do(f func(context.Context, uint64) (*storage.ApiKey, error)) *MockIApiKeyGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ApiKey, error)) *MockIApiKeyGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIApiKey) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIApiKeyMockRecorder) IsNoRows(err any) *MockIApiKeyIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIApiKey)(nil).IsNoRows), err)
+	return &MockIApiKeyIsNoRowsCall{Call: call}
+}
+
+// MockIApiKeyIsNoRowsCall wrap *gomock.Call
+type MockIApiKeyIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyIsNoRowsCall) Return(arg0 bool) *MockIApiKeyIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyIsNoRowsCall) Do(f func(error) bool) *MockIApiKeyIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIApiKeyIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIApiKey) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIApiKeyMockRecorder) LastID(ctx any) *MockIApiKeyLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIApiKey)(nil).LastID), ctx)
+	return &MockIApiKeyLastIDCall{Call: call}
+}
+
+// MockIApiKeyLastIDCall wrap *gomock.Call
+type MockIApiKeyLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyLastIDCall) Return(arg0 uint64, arg1 error) *MockIApiKeyLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIApiKeyLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIApiKeyLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIApiKey) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIApiKeyMockRecorder) List(ctx, limit, offset, order any) *MockIApiKeyListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIApiKey)(nil).List), ctx, limit, offset, order)
+	return &MockIApiKeyListCall{Call: call}
+}
+
+// MockIApiKeyListCall wrap *gomock.Call
+type MockIApiKeyListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyListCall) Return(arg0 []*storage.ApiKey, arg1 error) *MockIApiKeyListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ApiKey, error)) *MockIApiKeyListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ApiKey, error)) *MockIApiKeyListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIApiKey) Save(ctx context.Context, m *storage.ApiKey) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIApiKeyMockRecorder) Save(ctx, m any) *MockIApiKeySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIApiKey)(nil).Save), ctx, m)
+	return &MockIApiKeySaveCall{Call: call}
+}
+
+// MockIApiKeySaveCall wrap *gomock.Call
+type MockIApiKeySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeySaveCall) Return(arg0 error) *MockIApiKeySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeySaveCall) Do(f func(context.Context, *storage.ApiKey) error) *MockIApiKeySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeySaveCall) DoAndReturn(f func(context.Context, *storage.ApiKey) error) *MockIApiKeySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIApiKey) Update(ctx context.Context, m *storage.ApiKey) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIApiKeyMockRecorder) Update(ctx, m any) *MockIApiKeyUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIApiKey)(nil).Update), ctx, m)
+	return &MockIApiKeyUpdateCall{Call: call}
+}
+
+// MockIApiKeyUpdateCall wrap *gomock.Call
+type MockIApiKeyUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyUpdateCall) Return(arg0 error) *MockIApiKeyUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyUpdateCall) Do(f func(context.Context, *storage.ApiKey) error) *MockIApiKeyUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyUpdateCall) DoAndReturn(f func(context.Context, *storage.ApiKey) error) *MockIApiKeyUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_key_usage.go
+//
+// Generated by this command:
+//
+//	mockgen -source=api_key_usage.go -destination=mock/api_key_usage.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiKeyUsage is a mock of IApiKeyUsage interface.
+type MockIApiKeyUsage struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiKeyUsageMockRecorder
+}
+
+// MockIApiKeyUsageMockRecorder is the mock recorder for MockIApiKeyUsage.
+type MockIApiKeyUsageMockRecorder struct {
+	mock *MockIApiKeyUsage
+}
+
+// NewMockIApiKeyUsage creates a new mock instance.
+func NewMockIApiKeyUsage(ctrl *gomock.Controller) *MockIApiKeyUsage {
+	mock := &MockIApiKeyUsage{ctrl: ctrl}
+	mock.recorder = &MockIApiKeyUsageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiKeyUsage) EXPECT() *MockIApiKeyUsageMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIApiKeyUsage) Add(ctx context.Context, usage ...storage.ApiKeyUsage) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range usage {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIApiKeyUsageMockRecorder) Add(ctx any, usage ...any) *MockIApiKeyUsageAddCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, usage...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIApiKeyUsage)(nil).Add), varargs...)
+	return &MockIApiKeyUsageAddCall{Call: call}
+}
+
+// MockIApiKeyUsageAddCall wrap *gomock.Call
+type MockIApiKeyUsageAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyUsageAddCall) Return(arg0 error) *MockIApiKeyUsageAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyUsageAddCall) Do(f func(context.Context, ...storage.ApiKeyUsage) error) *MockIApiKeyUsageAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyUsageAddCall) DoAndReturn(f func(context.Context, ...storage.ApiKeyUsage) error) *MockIApiKeyUsageAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByApiKey mocks base method.
+func (m *MockIApiKeyUsage) ByApiKey(ctx context.Context, apiKeyId uint64, from, to time.Time) ([]storage.ApiKeyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByApiKey", ctx, apiKeyId, from, to)
+	ret0, _ := ret[0].([]storage.ApiKeyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByApiKey indicates an expected call of ByApiKey.
+func (mr *MockIApiKeyUsageMockRecorder) ByApiKey(ctx, apiKeyId, from, to any) *MockIApiKeyUsageByApiKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByApiKey", reflect.TypeOf((*MockIApiKeyUsage)(nil).ByApiKey), ctx, apiKeyId, from, to)
+	return &MockIApiKeyUsageByApiKeyCall{Call: call}
+}
+
+// MockIApiKeyUsageByApiKeyCall wrap *gomock.Call
+type MockIApiKeyUsageByApiKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiKeyUsageByApiKeyCall) Return(arg0 []storage.ApiKeyUsage, arg1 error) *MockIApiKeyUsageByApiKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiKeyUsageByApiKeyCall) Do(f func(context.Context, uint64, time.Time, time.Time) ([]storage.ApiKeyUsage, error)) *MockIApiKeyUsageByApiKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiKeyUsageByApiKeyCall) DoAndReturn(f func(context.Context, uint64, time.Time, time.Time) ([]storage.ApiKeyUsage, error)) *MockIApiKeyUsageByApiKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_tier.go
+//
+// Generated by this command:
+//
+//	mockgen -source=api_tier.go -destination=mock/api_tier.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiTier is a mock of IApiTier interface.
+type MockIApiTier struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiTierMockRecorder
+}
+
+// MockIApiTierMockRecorder is the mock recorder for MockIApiTier.
+type MockIApiTierMockRecorder struct {
+	mock *MockIApiTier
+}
+
+// NewMockIApiTier creates a new mock instance.
+func NewMockIApiTier(ctrl *gomock.Controller) *MockIApiTier {
+	mock := &MockIApiTier{ctrl: ctrl}
+	mock.recorder = &MockIApiTierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiTier) EXPECT() *MockIApiTierMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIApiTier) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ApiTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ApiTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIApiTierMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIApiTierCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIApiTier)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIApiTierCursorListCall{Call: call}
+}
+
+// MockIApiTierCursorListCall wrap *gomock.Call
+type MockIApiTierCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierCursorListCall) Return(arg0 []*storage.ApiTier, arg1 error) *MockIApiTierCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ApiTier, error)) *MockIApiTierCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ApiTier, error)) *MockIApiTierCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIApiTier) GetByID(ctx context.Context, id uint64) (*storage.ApiTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ApiTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIApiTierMockRecorder) GetByID(ctx, id any) *MockIApiTierGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIApiTier)(nil).GetByID), ctx, id)
+	return &MockIApiTierGetByIDCall{Call: call}
+}
+
+// MockIApiTierGetByIDCall wrap *gomock.Call
+type MockIApiTierGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierGetByIDCall) Return(arg0 *storage.ApiTier, arg1 error) *MockIApiTierGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierGetByIDCall) Do(f func(context.Context, uint64) (*storage.ApiTier, error)) *MockIApiTierGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ApiTier, error)) *MockIApiTierGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIApiTier) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIApiTierMockRecorder) IsNoRows(err any) *MockIApiTierIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIApiTier)(nil).IsNoRows), err)
+	return &MockIApiTierIsNoRowsCall{Call: call}
+}
+
+// MockIApiTierIsNoRowsCall wrap *gomock.Call
+type MockIApiTierIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierIsNoRowsCall) Return(arg0 bool) *MockIApiTierIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierIsNoRowsCall) Do(f func(error) bool) *MockIApiTierIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIApiTierIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIApiTier) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIApiTierMockRecorder) LastID(ctx any) *MockIApiTierLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIApiTier)(nil).LastID), ctx)
+	return &MockIApiTierLastIDCall{Call: call}
+}
+
+// MockIApiTierLastIDCall wrap *gomock.Call
+type MockIApiTierLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierLastIDCall) Return(arg0 uint64, arg1 error) *MockIApiTierLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIApiTierLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIApiTierLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIApiTier) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ApiTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ApiTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIApiTierMockRecorder) List(ctx, limit, offset, order any) *MockIApiTierListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIApiTier)(nil).List), ctx, limit, offset, order)
+	return &MockIApiTierListCall{Call: call}
+}
+
+// MockIApiTierListCall wrap *gomock.Call
+type MockIApiTierListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierListCall) Return(arg0 []*storage.ApiTier, arg1 error) *MockIApiTierListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ApiTier, error)) *MockIApiTierListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ApiTier, error)) *MockIApiTierListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIApiTier) Save(ctx context.Context, m *storage.ApiTier) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIApiTierMockRecorder) Save(ctx, m any) *MockIApiTierSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIApiTier)(nil).Save), ctx, m)
+	return &MockIApiTierSaveCall{Call: call}
+}
+
+// MockIApiTierSaveCall wrap *gomock.Call
+type MockIApiTierSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierSaveCall) Return(arg0 error) *MockIApiTierSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierSaveCall) Do(f func(context.Context, *storage.ApiTier) error) *MockIApiTierSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierSaveCall) DoAndReturn(f func(context.Context, *storage.ApiTier) error) *MockIApiTierSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIApiTier) Update(ctx context.Context, m *storage.ApiTier) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIApiTierMockRecorder) Update(ctx, m any) *MockIApiTierUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIApiTier)(nil).Update), ctx, m)
+	return &MockIApiTierUpdateCall{Call: call}
+}
+
+// MockIApiTierUpdateCall wrap *gomock.Call
+type MockIApiTierUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIApiTierUpdateCall) Return(arg0 error) *MockIApiTierUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIApiTierUpdateCall) Do(f func(context.Context, *storage.ApiTier) error) *MockIApiTierUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIApiTierUpdateCall) DoAndReturn(f func(context.Context, *storage.ApiTier) error) *MockIApiTierUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -44,6 +44,45 @@ func (m *MockIApp) EXPECT() *MockIAppMockRecorder {
 	return m.recorder
 }
 
+// Bridges mocks base method.
+func (m *MockIApp) Bridges(ctx context.Context, appId uint64) ([]storage.AppBridge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bridges", ctx, appId)
+	ret0, _ := ret[0].([]storage.AppBridge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bridges indicates an expected call of Bridges.
+func (mr *MockIAppMockRecorder) Bridges(ctx, appId any) *MockIAppBridgesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bridges", reflect.TypeOf((*MockIApp)(nil).Bridges), ctx, appId)
+	return &MockIAppBridgesCall{Call: call}
+}
+
+// MockIAppBridgesCall wrap *gomock.Call
+type MockIAppBridgesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAppBridgesCall) Return(arg0 []storage.AppBridge, arg1 error) *MockIAppBridgesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAppBridgesCall) Do(f func(context.Context, uint64) ([]storage.AppBridge, error)) *MockIAppBridgesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAppBridgesCall) DoAndReturn(f func(context.Context, uint64) ([]storage.AppBridge, error)) *MockIAppBridgesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByRollupId mocks base method.
 func (m *MockIApp) ByRollupId(ctx context.Context, rollupId uint64) (storage.AppWithStats, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +239,45 @@ func (c *MockIAppGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*stor
 	return c
 }
 
+// IdByRollupId mocks base method.
+func (m *MockIApp) IdByRollupId(ctx context.Context, rollupId uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdByRollupId", ctx, rollupId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IdByRollupId indicates an expected call of IdByRollupId.
+func (mr *MockIAppMockRecorder) IdByRollupId(ctx, rollupId any) *MockIAppIdByRollupIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdByRollupId", reflect.TypeOf((*MockIApp)(nil).IdByRollupId), ctx, rollupId)
+	return &MockIAppIdByRollupIdCall{Call: call}
+}
+
+// MockIAppIdByRollupIdCall wrap *gomock.Call
+type MockIAppIdByRollupIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAppIdByRollupIdCall) Return(arg0 uint64, arg1 error) *MockIAppIdByRollupIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAppIdByRollupIdCall) Do(f func(context.Context, uint64) (uint64, error)) *MockIAppIdByRollupIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAppIdByRollupIdCall) DoAndReturn(f func(context.Context, uint64) (uint64, error)) *MockIAppIdByRollupIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsNoRows mocks base method.
 func (m *MockIApp) IsNoRows(err error) bool {
 	m.ctrl.T.Helper()
@@ -355,6 +433,123 @@ func (c *MockIAppListCall) DoAndReturn(f func(context.Context, uint64, uint64, s
 	return c
 }
 
+// Report mocks base method.
+func (m *MockIApp) Report(ctx context.Context, rollupIds []uint64, fltrs storage.AppReportFilter) (storage.AppReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, rollupIds, fltrs)
+	ret0, _ := ret[0].(storage.AppReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockIAppMockRecorder) Report(ctx, rollupIds, fltrs any) *MockIAppReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockIApp)(nil).Report), ctx, rollupIds, fltrs)
+	return &MockIAppReportCall{Call: call}
+}
+
+// MockIAppReportCall wrap *gomock.Call
+type MockIAppReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAppReportCall) Return(arg0 storage.AppReport, arg1 error) *MockIAppReportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAppReportCall) Do(f func(context.Context, []uint64, storage.AppReportFilter) (storage.AppReport, error)) *MockIAppReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAppReportCall) DoAndReturn(f func(context.Context, []uint64, storage.AppReportFilter) (storage.AppReport, error)) *MockIAppReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReportSeries mocks base method.
+func (m *MockIApp) ReportSeries(ctx context.Context, rollupIds []uint64, req storage.SeriesRequest) ([]storage.AppReportItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportSeries", ctx, rollupIds, req)
+	ret0, _ := ret[0].([]storage.AppReportItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSeries indicates an expected call of ReportSeries.
+func (mr *MockIAppMockRecorder) ReportSeries(ctx, rollupIds, req any) *MockIAppReportSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSeries", reflect.TypeOf((*MockIApp)(nil).ReportSeries), ctx, rollupIds, req)
+	return &MockIAppReportSeriesCall{Call: call}
+}
+
+// MockIAppReportSeriesCall wrap *gomock.Call
+type MockIAppReportSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAppReportSeriesCall) Return(arg0 []storage.AppReportItem, arg1 error) *MockIAppReportSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAppReportSeriesCall) Do(f func(context.Context, []uint64, storage.SeriesRequest) ([]storage.AppReportItem, error)) *MockIAppReportSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAppReportSeriesCall) DoAndReturn(f func(context.Context, []uint64, storage.SeriesRequest) ([]storage.AppReportItem, error)) *MockIAppReportSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Rollups mocks base method.
+func (m *MockIApp) Rollups(ctx context.Context, appId uint64) ([]storage.AppRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollups", ctx, appId)
+	ret0, _ := ret[0].([]storage.AppRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollups indicates an expected call of Rollups.
+func (mr *MockIAppMockRecorder) Rollups(ctx, appId any) *MockIAppRollupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollups", reflect.TypeOf((*MockIApp)(nil).Rollups), ctx, appId)
+	return &MockIAppRollupsCall{Call: call}
+}
+
+// MockIAppRollupsCall wrap *gomock.Call
+type MockIAppRollupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAppRollupsCall) Return(arg0 []storage.AppRollup, arg1 error) *MockIAppRollupsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAppRollupsCall) Do(f func(context.Context, uint64) ([]storage.AppRollup, error)) *MockIAppRollupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAppRollupsCall) DoAndReturn(f func(context.Context, uint64) ([]storage.AppRollup, error)) *MockIAppRollupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIApp) Save(ctx context.Context, m *storage.App) error {
 	m_2.ctrl.T.Helper()
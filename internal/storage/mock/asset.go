@@ -44,6 +44,45 @@ func (m *MockIAsset) EXPECT() *MockIAssetMockRecorder {
 	return m.recorder
 }
 
+// ByDenom mocks base method.
+func (m *MockIAsset) ByDenom(ctx context.Context, denom string) (storage.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByDenom", ctx, denom)
+	ret0, _ := ret[0].(storage.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByDenom indicates an expected call of ByDenom.
+func (mr *MockIAssetMockRecorder) ByDenom(ctx, denom any) *MockIAssetByDenomCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByDenom", reflect.TypeOf((*MockIAsset)(nil).ByDenom), ctx, denom)
+	return &MockIAssetByDenomCall{Call: call}
+}
+
+// MockIAssetByDenomCall wrap *gomock.Call
+type MockIAssetByDenomCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAssetByDenomCall) Return(arg0 storage.Asset, arg1 error) *MockIAssetByDenomCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAssetByDenomCall) Do(f func(context.Context, string) (storage.Asset, error)) *MockIAssetByDenomCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAssetByDenomCall) DoAndReturn(f func(context.Context, string) (storage.Asset, error)) *MockIAssetByDenomCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // List mocks base method.
 func (m *MockIAsset) List(ctx context.Context, limit, offset int, sortBy string, order storage0.SortOrder) ([]storage.Asset, error) {
 	m.ctrl.T.Helper()
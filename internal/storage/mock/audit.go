@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit.go -destination=mock/audit.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAudit is a mock of IAudit interface.
+type MockIAudit struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditMockRecorder
+}
+
+// MockIAuditMockRecorder is the mock recorder for MockIAudit.
+type MockIAuditMockRecorder struct {
+	mock *MockIAudit
+}
+
+// NewMockIAudit creates a new mock instance.
+func NewMockIAudit(ctrl *gomock.Controller) *MockIAudit {
+	mock := &MockIAudit{ctrl: ctrl}
+	mock.recorder = &MockIAuditMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAudit) EXPECT() *MockIAuditMockRecorder {
+	return m.recorder
+}
+
+// AddressMismatches mocks base method.
+func (m *MockIAudit) AddressMismatches(ctx context.Context, limit int) ([]storage.AddressMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressMismatches", ctx, limit)
+	ret0, _ := ret[0].([]storage.AddressMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddressMismatches indicates an expected call of AddressMismatches.
+func (mr *MockIAuditMockRecorder) AddressMismatches(ctx, limit any) *MockIAuditAddressMismatchesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressMismatches", reflect.TypeOf((*MockIAudit)(nil).AddressMismatches), ctx, limit)
+	return &MockIAuditAddressMismatchesCall{Call: call}
+}
+
+// MockIAuditAddressMismatchesCall wrap *gomock.Call
+type MockIAuditAddressMismatchesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditAddressMismatchesCall) Return(arg0 []storage.AddressMismatch, arg1 error) *MockIAuditAddressMismatchesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditAddressMismatchesCall) Do(f func(context.Context, int) ([]storage.AddressMismatch, error)) *MockIAuditAddressMismatchesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditAddressMismatchesCall) DoAndReturn(f func(context.Context, int) ([]storage.AddressMismatch, error)) *MockIAuditAddressMismatchesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Addresses mocks base method.
+func (m *MockIAudit) Addresses(ctx context.Context, fromId uint64, limit int) ([]storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Addresses", ctx, fromId, limit)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Addresses indicates an expected call of Addresses.
+func (mr *MockIAuditMockRecorder) Addresses(ctx, fromId, limit any) *MockIAuditAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Addresses", reflect.TypeOf((*MockIAudit)(nil).Addresses), ctx, fromId, limit)
+	return &MockIAuditAddressesCall{Call: call}
+}
+
+// MockIAuditAddressesCall wrap *gomock.Call
+type MockIAuditAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditAddressesCall) Return(arg0 []storage.Address, arg1 error) *MockIAuditAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditAddressesCall) Do(f func(context.Context, uint64, int) ([]storage.Address, error)) *MockIAuditAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditAddressesCall) DoAndReturn(f func(context.Context, uint64, int) ([]storage.Address, error)) *MockIAuditAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BalanceMismatches mocks base method.
+func (m *MockIAudit) BalanceMismatches(ctx context.Context, limit int) ([]storage.BalanceMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceMismatches", ctx, limit)
+	ret0, _ := ret[0].([]storage.BalanceMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceMismatches indicates an expected call of BalanceMismatches.
+func (mr *MockIAuditMockRecorder) BalanceMismatches(ctx, limit any) *MockIAuditBalanceMismatchesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceMismatches", reflect.TypeOf((*MockIAudit)(nil).BalanceMismatches), ctx, limit)
+	return &MockIAuditBalanceMismatchesCall{Call: call}
+}
+
+// MockIAuditBalanceMismatchesCall wrap *gomock.Call
+type MockIAuditBalanceMismatchesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditBalanceMismatchesCall) Return(arg0 []storage.BalanceMismatch, arg1 error) *MockIAuditBalanceMismatchesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditBalanceMismatchesCall) Do(f func(context.Context, int) ([]storage.BalanceMismatch, error)) *MockIAuditBalanceMismatchesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditBalanceMismatchesCall) DoAndReturn(f func(context.Context, int) ([]storage.BalanceMismatch, error)) *MockIAuditBalanceMismatchesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExpectedState mocks base method.
+func (m *MockIAudit) ExpectedState(ctx context.Context, name string) (storage.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpectedState", ctx, name)
+	ret0, _ := ret[0].(storage.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpectedState indicates an expected call of ExpectedState.
+func (mr *MockIAuditMockRecorder) ExpectedState(ctx, name any) *MockIAuditExpectedStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpectedState", reflect.TypeOf((*MockIAudit)(nil).ExpectedState), ctx, name)
+	return &MockIAuditExpectedStateCall{Call: call}
+}
+
+// MockIAuditExpectedStateCall wrap *gomock.Call
+type MockIAuditExpectedStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditExpectedStateCall) Return(arg0 storage.State, arg1 error) *MockIAuditExpectedStateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditExpectedStateCall) Do(f func(context.Context, string) (storage.State, error)) *MockIAuditExpectedStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditExpectedStateCall) DoAndReturn(f func(context.Context, string) (storage.State, error)) *MockIAuditExpectedStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollupMismatches mocks base method.
+func (m *MockIAudit) RollupMismatches(ctx context.Context, limit int) ([]storage.RollupMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupMismatches", ctx, limit)
+	ret0, _ := ret[0].([]storage.RollupMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollupMismatches indicates an expected call of RollupMismatches.
+func (mr *MockIAuditMockRecorder) RollupMismatches(ctx, limit any) *MockIAuditRollupMismatchesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupMismatches", reflect.TypeOf((*MockIAudit)(nil).RollupMismatches), ctx, limit)
+	return &MockIAuditRollupMismatchesCall{Call: call}
+}
+
+// MockIAuditRollupMismatchesCall wrap *gomock.Call
+type MockIAuditRollupMismatchesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditRollupMismatchesCall) Return(arg0 []storage.RollupMismatch, arg1 error) *MockIAuditRollupMismatchesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditRollupMismatchesCall) Do(f func(context.Context, int) ([]storage.RollupMismatch, error)) *MockIAuditRollupMismatchesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditRollupMismatchesCall) DoAndReturn(f func(context.Context, int) ([]storage.RollupMismatch, error)) *MockIAuditRollupMismatchesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
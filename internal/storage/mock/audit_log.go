@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_log.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit_log.go -destination=mock/audit_log.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAuditLog is a mock of IAuditLog interface.
+type MockIAuditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditLogMockRecorder
+}
+
+// MockIAuditLogMockRecorder is the mock recorder for MockIAuditLog.
+type MockIAuditLogMockRecorder struct {
+	mock *MockIAuditLog
+}
+
+// NewMockIAuditLog creates a new mock instance.
+func NewMockIAuditLog(ctrl *gomock.Controller) *MockIAuditLog {
+	mock := &MockIAuditLog{ctrl: ctrl}
+	mock.recorder = &MockIAuditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuditLog) EXPECT() *MockIAuditLogMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIAuditLog) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIAuditLogMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIAuditLogCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIAuditLog)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIAuditLogCursorListCall{Call: call}
+}
+
+// MockIAuditLogCursorListCall wrap *gomock.Call
+type MockIAuditLogCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogCursorListCall) Return(arg0 []*storage.AuditLog, arg1 error) *MockIAuditLogCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.AuditLog, error)) *MockIAuditLogCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.AuditLog, error)) *MockIAuditLogCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIAuditLog) Filter(ctx context.Context, fltrs storage.AuditLogFilter) ([]storage.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIAuditLogMockRecorder) Filter(ctx, fltrs any) *MockIAuditLogFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIAuditLog)(nil).Filter), ctx, fltrs)
+	return &MockIAuditLogFilterCall{Call: call}
+}
+
+// MockIAuditLogFilterCall wrap *gomock.Call
+type MockIAuditLogFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogFilterCall) Return(arg0 []storage.AuditLog, arg1 error) *MockIAuditLogFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogFilterCall) Do(f func(context.Context, storage.AuditLogFilter) ([]storage.AuditLog, error)) *MockIAuditLogFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogFilterCall) DoAndReturn(f func(context.Context, storage.AuditLogFilter) ([]storage.AuditLog, error)) *MockIAuditLogFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIAuditLog) GetByID(ctx context.Context, id uint64) (*storage.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIAuditLogMockRecorder) GetByID(ctx, id any) *MockIAuditLogGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIAuditLog)(nil).GetByID), ctx, id)
+	return &MockIAuditLogGetByIDCall{Call: call}
+}
+
+// MockIAuditLogGetByIDCall wrap *gomock.Call
+type MockIAuditLogGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogGetByIDCall) Return(arg0 *storage.AuditLog, arg1 error) *MockIAuditLogGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogGetByIDCall) Do(f func(context.Context, uint64) (*storage.AuditLog, error)) *MockIAuditLogGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.AuditLog, error)) *MockIAuditLogGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIAuditLog) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIAuditLogMockRecorder) IsNoRows(err any) *MockIAuditLogIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIAuditLog)(nil).IsNoRows), err)
+	return &MockIAuditLogIsNoRowsCall{Call: call}
+}
+
+// MockIAuditLogIsNoRowsCall wrap *gomock.Call
+type MockIAuditLogIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogIsNoRowsCall) Return(arg0 bool) *MockIAuditLogIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogIsNoRowsCall) Do(f func(error) bool) *MockIAuditLogIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIAuditLogIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIAuditLog) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIAuditLogMockRecorder) LastID(ctx any) *MockIAuditLogLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIAuditLog)(nil).LastID), ctx)
+	return &MockIAuditLogLastIDCall{Call: call}
+}
+
+// MockIAuditLogLastIDCall wrap *gomock.Call
+type MockIAuditLogLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogLastIDCall) Return(arg0 uint64, arg1 error) *MockIAuditLogLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIAuditLogLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIAuditLogLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIAuditLog) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAuditLogMockRecorder) List(ctx, limit, offset, order any) *MockIAuditLogListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAuditLog)(nil).List), ctx, limit, offset, order)
+	return &MockIAuditLogListCall{Call: call}
+}
+
+// MockIAuditLogListCall wrap *gomock.Call
+type MockIAuditLogListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogListCall) Return(arg0 []*storage.AuditLog, arg1 error) *MockIAuditLogListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.AuditLog, error)) *MockIAuditLogListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.AuditLog, error)) *MockIAuditLogListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIAuditLog) Save(ctx context.Context, m *storage.AuditLog) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIAuditLogMockRecorder) Save(ctx, m any) *MockIAuditLogSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAuditLog)(nil).Save), ctx, m)
+	return &MockIAuditLogSaveCall{Call: call}
+}
+
+// MockIAuditLogSaveCall wrap *gomock.Call
+type MockIAuditLogSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogSaveCall) Return(arg0 error) *MockIAuditLogSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogSaveCall) Do(f func(context.Context, *storage.AuditLog) error) *MockIAuditLogSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogSaveCall) DoAndReturn(f func(context.Context, *storage.AuditLog) error) *MockIAuditLogSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIAuditLog) Update(ctx context.Context, m *storage.AuditLog) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAuditLogMockRecorder) Update(ctx, m any) *MockIAuditLogUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAuditLog)(nil).Update), ctx, m)
+	return &MockIAuditLogUpdateCall{Call: call}
+}
+
+// MockIAuditLogUpdateCall wrap *gomock.Call
+type MockIAuditLogUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIAuditLogUpdateCall) Return(arg0 error) *MockIAuditLogUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIAuditLogUpdateCall) Do(f func(context.Context, *storage.AuditLog) error) *MockIAuditLogUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIAuditLogUpdateCall) DoAndReturn(f func(context.Context, *storage.AuditLog) error) *MockIAuditLogUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
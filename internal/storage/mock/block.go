@@ -123,6 +123,45 @@ func (c *MockIBlockByHeightCall) DoAndReturn(f func(context.Context, types.Level
 	return c
 }
 
+// ByHeights mocks base method.
+func (m *MockIBlock) ByHeights(ctx context.Context, heights []types.Level, withStats bool) ([]storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeights", ctx, heights, withStats)
+	ret0, _ := ret[0].([]storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeights indicates an expected call of ByHeights.
+func (mr *MockIBlockMockRecorder) ByHeights(ctx, heights, withStats any) *MockIBlockByHeightsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeights", reflect.TypeOf((*MockIBlock)(nil).ByHeights), ctx, heights, withStats)
+	return &MockIBlockByHeightsCall{Call: call}
+}
+
+// MockIBlockByHeightsCall wrap *gomock.Call
+type MockIBlockByHeightsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockByHeightsCall) Return(arg0 []storage.Block, arg1 error) *MockIBlockByHeightsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockByHeightsCall) Do(f func(context.Context, []types.Level, bool) ([]storage.Block, error)) *MockIBlockByHeightsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockByHeightsCall) DoAndReturn(f func(context.Context, []types.Level, bool) ([]storage.Block, error)) *MockIBlockByHeightsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByIdWithRelations mocks base method.
 func (m *MockIBlock) ByIdWithRelations(ctx context.Context, id uint64) (storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +279,45 @@ func (c *MockIBlockCursorListCall) DoAndReturn(f func(context.Context, uint64, u
 	return c
 }
 
+// Filter mocks base method.
+func (m *MockIBlock) Filter(ctx context.Context, fltrs storage.BlockListFilter) ([]*storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, fltrs)
+	ret0, _ := ret[0].([]*storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIBlockMockRecorder) Filter(ctx, fltrs any) *MockIBlockFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIBlock)(nil).Filter), ctx, fltrs)
+	return &MockIBlockFilterCall{Call: call}
+}
+
+// MockIBlockFilterCall wrap *gomock.Call
+type MockIBlockFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIBlockFilterCall) Return(arg0 []*storage.Block, arg1 error) *MockIBlockFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIBlockFilterCall) Do(f func(context.Context, storage.BlockListFilter) ([]*storage.Block, error)) *MockIBlockFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIBlockFilterCall) DoAndReturn(f func(context.Context, storage.BlockListFilter) ([]*storage.Block, error)) *MockIBlockFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByID mocks base method.
 func (m *MockIBlock) GetByID(ctx context.Context, id uint64) (*storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -18,6 +18,7 @@ import (
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
 	types "github.com/celenium-io/astria-indexer/internal/storage/types"
+	types0 "github.com/celenium-io/astria-indexer/pkg/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -83,6 +84,45 @@ func (c *MockIConstantAllCall) DoAndReturn(f func(context.Context) ([]storage.Co
 	return c
 }
 
+// AtHeight mocks base method.
+func (m *MockIConstant) AtHeight(ctx context.Context, height types0.Level) ([]storage.Constant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AtHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Constant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AtHeight indicates an expected call of AtHeight.
+func (mr *MockIConstantMockRecorder) AtHeight(ctx, height any) *MockIConstantAtHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AtHeight", reflect.TypeOf((*MockIConstant)(nil).AtHeight), ctx, height)
+	return &MockIConstantAtHeightCall{Call: call}
+}
+
+// MockIConstantAtHeightCall wrap *gomock.Call
+type MockIConstantAtHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantAtHeightCall) Return(arg0 []storage.Constant, arg1 error) *MockIConstantAtHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantAtHeightCall) Do(f func(context.Context, types0.Level) ([]storage.Constant, error)) *MockIConstantAtHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantAtHeightCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.Constant, error)) *MockIConstantAtHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByModule mocks base method.
 func (m *MockIConstant) ByModule(ctx context.Context, module types.ModuleName) ([]storage.Constant, error) {
 	m.ctrl.T.Helper()
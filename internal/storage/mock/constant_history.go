@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: constant_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=constant_history.go -destination=mock/constant_history.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/internal/storage/types"
+	types0 "github.com/celenium-io/astria-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIConstantHistory is a mock of IConstantHistory interface.
+type MockIConstantHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockIConstantHistoryMockRecorder
+}
+
+// MockIConstantHistoryMockRecorder is the mock recorder for MockIConstantHistory.
+type MockIConstantHistoryMockRecorder struct {
+	mock *MockIConstantHistory
+}
+
+// NewMockIConstantHistory creates a new mock instance.
+func NewMockIConstantHistory(ctrl *gomock.Controller) *MockIConstantHistory {
+	mock := &MockIConstantHistory{ctrl: ctrl}
+	mock.recorder = &MockIConstantHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIConstantHistory) EXPECT() *MockIConstantHistoryMockRecorder {
+	return m.recorder
+}
+
+// ByHeight mocks base method.
+func (m *MockIConstantHistory) ByHeight(ctx context.Context, height types0.Level) ([]storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIConstantHistoryMockRecorder) ByHeight(ctx, height any) *MockIConstantHistoryByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIConstantHistory)(nil).ByHeight), ctx, height)
+	return &MockIConstantHistoryByHeightCall{Call: call}
+}
+
+// MockIConstantHistoryByHeightCall wrap *gomock.Call
+type MockIConstantHistoryByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryByHeightCall) Return(arg0 []storage.ConstantHistory, arg1 error) *MockIConstantHistoryByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryByHeightCall) Do(f func(context.Context, types0.Level) ([]storage.ConstantHistory, error)) *MockIConstantHistoryByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryByHeightCall) DoAndReturn(f func(context.Context, types0.Level) ([]storage.ConstantHistory, error)) *MockIConstantHistoryByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByName mocks base method.
+func (m *MockIConstantHistory) ByName(ctx context.Context, module types.ModuleName, name string, limit, offset int, sort storage0.SortOrder) ([]storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByName", ctx, module, name, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByName indicates an expected call of ByName.
+func (mr *MockIConstantHistoryMockRecorder) ByName(ctx, module, name, limit, offset, sort any) *MockIConstantHistoryByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByName", reflect.TypeOf((*MockIConstantHistory)(nil).ByName), ctx, module, name, limit, offset, sort)
+	return &MockIConstantHistoryByNameCall{Call: call}
+}
+
+// MockIConstantHistoryByNameCall wrap *gomock.Call
+type MockIConstantHistoryByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryByNameCall) Return(arg0 []storage.ConstantHistory, arg1 error) *MockIConstantHistoryByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryByNameCall) Do(f func(context.Context, types.ModuleName, string, int, int, storage0.SortOrder) ([]storage.ConstantHistory, error)) *MockIConstantHistoryByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryByNameCall) DoAndReturn(f func(context.Context, types.ModuleName, string, int, int, storage0.SortOrder) ([]storage.ConstantHistory, error)) *MockIConstantHistoryByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIConstantHistory) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIConstantHistoryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIConstantHistoryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIConstantHistory)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIConstantHistoryCursorListCall{Call: call}
+}
+
+// MockIConstantHistoryCursorListCall wrap *gomock.Call
+type MockIConstantHistoryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryCursorListCall) Return(arg0 []*storage.ConstantHistory, arg1 error) *MockIConstantHistoryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ConstantHistory, error)) *MockIConstantHistoryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.ConstantHistory, error)) *MockIConstantHistoryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIConstantHistory) GetByID(ctx context.Context, id uint64) (*storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIConstantHistoryMockRecorder) GetByID(ctx, id any) *MockIConstantHistoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIConstantHistory)(nil).GetByID), ctx, id)
+	return &MockIConstantHistoryGetByIDCall{Call: call}
+}
+
+// MockIConstantHistoryGetByIDCall wrap *gomock.Call
+type MockIConstantHistoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryGetByIDCall) Return(arg0 *storage.ConstantHistory, arg1 error) *MockIConstantHistoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryGetByIDCall) Do(f func(context.Context, uint64) (*storage.ConstantHistory, error)) *MockIConstantHistoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.ConstantHistory, error)) *MockIConstantHistoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIConstantHistory) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIConstantHistoryMockRecorder) IsNoRows(err any) *MockIConstantHistoryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIConstantHistory)(nil).IsNoRows), err)
+	return &MockIConstantHistoryIsNoRowsCall{Call: call}
+}
+
+// MockIConstantHistoryIsNoRowsCall wrap *gomock.Call
+type MockIConstantHistoryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryIsNoRowsCall) Return(arg0 bool) *MockIConstantHistoryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryIsNoRowsCall) Do(f func(error) bool) *MockIConstantHistoryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIConstantHistoryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIConstantHistory) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIConstantHistoryMockRecorder) LastID(ctx any) *MockIConstantHistoryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIConstantHistory)(nil).LastID), ctx)
+	return &MockIConstantHistoryLastIDCall{Call: call}
+}
+
+// MockIConstantHistoryLastIDCall wrap *gomock.Call
+type MockIConstantHistoryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryLastIDCall) Return(arg0 uint64, arg1 error) *MockIConstantHistoryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIConstantHistoryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIConstantHistoryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIConstantHistory) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIConstantHistoryMockRecorder) List(ctx, limit, offset, order any) *MockIConstantHistoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIConstantHistory)(nil).List), ctx, limit, offset, order)
+	return &MockIConstantHistoryListCall{Call: call}
+}
+
+// MockIConstantHistoryListCall wrap *gomock.Call
+type MockIConstantHistoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryListCall) Return(arg0 []*storage.ConstantHistory, arg1 error) *MockIConstantHistoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ConstantHistory, error)) *MockIConstantHistoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.ConstantHistory, error)) *MockIConstantHistoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIConstantHistory) Save(ctx context.Context, m *storage.ConstantHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIConstantHistoryMockRecorder) Save(ctx, m any) *MockIConstantHistorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIConstantHistory)(nil).Save), ctx, m)
+	return &MockIConstantHistorySaveCall{Call: call}
+}
+
+// MockIConstantHistorySaveCall wrap *gomock.Call
+type MockIConstantHistorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistorySaveCall) Return(arg0 error) *MockIConstantHistorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistorySaveCall) Do(f func(context.Context, *storage.ConstantHistory) error) *MockIConstantHistorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistorySaveCall) DoAndReturn(f func(context.Context, *storage.ConstantHistory) error) *MockIConstantHistorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIConstantHistory) Update(ctx context.Context, m *storage.ConstantHistory) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIConstantHistoryMockRecorder) Update(ctx, m any) *MockIConstantHistoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIConstantHistory)(nil).Update), ctx, m)
+	return &MockIConstantHistoryUpdateCall{Call: call}
+}
+
+// MockIConstantHistoryUpdateCall wrap *gomock.Call
+type MockIConstantHistoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIConstantHistoryUpdateCall) Return(arg0 error) *MockIConstantHistoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIConstantHistoryUpdateCall) Do(f func(context.Context, *storage.ConstantHistory) error) *MockIConstantHistoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIConstantHistoryUpdateCall) DoAndReturn(f func(context.Context, *storage.ConstantHistory) error) *MockIConstantHistoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
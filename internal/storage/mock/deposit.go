@@ -17,6 +17,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -83,6 +84,84 @@ func (c *MockIDepositByBridgeIdCall) DoAndReturn(f func(context.Context, uint64,
 	return c
 }
 
+// ByDestination mocks base method.
+func (m *MockIDeposit) ByDestination(ctx context.Context, rollupId uint64, destination string, limit, offset int, sort storage0.SortOrder) ([]storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByDestination", ctx, rollupId, destination, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByDestination indicates an expected call of ByDestination.
+func (mr *MockIDepositMockRecorder) ByDestination(ctx, rollupId, destination, limit, offset, sort any) *MockIDepositByDestinationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByDestination", reflect.TypeOf((*MockIDeposit)(nil).ByDestination), ctx, rollupId, destination, limit, offset, sort)
+	return &MockIDepositByDestinationCall{Call: call}
+}
+
+// MockIDepositByDestinationCall wrap *gomock.Call
+type MockIDepositByDestinationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDepositByDestinationCall) Return(arg0 []storage.Deposit, arg1 error) *MockIDepositByDestinationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDepositByDestinationCall) Do(f func(context.Context, uint64, string, int, int, storage0.SortOrder) ([]storage.Deposit, error)) *MockIDepositByDestinationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDepositByDestinationCall) DoAndReturn(f func(context.Context, uint64, string, int, int, storage0.SortOrder) ([]storage.Deposit, error)) *MockIDepositByDestinationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ByHeight mocks base method.
+func (m *MockIDeposit) ByHeight(ctx context.Context, height types.Level) ([]storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIDepositMockRecorder) ByHeight(ctx, height any) *MockIDepositByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIDeposit)(nil).ByHeight), ctx, height)
+	return &MockIDepositByHeightCall{Call: call}
+}
+
+// MockIDepositByHeightCall wrap *gomock.Call
+type MockIDepositByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDepositByHeightCall) Return(arg0 []storage.Deposit, arg1 error) *MockIDepositByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDepositByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Deposit, error)) *MockIDepositByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDepositByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Deposit, error)) *MockIDepositByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByRollupId mocks base method.
 func (m *MockIDeposit) ByRollupId(ctx context.Context, rollupId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.Deposit, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +433,45 @@ func (c *MockIDepositSaveCall) DoAndReturn(f func(context.Context, *storage.Depo
 	return c
 }
 
+// TotalsByDestination mocks base method.
+func (m *MockIDeposit) TotalsByDestination(ctx context.Context, rollupId uint64, destination string) ([]storage.AssetTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalsByDestination", ctx, rollupId, destination)
+	ret0, _ := ret[0].([]storage.AssetTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalsByDestination indicates an expected call of TotalsByDestination.
+func (mr *MockIDepositMockRecorder) TotalsByDestination(ctx, rollupId, destination any) *MockIDepositTotalsByDestinationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalsByDestination", reflect.TypeOf((*MockIDeposit)(nil).TotalsByDestination), ctx, rollupId, destination)
+	return &MockIDepositTotalsByDestinationCall{Call: call}
+}
+
+// MockIDepositTotalsByDestinationCall wrap *gomock.Call
+type MockIDepositTotalsByDestinationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIDepositTotalsByDestinationCall) Return(arg0 []storage.AssetTotal, arg1 error) *MockIDepositTotalsByDestinationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIDepositTotalsByDestinationCall) Do(f func(context.Context, uint64, string) ([]storage.AssetTotal, error)) *MockIDepositTotalsByDestinationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIDepositTotalsByDestinationCall) DoAndReturn(f func(context.Context, uint64, string) ([]storage.AssetTotal, error)) *MockIDepositTotalsByDestinationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIDeposit) Update(ctx context.Context, m *storage.Deposit) error {
 	m_2.ctrl.T.Helper()
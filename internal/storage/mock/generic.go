@@ -123,6 +123,44 @@ func (c *MockTransactionBulkSaveCall) DoAndReturn(f func(context.Context, []any)
 	return c
 }
 
+// CancelWebhookDeliveries mocks base method.
+func (m *MockTransaction) CancelWebhookDeliveries(ctx context.Context, fromHeight types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelWebhookDeliveries", ctx, fromHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelWebhookDeliveries indicates an expected call of CancelWebhookDeliveries.
+func (mr *MockTransactionMockRecorder) CancelWebhookDeliveries(ctx, fromHeight any) *MockTransactionCancelWebhookDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelWebhookDeliveries", reflect.TypeOf((*MockTransaction)(nil).CancelWebhookDeliveries), ctx, fromHeight)
+	return &MockTransactionCancelWebhookDeliveriesCall{Call: call}
+}
+
+// MockTransactionCancelWebhookDeliveriesCall wrap *gomock.Call
+type MockTransactionCancelWebhookDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionCancelWebhookDeliveriesCall) Return(arg0 error) *MockTransactionCancelWebhookDeliveriesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionCancelWebhookDeliveriesCall) Do(f func(context.Context, types.Level) error) *MockTransactionCancelWebhookDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionCancelWebhookDeliveriesCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionCancelWebhookDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockTransaction) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -199,6 +237,82 @@ func (c *MockTransactionCopyFromCall) DoAndReturn(f func(context.Context, string
 	return c
 }
 
+// DeleteApiKey mocks base method.
+func (m *MockTransaction) DeleteApiKey(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApiKey indicates an expected call of DeleteApiKey.
+func (mr *MockTransactionMockRecorder) DeleteApiKey(ctx, id any) *MockTransactionDeleteApiKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiKey", reflect.TypeOf((*MockTransaction)(nil).DeleteApiKey), ctx, id)
+	return &MockTransactionDeleteApiKeyCall{Call: call}
+}
+
+// MockTransactionDeleteApiKeyCall wrap *gomock.Call
+type MockTransactionDeleteApiKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteApiKeyCall) Return(arg0 error) *MockTransactionDeleteApiKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteApiKeyCall) Do(f func(context.Context, uint64) error) *MockTransactionDeleteApiKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteApiKeyCall) DoAndReturn(f func(context.Context, uint64) error) *MockTransactionDeleteApiKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteApiTier mocks base method.
+func (m *MockTransaction) DeleteApiTier(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiTier", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApiTier indicates an expected call of DeleteApiTier.
+func (mr *MockTransactionMockRecorder) DeleteApiTier(ctx, id any) *MockTransactionDeleteApiTierCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiTier", reflect.TypeOf((*MockTransaction)(nil).DeleteApiTier), ctx, id)
+	return &MockTransactionDeleteApiTierCall{Call: call}
+}
+
+// MockTransactionDeleteApiTierCall wrap *gomock.Call
+type MockTransactionDeleteApiTierCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteApiTierCall) Return(arg0 error) *MockTransactionDeleteApiTierCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteApiTierCall) Do(f func(context.Context, uint64) error) *MockTransactionDeleteApiTierCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteApiTierCall) DoAndReturn(f func(context.Context, uint64) error) *MockTransactionDeleteApiTierCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteApp mocks base method.
 func (m *MockTransaction) DeleteApp(ctx context.Context, appId uint64) error {
 	m.ctrl.T.Helper()
@@ -237,6 +351,163 @@ func (c *MockTransactionDeleteAppCall) DoAndReturn(f func(context.Context, uint6
 	return c
 }
 
+// DeleteAppBridge mocks base method.
+func (m *MockTransaction) DeleteAppBridge(ctx context.Context, appId, bridgeId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAppBridge", ctx, appId, bridgeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAppBridge indicates an expected call of DeleteAppBridge.
+func (mr *MockTransactionMockRecorder) DeleteAppBridge(ctx, appId, bridgeId any) *MockTransactionDeleteAppBridgeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppBridge", reflect.TypeOf((*MockTransaction)(nil).DeleteAppBridge), ctx, appId, bridgeId)
+	return &MockTransactionDeleteAppBridgeCall{Call: call}
+}
+
+// MockTransactionDeleteAppBridgeCall wrap *gomock.Call
+type MockTransactionDeleteAppBridgeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteAppBridgeCall) Return(arg0 error) *MockTransactionDeleteAppBridgeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteAppBridgeCall) Do(f func(context.Context, uint64, uint64) error) *MockTransactionDeleteAppBridgeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteAppBridgeCall) DoAndReturn(f func(context.Context, uint64, uint64) error) *MockTransactionDeleteAppBridgeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAppRollup mocks base method.
+func (m *MockTransaction) DeleteAppRollup(ctx context.Context, appId, rollupId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAppRollup", ctx, appId, rollupId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAppRollup indicates an expected call of DeleteAppRollup.
+func (mr *MockTransactionMockRecorder) DeleteAppRollup(ctx, appId, rollupId any) *MockTransactionDeleteAppRollupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppRollup", reflect.TypeOf((*MockTransaction)(nil).DeleteAppRollup), ctx, appId, rollupId)
+	return &MockTransactionDeleteAppRollupCall{Call: call}
+}
+
+// MockTransactionDeleteAppRollupCall wrap *gomock.Call
+type MockTransactionDeleteAppRollupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteAppRollupCall) Return(arg0 error) *MockTransactionDeleteAppRollupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteAppRollupCall) Do(f func(context.Context, uint64, uint64) error) *MockTransactionDeleteAppRollupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteAppRollupCall) DoAndReturn(f func(context.Context, uint64, uint64) error) *MockTransactionDeleteAppRollupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteConstants mocks base method.
+func (m *MockTransaction) DeleteConstants(ctx context.Context, constants ...*storage.Constant) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range constants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConstants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConstants indicates an expected call of DeleteConstants.
+func (mr *MockTransactionMockRecorder) DeleteConstants(ctx any, constants ...any) *MockTransactionDeleteConstantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, constants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConstants", reflect.TypeOf((*MockTransaction)(nil).DeleteConstants), varargs...)
+	return &MockTransactionDeleteConstantsCall{Call: call}
+}
+
+// MockTransactionDeleteConstantsCall wrap *gomock.Call
+type MockTransactionDeleteConstantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteConstantsCall) Return(arg0 error) *MockTransactionDeleteConstantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteConstantsCall) Do(f func(context.Context, ...*storage.Constant) error) *MockTransactionDeleteConstantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteConstantsCall) DoAndReturn(f func(context.Context, ...*storage.Constant) error) *MockTransactionDeleteConstantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockTransaction) DeleteWebhook(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockTransactionMockRecorder) DeleteWebhook(ctx, id any) *MockTransactionDeleteWebhookCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockTransaction)(nil).DeleteWebhook), ctx, id)
+	return &MockTransactionDeleteWebhookCall{Call: call}
+}
+
+// MockTransactionDeleteWebhookCall wrap *gomock.Call
+type MockTransactionDeleteWebhookCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionDeleteWebhookCall) Return(arg0 error) *MockTransactionDeleteWebhookCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionDeleteWebhookCall) Do(f func(context.Context, uint64) error) *MockTransactionDeleteWebhookCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionDeleteWebhookCall) DoAndReturn(f func(context.Context, uint64) error) *MockTransactionDeleteWebhookCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Exec mocks base method.
 func (m *MockTransaction) Exec(ctx context.Context, query string, params ...any) (int64, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +629,45 @@ func (c *MockTransactionGetAddressIdCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// GetApp mocks base method.
+func (m *MockTransaction) GetApp(ctx context.Context, id uint64) (storage.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApp", ctx, id)
+	ret0, _ := ret[0].(storage.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApp indicates an expected call of GetApp.
+func (mr *MockTransactionMockRecorder) GetApp(ctx, id any) *MockTransactionGetAppCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApp", reflect.TypeOf((*MockTransaction)(nil).GetApp), ctx, id)
+	return &MockTransactionGetAppCall{Call: call}
+}
+
+// MockTransactionGetAppCall wrap *gomock.Call
+type MockTransactionGetAppCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionGetAppCall) Return(arg0 storage.App, arg1 error) *MockTransactionGetAppCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionGetAppCall) Do(f func(context.Context, uint64) (storage.App, error)) *MockTransactionGetAppCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionGetAppCall) DoAndReturn(f func(context.Context, uint64) (storage.App, error)) *MockTransactionGetAppCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetBridgeIdByAddressId mocks base method.
 func (m *MockTransaction) GetBridgeIdByAddressId(ctx context.Context, id uint64) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +707,50 @@ func (c *MockTransactionGetBridgeIdByAddressIdCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetConstants mocks base method.
+func (m *MockTransaction) GetConstants(ctx context.Context, constants ...*storage.Constant) ([]storage.Constant, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range constants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConstants", varargs...)
+	ret0, _ := ret[0].([]storage.Constant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConstants indicates an expected call of GetConstants.
+func (mr *MockTransactionMockRecorder) GetConstants(ctx any, constants ...any) *MockTransactionGetConstantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, constants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConstants", reflect.TypeOf((*MockTransaction)(nil).GetConstants), varargs...)
+	return &MockTransactionGetConstantsCall{Call: call}
+}
+
+// MockTransactionGetConstantsCall wrap *gomock.Call
+type MockTransactionGetConstantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionGetConstantsCall) Return(arg0 []storage.Constant, arg1 error) *MockTransactionGetConstantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionGetConstantsCall) Do(f func(context.Context, ...*storage.Constant) ([]storage.Constant, error)) *MockTransactionGetConstantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionGetConstantsCall) DoAndReturn(f func(context.Context, ...*storage.Constant) ([]storage.Constant, error)) *MockTransactionGetConstantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProposerId mocks base method.
 func (m *MockTransaction) GetProposerId(ctx context.Context, address string) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -561,32 +915,271 @@ func (m *MockTransaction) LastNonce(ctx context.Context, id uint64) (uint32, err
 	return ret0, ret1
 }
 
-// LastNonce indicates an expected call of LastNonce.
-func (mr *MockTransactionMockRecorder) LastNonce(ctx, id any) *MockTransactionLastNonceCall {
+// LastNonce indicates an expected call of LastNonce.
+func (mr *MockTransactionMockRecorder) LastNonce(ctx, id any) *MockTransactionLastNonceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastNonce", reflect.TypeOf((*MockTransaction)(nil).LastNonce), ctx, id)
+	return &MockTransactionLastNonceCall{Call: call}
+}
+
+// MockTransactionLastNonceCall wrap *gomock.Call
+type MockTransactionLastNonceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionLastNonceCall) Return(arg0 uint32, arg1 error) *MockTransactionLastNonceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionLastNonceCall) Do(f func(context.Context, uint64) (uint32, error)) *MockTransactionLastNonceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionLastNonceCall) DoAndReturn(f func(context.Context, uint64) (uint32, error)) *MockTransactionLastNonceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LockWebhookState mocks base method.
+func (m *MockTransaction) LockWebhookState(ctx context.Context, name string) (*storage.WebhookState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockWebhookState", ctx, name)
+	ret0, _ := ret[0].(*storage.WebhookState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockWebhookState indicates an expected call of LockWebhookState.
+func (mr *MockTransactionMockRecorder) LockWebhookState(ctx, name any) *MockTransactionLockWebhookStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockWebhookState", reflect.TypeOf((*MockTransaction)(nil).LockWebhookState), ctx, name)
+	return &MockTransactionLockWebhookStateCall{Call: call}
+}
+
+// MockTransactionLockWebhookStateCall wrap *gomock.Call
+type MockTransactionLockWebhookStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionLockWebhookStateCall) Return(arg0 *storage.WebhookState, arg1 error) *MockTransactionLockWebhookStateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionLockWebhookStateCall) Do(f func(context.Context, string) (*storage.WebhookState, error)) *MockTransactionLockWebhookStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionLockWebhookStateCall) DoAndReturn(f func(context.Context, string) (*storage.WebhookState, error)) *MockTransactionLockWebhookStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecalculateAddresses mocks base method.
+func (m *MockTransaction) RecalculateAddresses(ctx context.Context, fromHeight types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecalculateAddresses", ctx, fromHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecalculateAddresses indicates an expected call of RecalculateAddresses.
+func (mr *MockTransactionMockRecorder) RecalculateAddresses(ctx, fromHeight any) *MockTransactionRecalculateAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateAddresses", reflect.TypeOf((*MockTransaction)(nil).RecalculateAddresses), ctx, fromHeight)
+	return &MockTransactionRecalculateAddressesCall{Call: call}
+}
+
+// MockTransactionRecalculateAddressesCall wrap *gomock.Call
+type MockTransactionRecalculateAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRecalculateAddressesCall) Return(arg0 error) *MockTransactionRecalculateAddressesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRecalculateAddressesCall) Do(f func(context.Context, types.Level) error) *MockTransactionRecalculateAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRecalculateAddressesCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRecalculateAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecalculateAddressesById mocks base method.
+func (m *MockTransaction) RecalculateAddressesById(ctx context.Context, ids ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecalculateAddressesById", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecalculateAddressesById indicates an expected call of RecalculateAddressesById.
+func (mr *MockTransactionMockRecorder) RecalculateAddressesById(ctx any, ids ...any) *MockTransactionRecalculateAddressesByIdCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateAddressesById", reflect.TypeOf((*MockTransaction)(nil).RecalculateAddressesById), varargs...)
+	return &MockTransactionRecalculateAddressesByIdCall{Call: call}
+}
+
+// MockTransactionRecalculateAddressesByIdCall wrap *gomock.Call
+type MockTransactionRecalculateAddressesByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRecalculateAddressesByIdCall) Return(arg0 error) *MockTransactionRecalculateAddressesByIdCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRecalculateAddressesByIdCall) Do(f func(context.Context, ...uint64) error) *MockTransactionRecalculateAddressesByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRecalculateAddressesByIdCall) DoAndReturn(f func(context.Context, ...uint64) error) *MockTransactionRecalculateAddressesByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecalculateRollups mocks base method.
+func (m *MockTransaction) RecalculateRollups(ctx context.Context, fromHeight types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecalculateRollups", ctx, fromHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecalculateRollups indicates an expected call of RecalculateRollups.
+func (mr *MockTransactionMockRecorder) RecalculateRollups(ctx, fromHeight any) *MockTransactionRecalculateRollupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateRollups", reflect.TypeOf((*MockTransaction)(nil).RecalculateRollups), ctx, fromHeight)
+	return &MockTransactionRecalculateRollupsCall{Call: call}
+}
+
+// MockTransactionRecalculateRollupsCall wrap *gomock.Call
+type MockTransactionRecalculateRollupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRecalculateRollupsCall) Return(arg0 error) *MockTransactionRecalculateRollupsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRecalculateRollupsCall) Do(f func(context.Context, types.Level) error) *MockTransactionRecalculateRollupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRecalculateRollupsCall) DoAndReturn(f func(context.Context, types.Level) error) *MockTransactionRecalculateRollupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecalculateRollupsById mocks base method.
+func (m *MockTransaction) RecalculateRollupsById(ctx context.Context, ids ...uint64) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecalculateRollupsById", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecalculateRollupsById indicates an expected call of RecalculateRollupsById.
+func (mr *MockTransactionMockRecorder) RecalculateRollupsById(ctx any, ids ...any) *MockTransactionRecalculateRollupsByIdCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateRollupsById", reflect.TypeOf((*MockTransaction)(nil).RecalculateRollupsById), varargs...)
+	return &MockTransactionRecalculateRollupsByIdCall{Call: call}
+}
+
+// MockTransactionRecalculateRollupsByIdCall wrap *gomock.Call
+type MockTransactionRecalculateRollupsByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRecalculateRollupsByIdCall) Return(arg0 error) *MockTransactionRecalculateRollupsByIdCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRecalculateRollupsByIdCall) Do(f func(context.Context, ...uint64) error) *MockTransactionRecalculateRollupsByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRecalculateRollupsByIdCall) DoAndReturn(f func(context.Context, ...uint64) error) *MockTransactionRecalculateRollupsByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecalculateState mocks base method.
+func (m *MockTransaction) RecalculateState(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecalculateState", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecalculateState indicates an expected call of RecalculateState.
+func (mr *MockTransactionMockRecorder) RecalculateState(ctx, name any) *MockTransactionRecalculateStateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastNonce", reflect.TypeOf((*MockTransaction)(nil).LastNonce), ctx, id)
-	return &MockTransactionLastNonceCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateState", reflect.TypeOf((*MockTransaction)(nil).RecalculateState), ctx, name)
+	return &MockTransactionRecalculateStateCall{Call: call}
 }
 
-// MockTransactionLastNonceCall wrap *gomock.Call
-type MockTransactionLastNonceCall struct {
+// MockTransactionRecalculateStateCall wrap *gomock.Call
+type MockTransactionRecalculateStateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockTransactionLastNonceCall) Return(arg0 uint32, arg1 error) *MockTransactionLastNonceCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockTransactionRecalculateStateCall) Return(arg0 error) *MockTransactionRecalculateStateCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockTransactionLastNonceCall) Do(f func(context.Context, uint64) (uint32, error)) *MockTransactionLastNonceCall {
+func (c *MockTransactionRecalculateStateCall) Do(f func(context.Context, string) error) *MockTransactionRecalculateStateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockTransactionLastNonceCall) DoAndReturn(f func(context.Context, uint64) (uint32, error)) *MockTransactionLastNonceCall {
+func (c *MockTransactionRecalculateStateCall) DoAndReturn(f func(context.Context, string) error) *MockTransactionRecalculateStateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -629,6 +1222,44 @@ func (c *MockTransactionRefreshLeaderboardCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// ReplaceApp mocks base method.
+func (m *MockTransaction) ReplaceApp(ctx context.Context, app *storage.App) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceApp", ctx, app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceApp indicates an expected call of ReplaceApp.
+func (mr *MockTransactionMockRecorder) ReplaceApp(ctx, app any) *MockTransactionReplaceAppCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceApp", reflect.TypeOf((*MockTransaction)(nil).ReplaceApp), ctx, app)
+	return &MockTransactionReplaceAppCall{Call: call}
+}
+
+// MockTransactionReplaceAppCall wrap *gomock.Call
+type MockTransactionReplaceAppCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionReplaceAppCall) Return(arg0 error) *MockTransactionReplaceAppCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionReplaceAppCall) Do(f func(context.Context, *storage.App) error) *MockTransactionReplaceAppCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionReplaceAppCall) DoAndReturn(f func(context.Context, *storage.App) error) *MockTransactionReplaceAppCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RetentionBlockSignatures mocks base method.
 func (m *MockTransaction) RetentionBlockSignatures(ctx context.Context, height types.Level) error {
 	m.ctrl.T.Helper()
@@ -1053,6 +1684,45 @@ func (c *MockTransactionRollbackBridgesCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// RollbackConstantHistory mocks base method.
+func (m *MockTransaction) RollbackConstantHistory(ctx context.Context, height types.Level) ([]storage.ConstantHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackConstantHistory", ctx, height)
+	ret0, _ := ret[0].([]storage.ConstantHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackConstantHistory indicates an expected call of RollbackConstantHistory.
+func (mr *MockTransactionMockRecorder) RollbackConstantHistory(ctx, height any) *MockTransactionRollbackConstantHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConstantHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackConstantHistory), ctx, height)
+	return &MockTransactionRollbackConstantHistoryCall{Call: call}
+}
+
+// MockTransactionRollbackConstantHistoryCall wrap *gomock.Call
+type MockTransactionRollbackConstantHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionRollbackConstantHistoryCall) Return(arg0 []storage.ConstantHistory, arg1 error) *MockTransactionRollbackConstantHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionRollbackConstantHistoryCall) Do(f func(context.Context, types.Level) ([]storage.ConstantHistory, error)) *MockTransactionRollbackConstantHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionRollbackConstantHistoryCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.ConstantHistory, error)) *MockTransactionRollbackConstantHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollbackDeposits mocks base method.
 func (m *MockTransaction) RollbackDeposits(ctx context.Context, height types.Level) error {
 	m.ctrl.T.Helper()
@@ -1566,6 +2236,120 @@ func (c *MockTransactionSaveAppCall) DoAndReturn(f func(context.Context, *storag
 	return c
 }
 
+// SaveAppBridge mocks base method.
+func (m *MockTransaction) SaveAppBridge(ctx context.Context, link *storage.AppBridge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAppBridge", ctx, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAppBridge indicates an expected call of SaveAppBridge.
+func (mr *MockTransactionMockRecorder) SaveAppBridge(ctx, link any) *MockTransactionSaveAppBridgeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAppBridge", reflect.TypeOf((*MockTransaction)(nil).SaveAppBridge), ctx, link)
+	return &MockTransactionSaveAppBridgeCall{Call: call}
+}
+
+// MockTransactionSaveAppBridgeCall wrap *gomock.Call
+type MockTransactionSaveAppBridgeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveAppBridgeCall) Return(arg0 error) *MockTransactionSaveAppBridgeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveAppBridgeCall) Do(f func(context.Context, *storage.AppBridge) error) *MockTransactionSaveAppBridgeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveAppBridgeCall) DoAndReturn(f func(context.Context, *storage.AppBridge) error) *MockTransactionSaveAppBridgeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAppRollup mocks base method.
+func (m *MockTransaction) SaveAppRollup(ctx context.Context, link *storage.AppRollup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAppRollup", ctx, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAppRollup indicates an expected call of SaveAppRollup.
+func (mr *MockTransactionMockRecorder) SaveAppRollup(ctx, link any) *MockTransactionSaveAppRollupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAppRollup", reflect.TypeOf((*MockTransaction)(nil).SaveAppRollup), ctx, link)
+	return &MockTransactionSaveAppRollupCall{Call: call}
+}
+
+// MockTransactionSaveAppRollupCall wrap *gomock.Call
+type MockTransactionSaveAppRollupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveAppRollupCall) Return(arg0 error) *MockTransactionSaveAppRollupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveAppRollupCall) Do(f func(context.Context, *storage.AppRollup) error) *MockTransactionSaveAppRollupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveAppRollupCall) DoAndReturn(f func(context.Context, *storage.AppRollup) error) *MockTransactionSaveAppRollupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAuditLog mocks base method.
+func (m *MockTransaction) SaveAuditLog(ctx context.Context, log *storage.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuditLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuditLog indicates an expected call of SaveAuditLog.
+func (mr *MockTransactionMockRecorder) SaveAuditLog(ctx, log any) *MockTransactionSaveAuditLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuditLog", reflect.TypeOf((*MockTransaction)(nil).SaveAuditLog), ctx, log)
+	return &MockTransactionSaveAuditLogCall{Call: call}
+}
+
+// MockTransactionSaveAuditLogCall wrap *gomock.Call
+type MockTransactionSaveAuditLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveAuditLogCall) Return(arg0 error) *MockTransactionSaveAuditLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveAuditLogCall) Do(f func(context.Context, *storage.AuditLog) error) *MockTransactionSaveAuditLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveAuditLogCall) DoAndReturn(f func(context.Context, *storage.AuditLog) error) *MockTransactionSaveAuditLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveBalanceUpdates mocks base method.
 func (m *MockTransaction) SaveBalanceUpdates(ctx context.Context, updates ...storage.BalanceUpdate) error {
 	m.ctrl.T.Helper()
@@ -1739,6 +2523,49 @@ func (c *MockTransactionSaveBridgesCall) DoAndReturn(f func(context.Context, ...
 	return c
 }
 
+// SaveConstantHistory mocks base method.
+func (m *MockTransaction) SaveConstantHistory(ctx context.Context, history ...*storage.ConstantHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range history {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveConstantHistory", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConstantHistory indicates an expected call of SaveConstantHistory.
+func (mr *MockTransactionMockRecorder) SaveConstantHistory(ctx any, history ...any) *MockTransactionSaveConstantHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, history...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConstantHistory", reflect.TypeOf((*MockTransaction)(nil).SaveConstantHistory), varargs...)
+	return &MockTransactionSaveConstantHistoryCall{Call: call}
+}
+
+// MockTransactionSaveConstantHistoryCall wrap *gomock.Call
+type MockTransactionSaveConstantHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveConstantHistoryCall) Return(arg0 error) *MockTransactionSaveConstantHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveConstantHistoryCall) Do(f func(context.Context, ...*storage.ConstantHistory) error) *MockTransactionSaveConstantHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveConstantHistoryCall) DoAndReturn(f func(context.Context, ...*storage.ConstantHistory) error) *MockTransactionSaveConstantHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveConstants mocks base method.
 func (m *MockTransaction) SaveConstants(ctx context.Context, constants ...storage.Constant) error {
 	m.ctrl.T.Helper()
@@ -2256,6 +3083,130 @@ func (c *MockTransactionSaveValidatorsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SaveWebhookDeliveries mocks base method.
+func (m *MockTransaction) SaveWebhookDeliveries(ctx context.Context, deliveries ...*storage.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range deliveries {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveWebhookDeliveries", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWebhookDeliveries indicates an expected call of SaveWebhookDeliveries.
+func (mr *MockTransactionMockRecorder) SaveWebhookDeliveries(ctx any, deliveries ...any) *MockTransactionSaveWebhookDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, deliveries...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookDeliveries", reflect.TypeOf((*MockTransaction)(nil).SaveWebhookDeliveries), varargs...)
+	return &MockTransactionSaveWebhookDeliveriesCall{Call: call}
+}
+
+// MockTransactionSaveWebhookDeliveriesCall wrap *gomock.Call
+type MockTransactionSaveWebhookDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveWebhookDeliveriesCall) Return(arg0 error) *MockTransactionSaveWebhookDeliveriesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveWebhookDeliveriesCall) Do(f func(context.Context, ...*storage.WebhookDelivery) error) *MockTransactionSaveWebhookDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveWebhookDeliveriesCall) DoAndReturn(f func(context.Context, ...*storage.WebhookDelivery) error) *MockTransactionSaveWebhookDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveWebhookState mocks base method.
+func (m *MockTransaction) SaveWebhookState(ctx context.Context, cursor *storage.WebhookState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookState", ctx, cursor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWebhookState indicates an expected call of SaveWebhookState.
+func (mr *MockTransactionMockRecorder) SaveWebhookState(ctx, cursor any) *MockTransactionSaveWebhookStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookState", reflect.TypeOf((*MockTransaction)(nil).SaveWebhookState), ctx, cursor)
+	return &MockTransactionSaveWebhookStateCall{Call: call}
+}
+
+// MockTransactionSaveWebhookStateCall wrap *gomock.Call
+type MockTransactionSaveWebhookStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSaveWebhookStateCall) Return(arg0 error) *MockTransactionSaveWebhookStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSaveWebhookStateCall) Do(f func(context.Context, *storage.WebhookState) error) *MockTransactionSaveWebhookStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSaveWebhookStateCall) DoAndReturn(f func(context.Context, *storage.WebhookState) error) *MockTransactionSaveWebhookStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetAddressesNonce mocks base method.
+func (m *MockTransaction) SetAddressesNonce(ctx context.Context, address ...*storage.Address) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range address {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetAddressesNonce", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAddressesNonce indicates an expected call of SetAddressesNonce.
+func (mr *MockTransactionMockRecorder) SetAddressesNonce(ctx any, address ...any) *MockTransactionSetAddressesNonceCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, address...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAddressesNonce", reflect.TypeOf((*MockTransaction)(nil).SetAddressesNonce), varargs...)
+	return &MockTransactionSetAddressesNonceCall{Call: call}
+}
+
+// MockTransactionSetAddressesNonceCall wrap *gomock.Call
+type MockTransactionSetAddressesNonceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTransactionSetAddressesNonceCall) Return(arg0 error) *MockTransactionSetAddressesNonceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTransactionSetAddressesNonceCall) Do(f func(context.Context, ...*storage.Address) error) *MockTransactionSetAddressesNonceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTransactionSetAddressesNonceCall) DoAndReturn(f func(context.Context, ...*storage.Address) error) *MockTransactionSetAddressesNonceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // State mocks base method.
 func (m *MockTransaction) State(ctx context.Context, name string) (storage.State, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: operator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=operator.go -destination=mock/operator.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOperator is a mock of IOperator interface.
+type MockIOperator struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOperatorMockRecorder
+}
+
+// MockIOperatorMockRecorder is the mock recorder for MockIOperator.
+type MockIOperatorMockRecorder struct {
+	mock *MockIOperator
+}
+
+// NewMockIOperator creates a new mock instance.
+func NewMockIOperator(ctrl *gomock.Controller) *MockIOperator {
+	mock := &MockIOperator{ctrl: ctrl}
+	mock.recorder = &MockIOperatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOperator) EXPECT() *MockIOperatorMockRecorder {
+	return m.recorder
+}
+
+// ByHash mocks base method.
+func (m *MockIOperator) ByHash(ctx context.Context, hash string) (storage.Operator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHash", ctx, hash)
+	ret0, _ := ret[0].(storage.Operator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHash indicates an expected call of ByHash.
+func (mr *MockIOperatorMockRecorder) ByHash(ctx, hash any) *MockIOperatorByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHash", reflect.TypeOf((*MockIOperator)(nil).ByHash), ctx, hash)
+	return &MockIOperatorByHashCall{Call: call}
+}
+
+// MockIOperatorByHashCall wrap *gomock.Call
+type MockIOperatorByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorByHashCall) Return(arg0 storage.Operator, arg1 error) *MockIOperatorByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorByHashCall) Do(f func(context.Context, string) (storage.Operator, error)) *MockIOperatorByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorByHashCall) DoAndReturn(f func(context.Context, string) (storage.Operator, error)) *MockIOperatorByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIOperator) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Operator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Operator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIOperatorMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIOperatorCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIOperator)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIOperatorCursorListCall{Call: call}
+}
+
+// MockIOperatorCursorListCall wrap *gomock.Call
+type MockIOperatorCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorCursorListCall) Return(arg0 []*storage.Operator, arg1 error) *MockIOperatorCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Operator, error)) *MockIOperatorCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Operator, error)) *MockIOperatorCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIOperator) GetByID(ctx context.Context, id uint64) (*storage.Operator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Operator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIOperatorMockRecorder) GetByID(ctx, id any) *MockIOperatorGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIOperator)(nil).GetByID), ctx, id)
+	return &MockIOperatorGetByIDCall{Call: call}
+}
+
+// MockIOperatorGetByIDCall wrap *gomock.Call
+type MockIOperatorGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorGetByIDCall) Return(arg0 *storage.Operator, arg1 error) *MockIOperatorGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorGetByIDCall) Do(f func(context.Context, uint64) (*storage.Operator, error)) *MockIOperatorGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Operator, error)) *MockIOperatorGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIOperator) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIOperatorMockRecorder) IsNoRows(err any) *MockIOperatorIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIOperator)(nil).IsNoRows), err)
+	return &MockIOperatorIsNoRowsCall{Call: call}
+}
+
+// MockIOperatorIsNoRowsCall wrap *gomock.Call
+type MockIOperatorIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorIsNoRowsCall) Return(arg0 bool) *MockIOperatorIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorIsNoRowsCall) Do(f func(error) bool) *MockIOperatorIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIOperatorIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIOperator) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIOperatorMockRecorder) LastID(ctx any) *MockIOperatorLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIOperator)(nil).LastID), ctx)
+	return &MockIOperatorLastIDCall{Call: call}
+}
+
+// MockIOperatorLastIDCall wrap *gomock.Call
+type MockIOperatorLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorLastIDCall) Return(arg0 uint64, arg1 error) *MockIOperatorLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIOperatorLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIOperatorLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIOperator) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Operator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Operator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIOperatorMockRecorder) List(ctx, limit, offset, order any) *MockIOperatorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIOperator)(nil).List), ctx, limit, offset, order)
+	return &MockIOperatorListCall{Call: call}
+}
+
+// MockIOperatorListCall wrap *gomock.Call
+type MockIOperatorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorListCall) Return(arg0 []*storage.Operator, arg1 error) *MockIOperatorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Operator, error)) *MockIOperatorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Operator, error)) *MockIOperatorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIOperator) Save(ctx context.Context, m *storage.Operator) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIOperatorMockRecorder) Save(ctx, m any) *MockIOperatorSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIOperator)(nil).Save), ctx, m)
+	return &MockIOperatorSaveCall{Call: call}
+}
+
+// MockIOperatorSaveCall wrap *gomock.Call
+type MockIOperatorSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorSaveCall) Return(arg0 error) *MockIOperatorSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorSaveCall) Do(f func(context.Context, *storage.Operator) error) *MockIOperatorSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorSaveCall) DoAndReturn(f func(context.Context, *storage.Operator) error) *MockIOperatorSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIOperator) Update(ctx context.Context, m *storage.Operator) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIOperatorMockRecorder) Update(ctx, m any) *MockIOperatorUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIOperator)(nil).Update), ctx, m)
+	return &MockIOperatorUpdateCall{Call: call}
+}
+
+// MockIOperatorUpdateCall wrap *gomock.Call
+type MockIOperatorUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIOperatorUpdateCall) Return(arg0 error) *MockIOperatorUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIOperatorUpdateCall) Do(f func(context.Context, *storage.Operator) error) *MockIOperatorUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIOperatorUpdateCall) DoAndReturn(f func(context.Context, *storage.Operator) error) *MockIOperatorUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rollback_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rollback_event.go -destination=mock/rollback_event.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRollbackEvent is a mock of IRollbackEvent interface.
+type MockIRollbackEvent struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRollbackEventMockRecorder
+}
+
+// MockIRollbackEventMockRecorder is the mock recorder for MockIRollbackEvent.
+type MockIRollbackEventMockRecorder struct {
+	mock *MockIRollbackEvent
+}
+
+// NewMockIRollbackEvent creates a new mock instance.
+func NewMockIRollbackEvent(ctrl *gomock.Controller) *MockIRollbackEvent {
+	mock := &MockIRollbackEvent{ctrl: ctrl}
+	mock.recorder = &MockIRollbackEventMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRollbackEvent) EXPECT() *MockIRollbackEventMockRecorder {
+	return m.recorder
+}
+
+// CursorList mocks base method.
+func (m *MockIRollbackEvent) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.RollbackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.RollbackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIRollbackEventMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIRollbackEventCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIRollbackEvent)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIRollbackEventCursorListCall{Call: call}
+}
+
+// MockIRollbackEventCursorListCall wrap *gomock.Call
+type MockIRollbackEventCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventCursorListCall) Return(arg0 []*storage.RollbackEvent, arg1 error) *MockIRollbackEventCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollbackEvent, error)) *MockIRollbackEventCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.RollbackEvent, error)) *MockIRollbackEventCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIRollbackEvent) GetByID(ctx context.Context, id uint64) (*storage.RollbackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.RollbackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRollbackEventMockRecorder) GetByID(ctx, id any) *MockIRollbackEventGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRollbackEvent)(nil).GetByID), ctx, id)
+	return &MockIRollbackEventGetByIDCall{Call: call}
+}
+
+// MockIRollbackEventGetByIDCall wrap *gomock.Call
+type MockIRollbackEventGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventGetByIDCall) Return(arg0 *storage.RollbackEvent, arg1 error) *MockIRollbackEventGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventGetByIDCall) Do(f func(context.Context, uint64) (*storage.RollbackEvent, error)) *MockIRollbackEventGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.RollbackEvent, error)) *MockIRollbackEventGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIRollbackEvent) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIRollbackEventMockRecorder) IsNoRows(err any) *MockIRollbackEventIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIRollbackEvent)(nil).IsNoRows), err)
+	return &MockIRollbackEventIsNoRowsCall{Call: call}
+}
+
+// MockIRollbackEventIsNoRowsCall wrap *gomock.Call
+type MockIRollbackEventIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventIsNoRowsCall) Return(arg0 bool) *MockIRollbackEventIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventIsNoRowsCall) Do(f func(error) bool) *MockIRollbackEventIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIRollbackEventIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIRollbackEvent) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIRollbackEventMockRecorder) LastID(ctx any) *MockIRollbackEventLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIRollbackEvent)(nil).LastID), ctx)
+	return &MockIRollbackEventLastIDCall{Call: call}
+}
+
+// MockIRollbackEventLastIDCall wrap *gomock.Call
+type MockIRollbackEventLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventLastIDCall) Return(arg0 uint64, arg1 error) *MockIRollbackEventLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIRollbackEventLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIRollbackEventLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIRollbackEvent) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.RollbackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.RollbackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRollbackEventMockRecorder) List(ctx, limit, offset, order any) *MockIRollbackEventListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRollbackEvent)(nil).List), ctx, limit, offset, order)
+	return &MockIRollbackEventListCall{Call: call}
+}
+
+// MockIRollbackEventListCall wrap *gomock.Call
+type MockIRollbackEventListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventListCall) Return(arg0 []*storage.RollbackEvent, arg1 error) *MockIRollbackEventListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollbackEvent, error)) *MockIRollbackEventListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.RollbackEvent, error)) *MockIRollbackEventListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIRollbackEvent) Save(ctx context.Context, m *storage.RollbackEvent) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRollbackEventMockRecorder) Save(ctx, m any) *MockIRollbackEventSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRollbackEvent)(nil).Save), ctx, m)
+	return &MockIRollbackEventSaveCall{Call: call}
+}
+
+// MockIRollbackEventSaveCall wrap *gomock.Call
+type MockIRollbackEventSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventSaveCall) Return(arg0 error) *MockIRollbackEventSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventSaveCall) Do(f func(context.Context, *storage.RollbackEvent) error) *MockIRollbackEventSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventSaveCall) DoAndReturn(f func(context.Context, *storage.RollbackEvent) error) *MockIRollbackEventSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIRollbackEvent) Update(ctx context.Context, m *storage.RollbackEvent) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIRollbackEventMockRecorder) Update(ctx, m any) *MockIRollbackEventUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRollbackEvent)(nil).Update), ctx, m)
+	return &MockIRollbackEventUpdateCall{Call: call}
+}
+
+// MockIRollbackEventUpdateCall wrap *gomock.Call
+type MockIRollbackEventUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollbackEventUpdateCall) Return(arg0 error) *MockIRollbackEventUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollbackEventUpdateCall) Do(f func(context.Context, *storage.RollbackEvent) error) *MockIRollbackEventUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollbackEventUpdateCall) DoAndReturn(f func(context.Context, *storage.RollbackEvent) error) *MockIRollbackEventUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
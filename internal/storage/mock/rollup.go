@@ -162,6 +162,45 @@ func (c *MockIRollupAddressesCall) DoAndReturn(f func(context.Context, uint64, i
 	return c
 }
 
+// ByFirstHeight mocks base method.
+func (m *MockIRollup) ByFirstHeight(ctx context.Context, height types.Level) ([]storage.Rollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByFirstHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Rollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByFirstHeight indicates an expected call of ByFirstHeight.
+func (mr *MockIRollupMockRecorder) ByFirstHeight(ctx, height any) *MockIRollupByFirstHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByFirstHeight", reflect.TypeOf((*MockIRollup)(nil).ByFirstHeight), ctx, height)
+	return &MockIRollupByFirstHeightCall{Call: call}
+}
+
+// MockIRollupByFirstHeightCall wrap *gomock.Call
+type MockIRollupByFirstHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupByFirstHeightCall) Return(arg0 []storage.Rollup, arg1 error) *MockIRollupByFirstHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupByFirstHeightCall) Do(f func(context.Context, types.Level) ([]storage.Rollup, error)) *MockIRollupByFirstHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupByFirstHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Rollup, error)) *MockIRollupByFirstHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByHash mocks base method.
 func (m *MockIRollup) ByHash(ctx context.Context, hash []byte) (storage.Rollup, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +240,45 @@ func (c *MockIRollupByHashCall) DoAndReturn(f func(context.Context, []byte) (sto
 	return c
 }
 
+// ByHeight mocks base method.
+func (m *MockIRollup) ByHeight(ctx context.Context, height types.Level) ([]storage.Rollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Rollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIRollupMockRecorder) ByHeight(ctx, height any) *MockIRollupByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIRollup)(nil).ByHeight), ctx, height)
+	return &MockIRollupByHeightCall{Call: call}
+}
+
+// MockIRollupByHeightCall wrap *gomock.Call
+type MockIRollupByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIRollupByHeightCall) Return(arg0 []storage.Rollup, arg1 error) *MockIRollupByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIRollupByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Rollup, error)) *MockIRollupByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIRollupByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Rollup, error)) *MockIRollupByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CountActionsByHeight mocks base method.
 func (m *MockIRollup) CountActionsByHeight(ctx context.Context, height types.Level) (int64, error) {
 	m.ctrl.T.Helper()
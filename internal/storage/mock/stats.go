@@ -15,6 +15,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
 	gomock "go.uber.org/mock/gomock"
@@ -43,6 +44,84 @@ func (m *MockIStats) EXPECT() *MockIStatsMockRecorder {
 	return m.recorder
 }
 
+// ActionBreakdown mocks base method.
+func (m *MockIStats) ActionBreakdown(ctx context.Context, timeframe storage.Timeframe) ([]storage.ActionTypeShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionBreakdown", ctx, timeframe)
+	ret0, _ := ret[0].([]storage.ActionTypeShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActionBreakdown indicates an expected call of ActionBreakdown.
+func (mr *MockIStatsMockRecorder) ActionBreakdown(ctx, timeframe any) *MockIStatsActionBreakdownCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionBreakdown", reflect.TypeOf((*MockIStats)(nil).ActionBreakdown), ctx, timeframe)
+	return &MockIStatsActionBreakdownCall{Call: call}
+}
+
+// MockIStatsActionBreakdownCall wrap *gomock.Call
+type MockIStatsActionBreakdownCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsActionBreakdownCall) Return(arg0 []storage.ActionTypeShare, arg1 error) *MockIStatsActionBreakdownCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsActionBreakdownCall) Do(f func(context.Context, storage.Timeframe) ([]storage.ActionTypeShare, error)) *MockIStatsActionBreakdownCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsActionBreakdownCall) DoAndReturn(f func(context.Context, storage.Timeframe) ([]storage.ActionTypeShare, error)) *MockIStatsActionBreakdownCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ActionSeries mocks base method.
+func (m *MockIStats) ActionSeries(ctx context.Context, timeframe storage.Timeframe, actionType string, req storage.SeriesRequest) ([]storage.SeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionSeries", ctx, timeframe, actionType, req)
+	ret0, _ := ret[0].([]storage.SeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActionSeries indicates an expected call of ActionSeries.
+func (mr *MockIStatsMockRecorder) ActionSeries(ctx, timeframe, actionType, req any) *MockIStatsActionSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionSeries", reflect.TypeOf((*MockIStats)(nil).ActionSeries), ctx, timeframe, actionType, req)
+	return &MockIStatsActionSeriesCall{Call: call}
+}
+
+// MockIStatsActionSeriesCall wrap *gomock.Call
+type MockIStatsActionSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsActionSeriesCall) Return(arg0 []storage.SeriesItem, arg1 error) *MockIStatsActionSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsActionSeriesCall) Do(f func(context.Context, storage.Timeframe, string, storage.SeriesRequest) ([]storage.SeriesItem, error)) *MockIStatsActionSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsActionSeriesCall) DoAndReturn(f func(context.Context, storage.Timeframe, string, storage.SeriesRequest) ([]storage.SeriesItem, error)) *MockIStatsActionSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ActiveAddressesCount mocks base method.
 func (m *MockIStats) ActiveAddressesCount(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +161,201 @@ func (c *MockIStatsActiveAddressesCountCall) DoAndReturn(f func(context.Context)
 	return c
 }
 
+// AddressSeries mocks base method.
+func (m *MockIStats) AddressSeries(ctx context.Context, timeframe storage.Timeframe, name string, req storage.SeriesRequest) ([]storage.SeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressSeries", ctx, timeframe, name, req)
+	ret0, _ := ret[0].([]storage.SeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddressSeries indicates an expected call of AddressSeries.
+func (mr *MockIStatsMockRecorder) AddressSeries(ctx, timeframe, name, req any) *MockIStatsAddressSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressSeries", reflect.TypeOf((*MockIStats)(nil).AddressSeries), ctx, timeframe, name, req)
+	return &MockIStatsAddressSeriesCall{Call: call}
+}
+
+// MockIStatsAddressSeriesCall wrap *gomock.Call
+type MockIStatsAddressSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsAddressSeriesCall) Return(arg0 []storage.SeriesItem, arg1 error) *MockIStatsAddressSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsAddressSeriesCall) Do(f func(context.Context, storage.Timeframe, string, storage.SeriesRequest) ([]storage.SeriesItem, error)) *MockIStatsAddressSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsAddressSeriesCall) DoAndReturn(f func(context.Context, storage.Timeframe, string, storage.SeriesRequest) ([]storage.SeriesItem, error)) *MockIStatsAddressSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CompareRollupSeries mocks base method.
+func (m *MockIStats) CompareRollupSeries(ctx context.Context, rollupIds []uint64, timeframe storage.Timeframe, name string, req storage.SeriesRequest) ([]storage.RollupSeriesPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareRollupSeries", ctx, rollupIds, timeframe, name, req)
+	ret0, _ := ret[0].([]storage.RollupSeriesPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareRollupSeries indicates an expected call of CompareRollupSeries.
+func (mr *MockIStatsMockRecorder) CompareRollupSeries(ctx, rollupIds, timeframe, name, req any) *MockIStatsCompareRollupSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareRollupSeries", reflect.TypeOf((*MockIStats)(nil).CompareRollupSeries), ctx, rollupIds, timeframe, name, req)
+	return &MockIStatsCompareRollupSeriesCall{Call: call}
+}
+
+// MockIStatsCompareRollupSeriesCall wrap *gomock.Call
+type MockIStatsCompareRollupSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsCompareRollupSeriesCall) Return(arg0 []storage.RollupSeriesPoint, arg1 error) *MockIStatsCompareRollupSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsCompareRollupSeriesCall) Do(f func(context.Context, []uint64, storage.Timeframe, string, storage.SeriesRequest) ([]storage.RollupSeriesPoint, error)) *MockIStatsCompareRollupSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsCompareRollupSeriesCall) DoAndReturn(f func(context.Context, []uint64, storage.Timeframe, string, storage.SeriesRequest) ([]storage.RollupSeriesPoint, error)) *MockIStatsCompareRollupSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Distribution mocks base method.
+func (m *MockIStats) Distribution(ctx context.Context, name string, fltrs storage.DistributionFilter) (storage.Distribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Distribution", ctx, name, fltrs)
+	ret0, _ := ret[0].(storage.Distribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Distribution indicates an expected call of Distribution.
+func (mr *MockIStatsMockRecorder) Distribution(ctx, name, fltrs any) *MockIStatsDistributionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Distribution", reflect.TypeOf((*MockIStats)(nil).Distribution), ctx, name, fltrs)
+	return &MockIStatsDistributionCall{Call: call}
+}
+
+// MockIStatsDistributionCall wrap *gomock.Call
+type MockIStatsDistributionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsDistributionCall) Return(arg0 storage.Distribution, arg1 error) *MockIStatsDistributionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsDistributionCall) Do(f func(context.Context, string, storage.DistributionFilter) (storage.Distribution, error)) *MockIStatsDistributionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsDistributionCall) DoAndReturn(f func(context.Context, string, storage.DistributionFilter) (storage.Distribution, error)) *MockIStatsDistributionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FeeByActionType mocks base method.
+func (m *MockIStats) FeeByActionType(ctx context.Context) ([]storage.FeeActionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeByActionType", ctx)
+	ret0, _ := ret[0].([]storage.FeeActionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeByActionType indicates an expected call of FeeByActionType.
+func (mr *MockIStatsMockRecorder) FeeByActionType(ctx any) *MockIStatsFeeByActionTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeByActionType", reflect.TypeOf((*MockIStats)(nil).FeeByActionType), ctx)
+	return &MockIStatsFeeByActionTypeCall{Call: call}
+}
+
+// MockIStatsFeeByActionTypeCall wrap *gomock.Call
+type MockIStatsFeeByActionTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsFeeByActionTypeCall) Return(arg0 []storage.FeeActionSummary, arg1 error) *MockIStatsFeeByActionTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsFeeByActionTypeCall) Do(f func(context.Context) ([]storage.FeeActionSummary, error)) *MockIStatsFeeByActionTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsFeeByActionTypeCall) DoAndReturn(f func(context.Context) ([]storage.FeeActionSummary, error)) *MockIStatsFeeByActionTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FeeSeries mocks base method.
+func (m *MockIStats) FeeSeries(ctx context.Context, timeframe storage.Timeframe, req storage.SeriesRequest, fltrs storage.FeeSeriesFilter) ([]storage.SeriesItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeSeries", ctx, timeframe, req, fltrs)
+	ret0, _ := ret[0].([]storage.SeriesItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeSeries indicates an expected call of FeeSeries.
+func (mr *MockIStatsMockRecorder) FeeSeries(ctx, timeframe, req, fltrs any) *MockIStatsFeeSeriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeSeries", reflect.TypeOf((*MockIStats)(nil).FeeSeries), ctx, timeframe, req, fltrs)
+	return &MockIStatsFeeSeriesCall{Call: call}
+}
+
+// MockIStatsFeeSeriesCall wrap *gomock.Call
+type MockIStatsFeeSeriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsFeeSeriesCall) Return(arg0 []storage.SeriesItem, arg1 error) *MockIStatsFeeSeriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsFeeSeriesCall) Do(f func(context.Context, storage.Timeframe, storage.SeriesRequest, storage.FeeSeriesFilter) ([]storage.SeriesItem, error)) *MockIStatsFeeSeriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsFeeSeriesCall) DoAndReturn(f func(context.Context, storage.Timeframe, storage.SeriesRequest, storage.FeeSeriesFilter) ([]storage.SeriesItem, error)) *MockIStatsFeeSeriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FeeSummary mocks base method.
 func (m *MockIStats) FeeSummary(ctx context.Context) ([]storage.FeeSummary, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +395,84 @@ func (c *MockIStatsFeeSummaryCall) DoAndReturn(f func(context.Context) ([]storag
 	return c
 }
 
+// RollupCadence mocks base method.
+func (m *MockIStats) RollupCadence(ctx context.Context, since time.Time) ([]storage.RollupCadence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupCadence", ctx, since)
+	ret0, _ := ret[0].([]storage.RollupCadence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollupCadence indicates an expected call of RollupCadence.
+func (mr *MockIStatsMockRecorder) RollupCadence(ctx, since any) *MockIStatsRollupCadenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupCadence", reflect.TypeOf((*MockIStats)(nil).RollupCadence), ctx, since)
+	return &MockIStatsRollupCadenceCall{Call: call}
+}
+
+// MockIStatsRollupCadenceCall wrap *gomock.Call
+type MockIStatsRollupCadenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsRollupCadenceCall) Return(arg0 []storage.RollupCadence, arg1 error) *MockIStatsRollupCadenceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsRollupCadenceCall) Do(f func(context.Context, time.Time) ([]storage.RollupCadence, error)) *MockIStatsRollupCadenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsRollupCadenceCall) DoAndReturn(f func(context.Context, time.Time) ([]storage.RollupCadence, error)) *MockIStatsRollupCadenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollupFees mocks base method.
+func (m *MockIStats) RollupFees(ctx context.Context, fltrs storage.RollupFeeFilter) ([]storage.RollupFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupFees", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.RollupFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollupFees indicates an expected call of RollupFees.
+func (mr *MockIStatsMockRecorder) RollupFees(ctx, fltrs any) *MockIStatsRollupFeesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupFees", reflect.TypeOf((*MockIStats)(nil).RollupFees), ctx, fltrs)
+	return &MockIStatsRollupFeesCall{Call: call}
+}
+
+// MockIStatsRollupFeesCall wrap *gomock.Call
+type MockIStatsRollupFeesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsRollupFeesCall) Return(arg0 []storage.RollupFee, arg1 error) *MockIStatsRollupFeesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsRollupFeesCall) Do(f func(context.Context, storage.RollupFeeFilter) ([]storage.RollupFee, error)) *MockIStatsRollupFeesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsRollupFeesCall) DoAndReturn(f func(context.Context, storage.RollupFeeFilter) ([]storage.RollupFee, error)) *MockIStatsRollupFeesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RollupSeries mocks base method.
 func (m *MockIStats) RollupSeries(ctx context.Context, rollupId uint64, timeframe storage.Timeframe, name string, req storage.SeriesRequest) ([]storage.SeriesItem, error) {
 	m.ctrl.T.Helper()
@@ -315,3 +667,42 @@ func (c *MockIStatsTokenTransferDistributionCall) DoAndReturn(f func(context.Con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// TopFeePayers mocks base method.
+func (m *MockIStats) TopFeePayers(ctx context.Context, fltrs storage.TopFeePayersFilter) ([]storage.FeePayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopFeePayers", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.FeePayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopFeePayers indicates an expected call of TopFeePayers.
+func (mr *MockIStatsMockRecorder) TopFeePayers(ctx, fltrs any) *MockIStatsTopFeePayersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopFeePayers", reflect.TypeOf((*MockIStats)(nil).TopFeePayers), ctx, fltrs)
+	return &MockIStatsTopFeePayersCall{Call: call}
+}
+
+// MockIStatsTopFeePayersCall wrap *gomock.Call
+type MockIStatsTopFeePayersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIStatsTopFeePayersCall) Return(arg0 []storage.FeePayer, arg1 error) *MockIStatsTopFeePayersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIStatsTopFeePayersCall) Do(f func(context.Context, storage.TopFeePayersFilter) ([]storage.FeePayer, error)) *MockIStatsTopFeePayersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIStatsTopFeePayersCall) DoAndReturn(f func(context.Context, storage.TopFeePayersFilter) ([]storage.FeePayer, error)) *MockIStatsTopFeePayersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -17,6 +17,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	types "github.com/celenium-io/astria-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -44,6 +45,45 @@ func (m *MockITransfer) EXPECT() *MockITransferMockRecorder {
 	return m.recorder
 }
 
+// ByHeight mocks base method.
+func (m *MockITransfer) ByHeight(ctx context.Context, height types.Level) ([]storage.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockITransferMockRecorder) ByHeight(ctx, height any) *MockITransferByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockITransfer)(nil).ByHeight), ctx, height)
+	return &MockITransferByHeightCall{Call: call}
+}
+
+// MockITransferByHeightCall wrap *gomock.Call
+type MockITransferByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITransferByHeightCall) Return(arg0 []storage.Transfer, arg1 error) *MockITransferByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITransferByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Transfer, error)) *MockITransferByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITransferByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Transfer, error)) *MockITransferByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CursorList mocks base method.
 func (m *MockITransfer) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Transfer, error) {
 	m.ctrl.T.Helper()
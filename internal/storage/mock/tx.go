@@ -123,6 +123,45 @@ func (c *MockITxByHashCall) DoAndReturn(f func(context.Context, []byte) (storage
 	return c
 }
 
+// ByHashes mocks base method.
+func (m *MockITx) ByHashes(ctx context.Context, hashes [][]byte) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHashes", ctx, hashes)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHashes indicates an expected call of ByHashes.
+func (mr *MockITxMockRecorder) ByHashes(ctx, hashes any) *MockITxByHashesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHashes", reflect.TypeOf((*MockITx)(nil).ByHashes), ctx, hashes)
+	return &MockITxByHashesCall{Call: call}
+}
+
+// MockITxByHashesCall wrap *gomock.Call
+type MockITxByHashesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockITxByHashesCall) Return(arg0 []storage.Tx, arg1 error) *MockITxByHashesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockITxByHashesCall) Do(f func(context.Context, [][]byte) ([]storage.Tx, error)) *MockITxByHashesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockITxByHashesCall) DoAndReturn(f func(context.Context, [][]byte) ([]storage.Tx, error)) *MockITxByHashesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ByHeight mocks base method.
 func (m *MockITx) ByHeight(ctx context.Context, height types.Level, limit, offset int) ([]storage.Tx, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook.go -destination=mock/webhook.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWebhook is a mock of IWebhook interface.
+type MockIWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookMockRecorder
+}
+
+// MockIWebhookMockRecorder is the mock recorder for MockIWebhook.
+type MockIWebhookMockRecorder struct {
+	mock *MockIWebhook
+}
+
+// NewMockIWebhook creates a new mock instance.
+func NewMockIWebhook(ctrl *gomock.Controller) *MockIWebhook {
+	mock := &MockIWebhook{ctrl: ctrl}
+	mock.recorder = &MockIWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhook) EXPECT() *MockIWebhookMockRecorder {
+	return m.recorder
+}
+
+// ByEvent mocks base method.
+func (m *MockIWebhook) ByEvent(ctx context.Context, event string) ([]storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByEvent", ctx, event)
+	ret0, _ := ret[0].([]storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByEvent indicates an expected call of ByEvent.
+func (mr *MockIWebhookMockRecorder) ByEvent(ctx, event any) *MockIWebhookByEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByEvent", reflect.TypeOf((*MockIWebhook)(nil).ByEvent), ctx, event)
+	return &MockIWebhookByEventCall{Call: call}
+}
+
+// MockIWebhookByEventCall wrap *gomock.Call
+type MockIWebhookByEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookByEventCall) Return(arg0 []storage.Webhook, arg1 error) *MockIWebhookByEventCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookByEventCall) Do(f func(context.Context, string) ([]storage.Webhook, error)) *MockIWebhookByEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookByEventCall) DoAndReturn(f func(context.Context, string) ([]storage.Webhook, error)) *MockIWebhookByEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWebhook) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWebhookMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIWebhookCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWebhook)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIWebhookCursorListCall{Call: call}
+}
+
+// MockIWebhookCursorListCall wrap *gomock.Call
+type MockIWebhookCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookCursorListCall) Return(arg0 []*storage.Webhook, arg1 error) *MockIWebhookCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Webhook, error)) *MockIWebhookCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Webhook, error)) *MockIWebhookCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWebhook) GetByID(ctx context.Context, id uint64) (*storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWebhookMockRecorder) GetByID(ctx, id any) *MockIWebhookGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWebhook)(nil).GetByID), ctx, id)
+	return &MockIWebhookGetByIDCall{Call: call}
+}
+
+// MockIWebhookGetByIDCall wrap *gomock.Call
+type MockIWebhookGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookGetByIDCall) Return(arg0 *storage.Webhook, arg1 error) *MockIWebhookGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookGetByIDCall) Do(f func(context.Context, uint64) (*storage.Webhook, error)) *MockIWebhookGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Webhook, error)) *MockIWebhookGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWebhook) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWebhookMockRecorder) IsNoRows(err any) *MockIWebhookIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWebhook)(nil).IsNoRows), err)
+	return &MockIWebhookIsNoRowsCall{Call: call}
+}
+
+// MockIWebhookIsNoRowsCall wrap *gomock.Call
+type MockIWebhookIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookIsNoRowsCall) Return(arg0 bool) *MockIWebhookIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookIsNoRowsCall) Do(f func(error) bool) *MockIWebhookIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIWebhookIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWebhook) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWebhookMockRecorder) LastID(ctx any) *MockIWebhookLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWebhook)(nil).LastID), ctx)
+	return &MockIWebhookLastIDCall{Call: call}
+}
+
+// MockIWebhookLastIDCall wrap *gomock.Call
+type MockIWebhookLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookLastIDCall) Return(arg0 uint64, arg1 error) *MockIWebhookLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIWebhookLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIWebhookLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWebhook) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWebhookMockRecorder) List(ctx, limit, offset, order any) *MockIWebhookListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWebhook)(nil).List), ctx, limit, offset, order)
+	return &MockIWebhookListCall{Call: call}
+}
+
+// MockIWebhookListCall wrap *gomock.Call
+type MockIWebhookListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookListCall) Return(arg0 []*storage.Webhook, arg1 error) *MockIWebhookListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Webhook, error)) *MockIWebhookListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Webhook, error)) *MockIWebhookListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWebhook) Save(ctx context.Context, m *storage.Webhook) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWebhookMockRecorder) Save(ctx, m any) *MockIWebhookSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWebhook)(nil).Save), ctx, m)
+	return &MockIWebhookSaveCall{Call: call}
+}
+
+// MockIWebhookSaveCall wrap *gomock.Call
+type MockIWebhookSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookSaveCall) Return(arg0 error) *MockIWebhookSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookSaveCall) Do(f func(context.Context, *storage.Webhook) error) *MockIWebhookSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookSaveCall) DoAndReturn(f func(context.Context, *storage.Webhook) error) *MockIWebhookSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWebhook) Update(ctx context.Context, m *storage.Webhook) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWebhookMockRecorder) Update(ctx, m any) *MockIWebhookUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWebhook)(nil).Update), ctx, m)
+	return &MockIWebhookUpdateCall{Call: call}
+}
+
+// MockIWebhookUpdateCall wrap *gomock.Call
+type MockIWebhookUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookUpdateCall) Return(arg0 error) *MockIWebhookUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookUpdateCall) Do(f func(context.Context, *storage.Webhook) error) *MockIWebhookUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookUpdateCall) DoAndReturn(f func(context.Context, *storage.Webhook) error) *MockIWebhookUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
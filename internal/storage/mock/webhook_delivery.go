@@ -0,0 +1,433 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_delivery.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_delivery.go -destination=mock/webhook_delivery.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWebhookDelivery is a mock of IWebhookDelivery interface.
+type MockIWebhookDelivery struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookDeliveryMockRecorder
+}
+
+// MockIWebhookDeliveryMockRecorder is the mock recorder for MockIWebhookDelivery.
+type MockIWebhookDeliveryMockRecorder struct {
+	mock *MockIWebhookDelivery
+}
+
+// NewMockIWebhookDelivery creates a new mock instance.
+func NewMockIWebhookDelivery(ctrl *gomock.Controller) *MockIWebhookDelivery {
+	mock := &MockIWebhookDelivery{ctrl: ctrl}
+	mock.recorder = &MockIWebhookDeliveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookDelivery) EXPECT() *MockIWebhookDeliveryMockRecorder {
+	return m.recorder
+}
+
+// ByWebhookId mocks base method.
+func (m *MockIWebhookDelivery) ByWebhookId(ctx context.Context, webhookId uint64, limit, offset int, sort storage0.SortOrder) ([]storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByWebhookId", ctx, webhookId, limit, offset, sort)
+	ret0, _ := ret[0].([]storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByWebhookId indicates an expected call of ByWebhookId.
+func (mr *MockIWebhookDeliveryMockRecorder) ByWebhookId(ctx, webhookId, limit, offset, sort any) *MockIWebhookDeliveryByWebhookIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByWebhookId", reflect.TypeOf((*MockIWebhookDelivery)(nil).ByWebhookId), ctx, webhookId, limit, offset, sort)
+	return &MockIWebhookDeliveryByWebhookIdCall{Call: call}
+}
+
+// MockIWebhookDeliveryByWebhookIdCall wrap *gomock.Call
+type MockIWebhookDeliveryByWebhookIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryByWebhookIdCall) Return(arg0 []storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryByWebhookIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryByWebhookIdCall) Do(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryByWebhookIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryByWebhookIdCall) DoAndReturn(f func(context.Context, uint64, int, int, storage0.SortOrder) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryByWebhookIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWebhookDelivery) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWebhookDeliveryMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIWebhookDeliveryCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWebhookDelivery)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIWebhookDeliveryCursorListCall{Call: call}
+}
+
+// MockIWebhookDeliveryCursorListCall wrap *gomock.Call
+type MockIWebhookDeliveryCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryCursorListCall) Return(arg0 []*storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeadLetters mocks base method.
+func (m *MockIWebhookDelivery) DeadLetters(ctx context.Context, limit, offset int) ([]storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetters", ctx, limit, offset)
+	ret0, _ := ret[0].([]storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetters indicates an expected call of DeadLetters.
+func (mr *MockIWebhookDeliveryMockRecorder) DeadLetters(ctx, limit, offset any) *MockIWebhookDeliveryDeadLettersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetters", reflect.TypeOf((*MockIWebhookDelivery)(nil).DeadLetters), ctx, limit, offset)
+	return &MockIWebhookDeliveryDeadLettersCall{Call: call}
+}
+
+// MockIWebhookDeliveryDeadLettersCall wrap *gomock.Call
+type MockIWebhookDeliveryDeadLettersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryDeadLettersCall) Return(arg0 []storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryDeadLettersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryDeadLettersCall) Do(f func(context.Context, int, int) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryDeadLettersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryDeadLettersCall) DoAndReturn(f func(context.Context, int, int) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryDeadLettersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWebhookDelivery) GetByID(ctx context.Context, id uint64) (*storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWebhookDeliveryMockRecorder) GetByID(ctx, id any) *MockIWebhookDeliveryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWebhookDelivery)(nil).GetByID), ctx, id)
+	return &MockIWebhookDeliveryGetByIDCall{Call: call}
+}
+
+// MockIWebhookDeliveryGetByIDCall wrap *gomock.Call
+type MockIWebhookDeliveryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryGetByIDCall) Return(arg0 *storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryGetByIDCall) Do(f func(context.Context, uint64) (*storage.WebhookDelivery, error)) *MockIWebhookDeliveryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.WebhookDelivery, error)) *MockIWebhookDeliveryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWebhookDelivery) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWebhookDeliveryMockRecorder) IsNoRows(err any) *MockIWebhookDeliveryIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWebhookDelivery)(nil).IsNoRows), err)
+	return &MockIWebhookDeliveryIsNoRowsCall{Call: call}
+}
+
+// MockIWebhookDeliveryIsNoRowsCall wrap *gomock.Call
+type MockIWebhookDeliveryIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryIsNoRowsCall) Return(arg0 bool) *MockIWebhookDeliveryIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryIsNoRowsCall) Do(f func(error) bool) *MockIWebhookDeliveryIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIWebhookDeliveryIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWebhookDelivery) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWebhookDeliveryMockRecorder) LastID(ctx any) *MockIWebhookDeliveryLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWebhookDelivery)(nil).LastID), ctx)
+	return &MockIWebhookDeliveryLastIDCall{Call: call}
+}
+
+// MockIWebhookDeliveryLastIDCall wrap *gomock.Call
+type MockIWebhookDeliveryLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryLastIDCall) Return(arg0 uint64, arg1 error) *MockIWebhookDeliveryLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIWebhookDeliveryLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIWebhookDeliveryLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWebhookDelivery) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWebhookDeliveryMockRecorder) List(ctx, limit, offset, order any) *MockIWebhookDeliveryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWebhookDelivery)(nil).List), ctx, limit, offset, order)
+	return &MockIWebhookDeliveryListCall{Call: call}
+}
+
+// MockIWebhookDeliveryListCall wrap *gomock.Call
+type MockIWebhookDeliveryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryListCall) Return(arg0 []*storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookDelivery, error)) *MockIWebhookDeliveryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pending mocks base method.
+func (m *MockIWebhookDelivery) Pending(ctx context.Context, now, lockUntil time.Time, limit int) ([]storage.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx, now, lockUntil, limit)
+	ret0, _ := ret[0].([]storage.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockIWebhookDeliveryMockRecorder) Pending(ctx, now, lockUntil, limit any) *MockIWebhookDeliveryPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockIWebhookDelivery)(nil).Pending), ctx, now, lockUntil, limit)
+	return &MockIWebhookDeliveryPendingCall{Call: call}
+}
+
+// MockIWebhookDeliveryPendingCall wrap *gomock.Call
+type MockIWebhookDeliveryPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryPendingCall) Return(arg0 []storage.WebhookDelivery, arg1 error) *MockIWebhookDeliveryPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryPendingCall) Do(f func(context.Context, time.Time, time.Time, int) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryPendingCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]storage.WebhookDelivery, error)) *MockIWebhookDeliveryPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWebhookDelivery) Save(ctx context.Context, m *storage.WebhookDelivery) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWebhookDeliveryMockRecorder) Save(ctx, m any) *MockIWebhookDeliverySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWebhookDelivery)(nil).Save), ctx, m)
+	return &MockIWebhookDeliverySaveCall{Call: call}
+}
+
+// MockIWebhookDeliverySaveCall wrap *gomock.Call
+type MockIWebhookDeliverySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliverySaveCall) Return(arg0 error) *MockIWebhookDeliverySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliverySaveCall) Do(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliverySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliverySaveCall) DoAndReturn(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliverySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWebhookDelivery) Update(ctx context.Context, m *storage.WebhookDelivery) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWebhookDeliveryMockRecorder) Update(ctx, m any) *MockIWebhookDeliveryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWebhookDelivery)(nil).Update), ctx, m)
+	return &MockIWebhookDeliveryUpdateCall{Call: call}
+}
+
+// MockIWebhookDeliveryUpdateCall wrap *gomock.Call
+type MockIWebhookDeliveryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookDeliveryUpdateCall) Return(arg0 error) *MockIWebhookDeliveryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookDeliveryUpdateCall) Do(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliveryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookDeliveryUpdateCall) DoAndReturn(f func(context.Context, *storage.WebhookDelivery) error) *MockIWebhookDeliveryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_state.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_state.go -destination=mock/webhook_state.go -package=mock -typed
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/celenium-io/astria-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWebhookState is a mock of IWebhookState interface.
+type MockIWebhookState struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookStateMockRecorder
+}
+
+// MockIWebhookStateMockRecorder is the mock recorder for MockIWebhookState.
+type MockIWebhookStateMockRecorder struct {
+	mock *MockIWebhookState
+}
+
+// NewMockIWebhookState creates a new mock instance.
+func NewMockIWebhookState(ctrl *gomock.Controller) *MockIWebhookState {
+	mock := &MockIWebhookState{ctrl: ctrl}
+	mock.recorder = &MockIWebhookStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookState) EXPECT() *MockIWebhookStateMockRecorder {
+	return m.recorder
+}
+
+// ByName mocks base method.
+func (m *MockIWebhookState) ByName(ctx context.Context, name string) (storage.WebhookState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByName", ctx, name)
+	ret0, _ := ret[0].(storage.WebhookState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByName indicates an expected call of ByName.
+func (mr *MockIWebhookStateMockRecorder) ByName(ctx, name any) *MockIWebhookStateByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByName", reflect.TypeOf((*MockIWebhookState)(nil).ByName), ctx, name)
+	return &MockIWebhookStateByNameCall{Call: call}
+}
+
+// MockIWebhookStateByNameCall wrap *gomock.Call
+type MockIWebhookStateByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateByNameCall) Return(arg0 storage.WebhookState, arg1 error) *MockIWebhookStateByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateByNameCall) Do(f func(context.Context, string) (storage.WebhookState, error)) *MockIWebhookStateByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateByNameCall) DoAndReturn(f func(context.Context, string) (storage.WebhookState, error)) *MockIWebhookStateByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIWebhookState) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.WebhookState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.WebhookState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIWebhookStateMockRecorder) CursorList(ctx, id, limit, order, cmp any) *MockIWebhookStateCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIWebhookState)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &MockIWebhookStateCursorListCall{Call: call}
+}
+
+// MockIWebhookStateCursorListCall wrap *gomock.Call
+type MockIWebhookStateCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateCursorListCall) Return(arg0 []*storage.WebhookState, arg1 error) *MockIWebhookStateCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookState, error)) *MockIWebhookStateCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.WebhookState, error)) *MockIWebhookStateCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIWebhookState) GetByID(ctx context.Context, id uint64) (*storage.WebhookState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.WebhookState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIWebhookStateMockRecorder) GetByID(ctx, id any) *MockIWebhookStateGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIWebhookState)(nil).GetByID), ctx, id)
+	return &MockIWebhookStateGetByIDCall{Call: call}
+}
+
+// MockIWebhookStateGetByIDCall wrap *gomock.Call
+type MockIWebhookStateGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateGetByIDCall) Return(arg0 *storage.WebhookState, arg1 error) *MockIWebhookStateGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateGetByIDCall) Do(f func(context.Context, uint64) (*storage.WebhookState, error)) *MockIWebhookStateGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.WebhookState, error)) *MockIWebhookStateGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIWebhookState) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIWebhookStateMockRecorder) IsNoRows(err any) *MockIWebhookStateIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIWebhookState)(nil).IsNoRows), err)
+	return &MockIWebhookStateIsNoRowsCall{Call: call}
+}
+
+// MockIWebhookStateIsNoRowsCall wrap *gomock.Call
+type MockIWebhookStateIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateIsNoRowsCall) Return(arg0 bool) *MockIWebhookStateIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateIsNoRowsCall) Do(f func(error) bool) *MockIWebhookStateIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateIsNoRowsCall) DoAndReturn(f func(error) bool) *MockIWebhookStateIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIWebhookState) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIWebhookStateMockRecorder) LastID(ctx any) *MockIWebhookStateLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIWebhookState)(nil).LastID), ctx)
+	return &MockIWebhookStateLastIDCall{Call: call}
+}
+
+// MockIWebhookStateLastIDCall wrap *gomock.Call
+type MockIWebhookStateLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateLastIDCall) Return(arg0 uint64, arg1 error) *MockIWebhookStateLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateLastIDCall) Do(f func(context.Context) (uint64, error)) *MockIWebhookStateLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *MockIWebhookStateLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIWebhookState) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.WebhookState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.WebhookState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIWebhookStateMockRecorder) List(ctx, limit, offset, order any) *MockIWebhookStateListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIWebhookState)(nil).List), ctx, limit, offset, order)
+	return &MockIWebhookStateListCall{Call: call}
+}
+
+// MockIWebhookStateListCall wrap *gomock.Call
+type MockIWebhookStateListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateListCall) Return(arg0 []*storage.WebhookState, arg1 error) *MockIWebhookStateListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookState, error)) *MockIWebhookStateListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.WebhookState, error)) *MockIWebhookStateListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIWebhookState) Save(ctx context.Context, m *storage.WebhookState) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIWebhookStateMockRecorder) Save(ctx, m any) *MockIWebhookStateSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIWebhookState)(nil).Save), ctx, m)
+	return &MockIWebhookStateSaveCall{Call: call}
+}
+
+// MockIWebhookStateSaveCall wrap *gomock.Call
+type MockIWebhookStateSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateSaveCall) Return(arg0 error) *MockIWebhookStateSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateSaveCall) Do(f func(context.Context, *storage.WebhookState) error) *MockIWebhookStateSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateSaveCall) DoAndReturn(f func(context.Context, *storage.WebhookState) error) *MockIWebhookStateSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIWebhookState) Update(ctx context.Context, m *storage.WebhookState) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIWebhookStateMockRecorder) Update(ctx, m any) *MockIWebhookStateUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIWebhookState)(nil).Update), ctx, m)
+	return &MockIWebhookStateUpdateCall{Call: call}
+}
+
+// MockIWebhookStateUpdateCall wrap *gomock.Call
+type MockIWebhookStateUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIWebhookStateUpdateCall) Return(arg0 error) *MockIWebhookStateUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIWebhookStateUpdateCall) Do(f func(context.Context, *storage.WebhookState) error) *MockIWebhookStateUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIWebhookStateUpdateCall) DoAndReturn(f func(context.Context, *storage.WebhookState) error) *MockIWebhookStateUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
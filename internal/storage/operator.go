@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+// roles of private API operators
+const (
+	OperatorRoleAdmin     = "admin"
+	OperatorRoleAppEditor = "app_editor"
+)
+
+// OperatorRoles - list of supported operator roles
+var OperatorRoles = []string{
+	OperatorRoleAdmin,
+	OperatorRoleAppEditor,
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IOperator interface {
+	storage.Table[*Operator]
+
+	ByHash(ctx context.Context, hash string) (Operator, error)
+}
+
+// Operator - named credential of the private API. Only SHA-256 hash of the key is stored.
+type Operator struct {
+	bun.BaseModel `bun:"operator" comment:"Table with private API operators"`
+
+	Id        uint64    `bun:"id,pk,notnull,autoincrement"                 comment:"Unique internal identity"`
+	Name      string    `bun:"name,unique:operator_name,notnull,type:text" comment:"Operator name"`
+	Role      string    `bun:"role,notnull,type:text"                      comment:"Operator role"`
+	KeyHash   string    `bun:"key_hash,unique:operator_key_hash"           comment:"SHA-256 hash of the key"`
+	Active    bool      `bun:"active,notnull"                              comment:"Is operator active"`
+	CreatedAt time.Time `bun:"created_at,notnull"                          comment:"Creation time"`
+}
+
+// TableName -
+func (Operator) TableName() string {
+	return "operator"
+}
+
+// HasRole - checks whether operator has one of the roles. Admin has all roles.
+func (o Operator) HasRole(roles ...string) bool {
+	return o.Role == OperatorRoleAdmin || slices.Contains(roles, o.Role)
+}
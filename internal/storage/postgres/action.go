@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
@@ -85,6 +86,9 @@ func (a *Action) ByAddress(ctx context.Context, addressId uint64, filters storag
 	if filters.ActionTypes.Bits > 0 {
 		subQuery = subQuery.Where("action_type IN (?)", bun.In(filters.ActionTypes.Strings()))
 	}
+	if filters.MaxHeight > 0 {
+		subQuery = subQuery.Where("height <= ?", filters.MaxHeight)
+	}
 
 	subQuery = sortScope(subQuery, "action_id", filters.Sort)
 	subQuery = limitScope(subQuery, filters.Limit)
@@ -104,14 +108,18 @@ func (a *Action) ByAddress(ctx context.Context, addressId uint64, filters storag
 	return
 }
 
-func (a *Action) ByRollup(ctx context.Context, rollupId uint64, limit, offset int, sort sdk.SortOrder) (actions []storage.RollupAction, err error) {
+func (a *Action) ByRollup(ctx context.Context, rollupId uint64, fltrs storage.RollupActionsFilter) (actions []storage.RollupAction, err error) {
 	subQuery := a.DB().NewSelect().
 		Model((*storage.RollupAction)(nil)).
 		Where("rollup_id = ?", rollupId)
 
-	subQuery = sortScope(subQuery, "action_id", sort)
-	subQuery = limitScope(subQuery, limit)
-	subQuery = offsetScope(subQuery, offset)
+	if fltrs.MaxHeight > 0 {
+		subQuery = subQuery.Where("height <= ?", fltrs.MaxHeight)
+	}
+
+	subQuery = sortScope(subQuery, "action_id", fltrs.Sort)
+	subQuery = limitScope(subQuery, fltrs.Limit)
+	subQuery = offsetScope(subQuery, fltrs.Offset)
 
 	query := a.DB().NewSelect().
 		TableExpr("(?) as rollup_action", subQuery).
@@ -122,7 +130,7 @@ func (a *Action) ByRollup(ctx context.Context, rollupId uint64, limit, offset in
 		Join("left join tx on tx.id = rollup_action.tx_id").
 		Join("left join action on action.id = rollup_action.action_id").
 		Join("left join fee on fee.action_id = rollup_action.action_id")
-	query = sortScope(query, "action_id", sort)
+	query = sortScope(query, "action_id", fltrs.Sort)
 	err = query.Scan(ctx, &actions)
 	return
 }
@@ -158,6 +166,10 @@ func (a *Action) ByRollupAndBridge(ctx context.Context, rollupId uint64, fltrs s
 		rollupActions = rollupActions.Where("action_type IN (?)", bun.In(fltrs.ActionTypes.Strings()))
 		addressActions = addressActions.Where("action_type IN (?)", bun.In(fltrs.ActionTypes.Strings()))
 	}
+	if fltrs.MaxHeight > 0 {
+		rollupActions = rollupActions.Where("height <= ?", fltrs.MaxHeight)
+		addressActions = addressActions.Where("height <= ?", fltrs.MaxHeight)
+	}
 
 	var subQuery *bun.SelectQuery
 	switch {
@@ -188,3 +200,64 @@ func (a *Action) ByRollupAndBridge(ctx context.Context, rollupId uint64, fltrs s
 	err = query.Scan(ctx, &actions)
 	return
 }
+
+// withdrawalsByDestination - returns query of withdrawals from rollup bridges which are related to the rollup-side address.
+// ICS20 withdrawal from rollup is linked exactly: rollup-side sender is extracted from its memo at index time.
+// Bridge unlock doesn't contain rollup-side sender, so it's only possibly related if it's sent to the sequencer address which signed deposit to the destination.
+// Exact and possible withdrawals are returned separately according to the exact flag.
+func (a *Action) withdrawalsByDestination(rollupId uint64, destination string, exact bool) *bun.SelectQuery {
+	query := a.DB().NewSelect().
+		Model((*storage.AddressAction)(nil)).
+		ColumnExpr("address_action.action_id, address_action.time, address_action.tx_id").
+		ColumnExpr("(case when address_action.action_type = ? then bridge.asset else action.data->>'denom' end) as asset", storageTypes.ActionTypeBridgeUnlock).
+		ColumnExpr("(action.data->>'amount')::numeric as amount").
+		Join("inner join bridge on bridge.address_id = address_action.address_id").
+		Join("inner join action on action.id = address_action.action_id and action.time = address_action.time").
+		Where("bridge.rollup_id = ?", rollupId)
+
+	if exact {
+		return query.
+			Where("address_action.action_type = ?", storageTypes.ActionTypeIcs20Withdrawal).
+			Where("lower(action.rollup_return_address) = lower(?)", destination)
+	}
+
+	signers := a.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		ColumnExpr("tx.signer_id").
+		Join("left join tx on tx.id = deposit.tx_id").
+		Where("deposit.rollup_id = ?", rollupId).
+		Where("lower(deposit.destination_chain_address) = lower(?)", destination)
+
+	return query.
+		Where("address_action.action_type = ?", storageTypes.ActionTypeBridgeUnlock).
+		Where("exists (select 1 from address_action as recipient where recipient.action_id = address_action.action_id and recipient.address_id <> address_action.address_id and recipient.address_id IN (?))", signers)
+}
+
+func (a *Action) WithdrawalsByDestination(ctx context.Context, rollupId uint64, destination string, exact bool, limit, offset int, sort sdk.SortOrder) (actions []storage.ActionWithTx, err error) {
+	subQuery := a.withdrawalsByDestination(rollupId, destination, exact)
+	subQuery = sortScope(subQuery, "address_action.time", sort)
+	subQuery = limitScope(subQuery, limit)
+	subQuery = offsetScope(subQuery, offset)
+
+	query := a.DB().NewSelect().
+		TableExpr("(?) as withdrawal", subQuery).
+		ColumnExpr("fee.asset as fee__asset, fee.amount as fee__amount").
+		ColumnExpr("action.*").
+		ColumnExpr("tx.hash as tx__hash").
+		Join("left join tx on tx.id = withdrawal.tx_id").
+		Join("left join action on action.id = withdrawal.action_id").
+		Join("left join fee on fee.action_id = withdrawal.action_id")
+	query = sortScope(query, "withdrawal.time", sort)
+	err = query.Scan(ctx, &actions)
+	return
+}
+
+func (a *Action) WithdrawalTotalsByDestination(ctx context.Context, rollupId uint64, destination string, exact bool) (totals []storage.AssetTotal, err error) {
+	err = a.DB().NewSelect().
+		TableExpr("(?) as withdrawal", a.withdrawalsByDestination(rollupId, destination, exact)).
+		ColumnExpr("asset, sum(amount) as amount, count(*) as count").
+		Group("asset").
+		Order("asset").
+		Scan(ctx, &totals)
+	return
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
 )
 
 func (s *StorageTestSuite) TestActionByBlock() {
@@ -96,7 +97,10 @@ func (s *StorageTestSuite) TestActionByRollup() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
 
-	actions, err := s.Action.ByRollup(ctx, 1, 1, 0, sdk.SortOrderDesc)
+	actions, err := s.Action.ByRollup(ctx, 1, storage.RollupActionsFilter{
+		Limit: 1,
+		Sort:  sdk.SortOrderDesc,
+	})
 	s.Require().NoError(err)
 	s.Require().Len(actions, 1)
 
@@ -159,3 +163,73 @@ func (s *StorageTestSuite) TestActionByRollupAndBridgeWithoutRollupActions() {
 	s.Require().NotNil(action.Data)
 	s.Require().NotNil(action.Fee)
 }
+
+func (s *StorageTestSuite) TestWithdrawalsByDestination() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	const destination = "0xAbCdEf0123456789"
+	ts := time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC)
+	db := s.storage.Connection().DB()
+
+	tx := storage.Tx{Id: 100, Height: 8000, Time: ts, Hash: []byte{0x01, 0x00}, SignerId: 8, Status: types.StatusSuccess}
+	deposit := storage.Deposit{Id: 100, Height: 8000, Time: ts, BridgeId: 1, RollupId: 1, Asset: "nria", Amount: decimal.NewFromInt(70), DestinationChainAddress: destination, TxId: tx.Id}
+	actions := []storage.Action{
+		{Id: 101, Height: 8001, Time: ts.Add(time.Hour), Type: types.ActionTypeBridgeUnlock, Data: map[string]any{"amount": "30"}},
+		{Id: 102, Height: 8002, Time: ts.Add(2 * time.Hour), Type: types.ActionTypeIcs20Withdrawal, Data: map[string]any{"amount": "20", "denom": "transfer/channel-0/utia", "memo": `{"rollupReturnAddress":"0xabcdef0123456789"}`}, RollupReturnAddress: "0xabcdef0123456789"},
+		{Id: 103, Height: 8003, Time: ts.Add(3 * time.Hour), Type: types.ActionTypeBridgeUnlock, Data: map[string]any{"amount": "10"}},
+		{Id: 104, Height: 8004, Time: ts.Add(4 * time.Hour), Type: types.ActionTypeIcs20Withdrawal, Data: map[string]any{"amount": "5", "denom": "transfer/channel-0/utia", "memo": `{"rollupReturnAddress":"0x01","memo":"0xabcdef0123456789"}`}, RollupReturnAddress: "0x01"},
+	}
+	addressActions := []storage.AddressAction{
+		{AddressId: 1, ActionId: 101, Time: actions[0].Time, ActionType: types.ActionTypeBridgeUnlock},
+		{AddressId: 8, ActionId: 101, Time: actions[0].Time, ActionType: types.ActionTypeBridgeUnlock},
+		{AddressId: 1, ActionId: 102, Time: actions[1].Time, ActionType: types.ActionTypeIcs20Withdrawal},
+		{AddressId: 1, ActionId: 103, Time: actions[2].Time, ActionType: types.ActionTypeBridgeUnlock},
+		{AddressId: 3, ActionId: 103, Time: actions[2].Time, ActionType: types.ActionTypeBridgeUnlock},
+		{AddressId: 1, ActionId: 104, Time: actions[3].Time, ActionType: types.ActionTypeIcs20Withdrawal},
+	}
+
+	_, err := db.NewInsert().Model(&tx).Exec(ctx)
+	s.Require().NoError(err)
+	_, err = db.NewInsert().Model(&deposit).Exec(ctx)
+	s.Require().NoError(err)
+	_, err = db.NewInsert().Model(&actions).Exec(ctx)
+	s.Require().NoError(err)
+	_, err = db.NewInsert().Model(&addressActions).Exec(ctx)
+	s.Require().NoError(err)
+
+	withdrawals, err := s.Action.WithdrawalsByDestination(ctx, 1, destination, true, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(withdrawals, 1)
+	s.Require().EqualValues(102, withdrawals[0].Id)
+	s.Require().Equal(types.ActionTypeIcs20Withdrawal, withdrawals[0].Type)
+
+	possible, err := s.Action.WithdrawalsByDestination(ctx, 1, destination, false, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(possible, 1)
+	s.Require().EqualValues(101, possible[0].Id)
+	s.Require().Equal(types.ActionTypeBridgeUnlock, possible[0].Type)
+
+	totals, err := s.Action.WithdrawalTotalsByDestination(ctx, 1, destination, true)
+	s.Require().NoError(err)
+	s.Require().Len(totals, 1)
+	s.Require().EqualValues("transfer/channel-0/utia", totals[0].Asset)
+	s.Require().EqualValues("20", totals[0].Amount.String())
+	s.Require().EqualValues(1, totals[0].Count)
+
+	possibleTotals, err := s.Action.WithdrawalTotalsByDestination(ctx, 1, destination, false)
+	s.Require().NoError(err)
+	s.Require().Len(possibleTotals, 1)
+	s.Require().EqualValues("nria", possibleTotals[0].Asset)
+	s.Require().EqualValues("30", possibleTotals[0].Amount.String())
+	s.Require().EqualValues(1, possibleTotals[0].Count)
+
+	_, err = db.NewDelete().Model((*storage.AddressAction)(nil)).Where("action_id IN (101, 102, 103, 104)").Exec(ctx)
+	s.Require().NoError(err)
+	_, err = db.NewDelete().Model((*storage.Action)(nil)).Where("id IN (101, 102, 103, 104)").Exec(ctx)
+	s.Require().NoError(err)
+	_, err = db.NewDelete().Model((*storage.Deposit)(nil)).Where("id = 100").Exec(ctx)
+	s.Require().NoError(err)
+	_, err = db.NewDelete().Model((*storage.Tx)(nil)).Where("id = 100").Exec(ctx)
+	s.Require().NoError(err)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/uptrace/bun"
 )
@@ -36,6 +37,19 @@ func (a *Address) ByHash(ctx context.Context, hash string) (address storage.Addr
 	return
 }
 
+// ByHashes - returns addresses with the hashes. Order of addresses is not defined.
+func (a *Address) ByHashes(ctx context.Context, hashes []string) (addresses []storage.Address, err error) {
+	query := a.DB().NewSelect().
+		ColumnExpr("address.*").
+		Model(&addresses).
+		Where("hash IN (?)", bun.In(hashes)).
+		Relation("Balance")
+
+	query = joinCelestials(query, "", "address.id")
+	err = query.Scan(ctx)
+	return
+}
+
 func (a *Address) ListWithBalance(ctx context.Context, fltrs storage.AddressListFilter) (address []storage.Address, err error) {
 	query := a.DB().NewSelect().
 		ColumnExpr("address.*").
@@ -56,3 +70,28 @@ func (a *Address) ListWithBalance(ctx context.Context, fltrs storage.AddressList
 	err = query.Scan(ctx)
 	return
 }
+
+// ByHeight - returns addresses which were involved in actions, signed transactions or had balance updates in the block
+func (a *Address) ByHeight(ctx context.Context, height types.Level) (addresses []storage.Address, err error) {
+	actionsQuery := a.DB().NewSelect().
+		Model((*storage.AddressAction)(nil)).
+		Column("address_id").
+		Where("height = ?", height)
+
+	signersQuery := a.DB().NewSelect().
+		Model((*storage.Tx)(nil)).
+		ColumnExpr("signer_id as address_id").
+		Where("height = ?", height)
+
+	balancesQuery := a.DB().NewSelect().
+		Model((*storage.BalanceUpdate)(nil)).
+		Column("address_id").
+		Where("height = ?", height)
+
+	err = a.DB().NewSelect().
+		Model(&addresses).
+		Where("id IN (?)", actionsQuery.Union(signersQuery).Union(balancesQuery)).
+		Order("id asc").
+		Scan(ctx)
+	return
+}
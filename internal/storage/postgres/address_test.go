@@ -35,6 +35,33 @@ func (s *StorageTestSuite) TestAddressByHash() {
 	s.Require().EqualValues(2, address.Celestials.ChangeId)
 }
 
+func (s *StorageTestSuite) TestAddressByHashes() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.Address.ByHashes(ctx, []string{
+		"astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p",
+		"astria1lhpxecq5ffhq68dgu9s8y2g5h53jqw5cvudrkk",
+		"astria1unknown",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+
+	for i := range addresses {
+		switch addresses[i].Hash {
+		case "astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p":
+			s.Require().EqualValues(1, addresses[i].Id)
+			s.Require().Len(addresses[i].Balance, 2)
+			s.Require().NotNil(addresses[i].Celestials)
+			s.Require().EqualValues("name 2", addresses[i].Celestials.Id)
+		case "astria1lhpxecq5ffhq68dgu9s8y2g5h53jqw5cvudrkk":
+			s.Require().EqualValues(2, addresses[i].Id)
+		default:
+			s.T().Errorf("unexpected address: %s", addresses[i].Hash)
+		}
+	}
+}
+
 func (s *StorageTestSuite) TestAddressListWithBalances() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -92,3 +119,29 @@ func (s *StorageTestSuite) TestAddressListWithBalancesWithAsset() {
 	s.Require().EqualValues(types.CelestialsStatusVERIFIED, address.Celestials.Status)
 	s.Require().EqualValues(2, address.Celestials.ChangeId)
 }
+
+func (s *StorageTestSuite) TestAddressByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.Address.ByHeight(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+	s.Require().EqualValues(1, addresses[0].Id)
+	s.Require().EqualValues(8, addresses[1].Id)
+
+	addresses, err = s.Address.ByHeight(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 0)
+}
+
+func (s *StorageTestSuite) TestAddressByHeightBalanceUpdates() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.Address.ByHeight(ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+	s.Require().EqualValues(1, addresses[0].Id)
+	s.Require().EqualValues(2, addresses[1].Id)
+}
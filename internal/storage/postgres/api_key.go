@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// ApiKey -
+type ApiKey struct {
+	*postgres.Table[*storage.ApiKey]
+}
+
+// NewApiKey -
+func NewApiKey(db *postgres.Storage) *ApiKey {
+	return &ApiKey{
+		Table: postgres.NewTable[*storage.ApiKey](db.Connection()),
+	}
+}
+
+func (k *ApiKey) ByHash(ctx context.Context, hash string) (key storage.ApiKey, err error) {
+	err = k.DB().NewSelect().
+		Model(&key).
+		Relation("Tier").
+		Where("key_hash = ?", hash).
+		Limit(1).
+		Scan(ctx)
+	return
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+)
+
+func (s *StorageTestSuite) TestApiKeyByHash() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	key, err := s.ApiKeys.ByHash(ctx, storage.HashKey("ak_test"))
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, key.Id)
+	s.Require().Equal("partner", key.Name)
+	s.Require().True(key.Active)
+	s.Require().Nil(key.ExpiresAt)
+
+	s.Require().NotNil(key.Tier)
+	s.Require().Equal("partner", key.Tier.Name)
+	s.Require().EqualValues(50, key.Tier.Rate)
+	s.Require().EqualValues(0, key.Tier.DailyQuota)
+
+	_, err = s.ApiKeys.ByHash(ctx, storage.HashKey("unknown"))
+	s.Require().Error(err)
+	s.Require().True(s.ApiKeys.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestApiKeyUsageByApiKey() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	usage, err := s.ApiKeyUsage.ByApiKey(ctx, 1, time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC), time.Time{})
+	s.Require().NoError(err)
+	s.Require().Len(usage, 2)
+	s.Require().Equal("/v1/block", usage[0].Endpoint)
+	s.Require().EqualValues(50, usage[0].Requests)
+	s.Require().Equal("/v1/tx", usage[1].Endpoint)
+	s.Require().EqualValues(1, usage[1].Rejected)
+}
+
+func (s *StorageTestSuite) TestApiKeyUsageAdd() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	day := time.Date(2023, 12, 3, 0, 0, 0, 0, time.UTC)
+	err := s.ApiKeyUsage.Add(ctx, storage.ApiKeyUsage{
+		ApiKeyId: 1,
+		Day:      day,
+		Endpoint: "/v1/head",
+		Requests: 3,
+		Rejected: 1,
+	})
+	s.Require().NoError(err)
+
+	err = s.ApiKeyUsage.Add(ctx, storage.ApiKeyUsage{
+		ApiKeyId: 1,
+		Day:      day,
+		Endpoint: "/v1/head",
+		Requests: 2,
+	})
+	s.Require().NoError(err)
+
+	usage, err := s.ApiKeyUsage.ByApiKey(ctx, 1, day, day.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+	s.Require().Len(usage, 1)
+	s.Require().EqualValues(5, usage[0].Requests)
+	s.Require().EqualValues(1, usage[0].Rejected)
+
+	_, err = s.storage.Connection().DB().NewDelete().
+		Model((*storage.ApiKeyUsage)(nil)).
+		Where("day = ?", day).
+		Exec(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *StorageTestSuite) TestApiTierDeleteInUse() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.DeleteApiTier(ctx, 2)
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, storage.ErrValidation)
+	s.Require().NoError(tx.Rollback(ctx))
+
+	tier, err := s.ApiTiers.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal("partner", tier.Name)
+}
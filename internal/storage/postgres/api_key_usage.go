@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// ApiKeyUsage -
+type ApiKeyUsage struct {
+	db *postgres.Storage
+}
+
+// NewApiKeyUsage -
+func NewApiKeyUsage(db *postgres.Storage) *ApiKeyUsage {
+	return &ApiKeyUsage{
+		db: db,
+	}
+}
+
+// Add - increments counters of API keys usage
+func (u *ApiKeyUsage) Add(ctx context.Context, usage ...storage.ApiKeyUsage) error {
+	if len(usage) == 0 {
+		return nil
+	}
+	_, err := u.db.Connection().DB().NewInsert().
+		Model(&usage).
+		On("CONFLICT (api_key_id, day, endpoint) DO UPDATE").
+		Set("requests = api_key_usage.requests + EXCLUDED.requests").
+		Set("rejected = api_key_usage.rejected + EXCLUDED.rejected").
+		Exec(ctx)
+	return err
+}
+
+func (u *ApiKeyUsage) ByApiKey(ctx context.Context, apiKeyId uint64, from, to time.Time) (usage []storage.ApiKeyUsage, err error) {
+	query := u.db.Connection().DB().NewSelect().
+		Model(&usage).
+		Where("api_key_id = ?", apiKeyId)
+
+	if !from.IsZero() {
+		query = query.Where("day >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("day < ?", to)
+	}
+
+	err = query.
+		OrderExpr("day desc, endpoint asc").
+		Scan(ctx)
+	return
+}
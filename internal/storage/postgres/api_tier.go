@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// ApiTier -
+type ApiTier struct {
+	*postgres.Table[*storage.ApiTier]
+}
+
+// NewApiTier -
+func NewApiTier(db *postgres.Storage) *ApiTier {
+	return &ApiTier{
+		Table: postgres.NewTable[*storage.ApiTier](db.Connection()),
+	}
+}
@@ -6,8 +6,10 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
@@ -79,7 +81,7 @@ func (app *App) ByRollupId(ctx context.Context, rollupId uint64) (result storage
 		Table(storage.ViewLeaderboard).
 		ColumnExpr("leaderboard.*").
 		ColumnExpr("address.hash as bridge__hash").
-		Where("rollup_id = ?", rollupId).
+		Where("leaderboard.id IN (?)", app.appIdByRollupQuery(rollupId)).
 		Join("left join address on native_bridge_id = address.id").
 		Limit(1)
 
@@ -87,3 +89,206 @@ func (app *App) ByRollupId(ctx context.Context, rollupId uint64) (result storage
 	err = query.Scan(ctx, &result)
 	return
 }
+
+// appIdByRollupQuery - returns query of application identity which the rollup belongs to as primary or additional rollup
+func (app *App) appIdByRollupQuery(rollupId uint64) *bun.SelectQuery {
+	primary := app.DB().NewSelect().
+		Model((*storage.App)(nil)).
+		Column("id").
+		Where("rollup_id = ?", rollupId)
+
+	return app.DB().NewSelect().
+		Model((*storage.AppRollup)(nil)).
+		ColumnExpr("app_id as id").
+		Where("rollup_id = ?", rollupId).
+		UnionAll(primary)
+}
+
+func (app *App) IdByRollupId(ctx context.Context, rollupId uint64) (id uint64, err error) {
+	err = app.DB().NewSelect().
+		TableExpr("(?) as apps", app.appIdByRollupQuery(rollupId)).
+		Column("id").
+		Limit(1).
+		Scan(ctx, &id)
+	return
+}
+
+func (app *App) Rollups(ctx context.Context, appId uint64) (rollups []storage.AppRollup, err error) {
+	primary := app.DB().NewSelect().
+		Model((*storage.App)(nil)).
+		ColumnExpr("id as app_id, rollup_id, ? as role", storage.AppRolePrimary).
+		Where("id = ?", appId)
+
+	links := app.DB().NewSelect().
+		Model((*storage.AppRollup)(nil)).
+		ColumnExpr("app_id, rollup_id, role").
+		Where("app_id = ?", appId).
+		UnionAll(primary)
+
+	err = app.DB().NewSelect().
+		TableExpr("(?) as links", links).
+		ColumnExpr("links.*").
+		ColumnExpr("rollup.astria_id as rollup__astria_id").
+		Join("left join rollup on rollup.id = links.rollup_id").
+		OrderExpr("links.role = ? desc, links.rollup_id asc", storage.AppRolePrimary).
+		Scan(ctx, &rollups)
+	return
+}
+
+func (app *App) Bridges(ctx context.Context, appId uint64) (bridges []storage.AppBridge, err error) {
+	native := app.DB().NewSelect().
+		Model((*storage.App)(nil)).
+		ColumnExpr("id as app_id, native_bridge_id as bridge_id, ? as role", storage.AppRoleNative).
+		Where("id = ?", appId).
+		Where("native_bridge_id > 0")
+
+	links := app.DB().NewSelect().
+		Model((*storage.AppBridge)(nil)).
+		ColumnExpr("app_id, bridge_id, role").
+		Where("app_id = ?", appId).
+		UnionAll(native)
+
+	query := app.DB().NewSelect().
+		TableExpr("(?) as links", links).
+		ColumnExpr("links.*").
+		ColumnExpr("address.hash as bridge__hash").
+		Join("left join address on address.id = links.bridge_id").
+		OrderExpr("links.role = ? desc, links.bridge_id asc", storage.AppRoleNative)
+	query = joinCelestials(query, "bridge__", "links.bridge_id")
+
+	err = query.Scan(ctx, &bridges)
+	return
+}
+
+// submissionsQuery - returns data submissions of the application rollups with delay in seconds since previous submission of any of them
+func (app *App) submissionsQuery(rollupIds []uint64, from, to time.Time) *bun.SelectQuery {
+	query := app.DB().NewSelect().
+		Model((*storage.RollupAction)(nil)).
+		ColumnExpr("time, height, tx_id, size").
+		ColumnExpr("lag(time) over (order by time) as prev_time").
+		ColumnExpr("lag(height) over (order by time) as prev_height").
+		ColumnExpr("extract(epoch from time - lag(time) over (order by time)) as delay").
+		Where("rollup_id IN (?)", bun.In(rollupIds)).
+		Where("action_type = ?", types.ActionTypeRollupDataSubmission)
+
+	if !from.IsZero() {
+		query = query.Where("time >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("time < ?", to)
+	}
+	return query
+}
+
+func (app *App) Report(ctx context.Context, rollupIds []uint64, fltrs storage.AppReportFilter) (report storage.AppReport, err error) {
+	if len(rollupIds) == 0 {
+		return
+	}
+	submissions := app.submissionsQuery(rollupIds, fltrs.From, fltrs.To)
+
+	if err = app.DB().NewSelect().
+		TableExpr("(?) as submissions", submissions).
+		ColumnExpr("count(*) as actions_count, coalesce(sum(size), 0) as size").
+		ColumnExpr("min(time) as first_time, max(time) as last_time").
+		ColumnExpr("coalesce(avg(delay), 0) as avg_interval, coalesce(stddev_pop(delay), 0) as interval_jitter").
+		Scan(ctx, &report); err != nil {
+		return
+	}
+
+	if fltrs.GapThreshold > 0 {
+		gapsQuery := app.DB().NewSelect().
+			TableExpr("(?) as submissions", submissions).
+			ColumnExpr("prev_time as from_time, time as to_time").
+			ColumnExpr("prev_height as from_height, height as to_height").
+			ColumnExpr("delay as duration").
+			Where("delay > ?", fltrs.GapThreshold.Seconds()).
+			Order("delay desc")
+		gapsQuery = limitScope(gapsQuery, fltrs.GapsLimit)
+
+		if err = gapsQuery.Scan(ctx, &report.Gaps); err != nil {
+			return
+		}
+	}
+
+	feesQuery := app.DB().NewSelect().
+		Model((*storage.Fee)(nil)).
+		ColumnExpr("asset, sum(amount) as amount, count(*) as fee_count").
+		Where("rollup_id IN (?)", bun.In(rollupIds)).
+		Group("asset").
+		Order("amount desc")
+	if !fltrs.From.IsZero() {
+		feesQuery = feesQuery.Where("time >= ?", fltrs.From)
+	}
+	if !fltrs.To.IsZero() {
+		feesQuery = feesQuery.Where("time < ?", fltrs.To)
+	}
+	if err = feesQuery.Scan(ctx, &report.Fees); err != nil {
+		return
+	}
+
+	signers := app.DB().NewSelect().
+		TableExpr("(?) as submissions", submissions).
+		ColumnExpr("tx.signer_id, count(*) as actions_count, sum(submissions.size) as size").
+		Join("inner join tx on tx.id = submissions.tx_id").
+		Group("tx.signer_id")
+
+	query := app.DB().NewSelect().
+		TableExpr("(?) as signers", signers).
+		ColumnExpr("signers.*").
+		ColumnExpr("address.hash as signer__hash").
+		Join("left join address on address.id = signers.signer_id").
+		Order("actions_count desc")
+	query = joinCelestials(query, "signer__", "signers.signer_id")
+
+	err = query.Scan(ctx, &report.Signers)
+	return
+}
+
+type appReportDailyFee struct {
+	Time time.Time `bun:"ts"`
+	storage.AppReportFee
+}
+
+func (app *App) ReportSeries(ctx context.Context, rollupIds []uint64, req storage.SeriesRequest) (items []storage.AppReportItem, err error) {
+	if len(rollupIds) == 0 {
+		return
+	}
+	if err = app.DB().NewSelect().
+		TableExpr("(?) as submissions", app.submissionsQuery(rollupIds, req.From, req.To)).
+		ColumnExpr("time_bucket('1 day'::interval, time) as ts").
+		ColumnExpr("count(*) as actions_count, sum(size) as size").
+		ColumnExpr("coalesce(avg(delay), 0) as avg_interval, coalesce(stddev_pop(delay), 0) as interval_jitter").
+		Group("ts").
+		Order("ts desc").
+		Scan(ctx, &items); err != nil {
+		return
+	}
+
+	feesQuery := app.DB().NewSelect().
+		Table(storage.ViewFeeRollupStatsByDay).
+		ColumnExpr("ts, asset, sum(amount) as amount, sum(fee_count) as fee_count").
+		Where("rollup_id IN (?)", bun.In(rollupIds)).
+		Group("ts", "asset")
+	if !req.From.IsZero() {
+		feesQuery = feesQuery.Where("ts >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		feesQuery = feesQuery.Where("ts < ?", req.To)
+	}
+
+	var fees []appReportDailyFee
+	if err = feesQuery.Scan(ctx, &fees); err != nil {
+		return
+	}
+
+	index := make(map[int64]int, len(items))
+	for i := range items {
+		index[items[i].Time.Unix()] = i
+	}
+	for i := range fees {
+		if idx, ok := index[fees[i].Time.Unix()]; ok {
+			items[idx].Fees = append(items[idx].Fees, fees[i].AppReportFee)
+		}
+	}
+	return
+}
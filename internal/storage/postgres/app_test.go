@@ -137,6 +137,7 @@ func (s *StorageTestSuite) TestAppByRollupId() {
 	s.Require().NotNil(app.Bridge)
 	s.Require().EqualValues("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", app.Bridge.Hash)
 	s.Require().Nil(app.Rollup)
+	s.Require().Equal([]uint64{1}, app.RollupIds)
 
 	s.Require().NotNil(app.Bridge.Celestials)
 	s.Require().EqualValues("name 2", app.Bridge.Celestials.Id)
@@ -144,3 +145,149 @@ func (s *StorageTestSuite) TestAppByRollupId() {
 	s.Require().EqualValues(types.CelestialsStatusVERIFIED, app.Bridge.Celestials.Status)
 	s.Require().EqualValues(2, app.Bridge.Celestials.ChangeId)
 }
+
+func (s *StorageTestSuite) TestAppByLinkedRollupId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Connection().Exec(ctx, "REFRESH MATERIALIZED VIEW leaderboard;")
+	s.Require().NoError(err)
+
+	app, err := s.App.ByRollupId(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues("App 1", app.Name)
+	s.Require().EqualValues(34, app.Size)
+}
+
+func (s *StorageTestSuite) TestAppIdByRollupId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, rollupId := range []uint64{1, 2} {
+		id, err := s.App.IdByRollupId(ctx, rollupId)
+		s.Require().NoError(err)
+		s.Require().EqualValues(1, id)
+	}
+
+	_, err := s.App.IdByRollupId(ctx, 100)
+	s.Require().Error(err)
+	s.Require().True(s.App.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestAppRollups() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	rollups, err := s.App.Rollups(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 2)
+
+	s.Require().EqualValues(1, rollups[0].RollupId)
+	s.Require().Equal(storage.AppRolePrimary, rollups[0].Role)
+	s.Require().NotNil(rollups[0].Rollup)
+	s.Require().EqualValues("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539", hex.EncodeToString(rollups[0].Rollup.AstriaId))
+
+	s.Require().EqualValues(2, rollups[1].RollupId)
+	s.Require().Equal("testnet", rollups[1].Role)
+	s.Require().NotNil(rollups[1].Rollup)
+}
+
+func (s *StorageTestSuite) TestAppBridges() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	bridges, err := s.App.Bridges(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(bridges, 1)
+
+	s.Require().EqualValues(2, bridges[0].BridgeId)
+	s.Require().Equal(storage.AppRoleNative, bridges[0].Role)
+	s.Require().NotNil(bridges[0].Bridge)
+	s.Require().EqualValues("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", bridges[0].Bridge.Hash)
+	s.Require().NotNil(bridges[0].Bridge.Celestials)
+	s.Require().EqualValues("name 2", bridges[0].Bridge.Celestials.Id)
+}
+
+func (s *StorageTestSuite) TestAppReport() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	report, err := s.App.Report(ctx, []uint64{1}, storage.AppReportFilter{
+		From:         time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+		GapThreshold: time.Hour,
+		GapsLimit:    10,
+	})
+	s.Require().NoError(err)
+
+	s.Require().EqualValues(34, report.Size)
+	s.Require().EqualValues(1, report.ActionsCount)
+	s.Require().False(report.FirstTime.IsZero())
+	s.Require().False(report.LastTime.IsZero())
+	s.Require().Zero(report.AvgInterval)
+	s.Require().Zero(report.IntervalJitter)
+	s.Require().Len(report.Gaps, 0)
+
+	s.Require().Len(report.Fees, 1)
+	s.Require().EqualValues("nria", report.Fees[0].Asset)
+	s.Require().EqualValues("100", report.Fees[0].Amount)
+	s.Require().EqualValues(1, report.Fees[0].FeeCount)
+
+	s.Require().Len(report.Signers, 1)
+	s.Require().EqualValues(1, report.Signers[0].SignerId)
+	s.Require().EqualValues(1, report.Signers[0].ActionsCount)
+	s.Require().EqualValues(34, report.Signers[0].Size)
+	s.Require().NotNil(report.Signers[0].Signer)
+	s.Require().NotEmpty(report.Signers[0].Signer.Hash)
+}
+
+func (s *StorageTestSuite) TestAppReportEmptyPeriod() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	report, err := s.App.Report(ctx, []uint64{1}, storage.AppReportFilter{
+		From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, report.Size)
+	s.Require().EqualValues(0, report.ActionsCount)
+	s.Require().Len(report.Fees, 0)
+	s.Require().Len(report.Signers, 0)
+}
+
+func (s *StorageTestSuite) TestAppReportSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	items, err := s.App.ReportSeries(ctx, []uint64{1}, storage.SeriesRequest{
+		From: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+
+	item := items[0]
+	s.Require().EqualValues(34, item.Size)
+	s.Require().EqualValues(1, item.ActionsCount)
+	s.Require().Len(item.Fees, 1)
+	s.Require().EqualValues("nria", item.Fees[0].Asset)
+	s.Require().EqualValues("100", item.Fees[0].Amount)
+}
+
+func (s *StorageTestSuite) TestAppReportSeveralRollups() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	report, err := s.App.Report(ctx, []uint64{1, 2}, storage.AppReportFilter{
+		From: time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(34, report.Size)
+	s.Require().EqualValues(1, report.ActionsCount)
+	s.Require().Len(report.Fees, 1)
+	s.Require().Len(report.Signers, 1)
+
+	report, err = s.App.Report(ctx, nil, storage.AppReportFilter{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, report.ActionsCount)
+}
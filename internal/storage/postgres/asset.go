@@ -9,6 +9,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 type Asset struct {
@@ -30,7 +31,8 @@ var validSortFieldsForAssetList = map[string]struct{}{
 	"supply":         {},
 }
 
-func (a *Asset) List(ctx context.Context, limit int, offset int, sortBy string, order sdk.SortOrder) (assets []storage.Asset, err error) {
+// assetQuery - returns query of assets statistics. Assets are taken from balances, so asset without holders is not returned.
+func (a *Asset) assetQuery() *bun.SelectQuery {
 	transferredQuery := a.db.Connection().DB().NewSelect().
 		Model((*storage.Transfer)(nil)).
 		ColumnExpr("asset, count(*) as c, sum(amount) as amount").
@@ -46,7 +48,7 @@ func (a *Asset) List(ctx context.Context, limit int, offset int, sortBy string,
 		ColumnExpr("currency, sum(total) as amount").
 		Group("currency")
 
-	query := a.db.Connection().DB().NewSelect().
+	return a.db.Connection().DB().NewSelect().
 		With("fees", feesQuery).
 		With("transferred", transferredQuery).
 		With("supply", supplyQuery).
@@ -59,7 +61,10 @@ func (a *Asset) List(ctx context.Context, limit int, offset int, sortBy string,
 		ColumnExpr("(case when transferred.c is NULL then 0 else transferred.c end) as transfer_count").
 		Join("left join transferred on supply.currency = transferred.asset").
 		Join("left join fees on supply.currency = fees.asset")
+}
 
+func (a *Asset) List(ctx context.Context, limit int, offset int, sortBy string, order sdk.SortOrder) (assets []storage.Asset, err error) {
+	query := a.assetQuery()
 	query = limitScope(query, limit)
 	query = offsetScope(query, offset)
 
@@ -72,3 +77,12 @@ func (a *Asset) List(ctx context.Context, limit int, offset int, sortBy string,
 	err = query.Scan(ctx, &assets)
 	return
 }
+
+// ByDenom - returns statistics of asset with the denom
+func (a *Asset) ByDenom(ctx context.Context, denom string) (asset storage.Asset, err error) {
+	err = a.assetQuery().
+		Where("supply.currency = ?", denom).
+		Limit(1).
+		Scan(ctx, &asset)
+	return
+}
@@ -59,3 +59,18 @@ func (s *StorageTestSuite) TestAssetList() {
 		s.Require().Equal(a.Supply.String(), assets[i].Supply.String(), a.Asset)
 	}
 }
+
+func (s *StorageTestSuite) TestAssetByDenom() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	asset, err := s.Asset.ByDenom(ctx, "asset-1")
+	s.Require().NoError(err)
+	s.Require().Equal("asset-1", asset.Asset)
+	s.Require().Equal("1", asset.Transferred.String())
+	s.Require().EqualValues(1, asset.TransferCount)
+	s.Require().Equal("10", asset.Supply.String())
+
+	_, err = s.Asset.ByDenom(ctx, "unknown")
+	s.Require().Error(err)
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+type Audit struct {
+	db *database.Bun
+}
+
+func NewAudit(conn *postgres.Storage) Audit {
+	return Audit{
+		db: conn.Connection(),
+	}
+}
+
+func (a Audit) BalanceMismatches(ctx context.Context, limit int) (result []storage.BalanceMismatch, err error) {
+	updates := a.db.DB().NewSelect().
+		Model((*storage.BalanceUpdate)(nil)).
+		Column("address_id", "currency").
+		ColumnExpr(`sum("update") as expected`).
+		Group("address_id", "currency")
+
+	query := a.db.DB().NewSelect().
+		With("updates", updates).
+		TableExpr("balance").
+		ColumnExpr("balance.id as address_id, balance.currency, balance.total").
+		ColumnExpr("COALESCE(updates.expected, 0) as expected").
+		ColumnExpr("address.hash").
+		Join("left join updates on updates.address_id = balance.id and updates.currency = balance.currency").
+		Join("left join address on address.id = balance.id").
+		Where("balance.total <> COALESCE(updates.expected, 0)").
+		OrderExpr("balance.id, balance.currency")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err = query.Scan(ctx, &result)
+	return
+}
+
+func (a Audit) AddressMismatches(ctx context.Context, limit int) (result []storage.AddressMismatch, err error) {
+	actions := a.db.DB().NewSelect().
+		Model((*storage.AddressAction)(nil)).
+		Column("address_id").
+		ColumnExpr("count(*) as actions_count").
+		Group("address_id")
+
+	txs := a.db.DB().NewSelect().
+		Model((*storage.Tx)(nil)).
+		Column("signer_id").
+		ColumnExpr("count(*) as signed_tx_count").
+		ColumnExpr("(array_agg(nonce order by id desc))[1] as nonce").
+		Group("signer_id")
+
+	query := a.db.DB().NewSelect().
+		With("actions", actions).
+		With("txs", txs).
+		TableExpr("address").
+		ColumnExpr("address.id, address.hash, address.actions_count, address.signed_tx_count, address.nonce").
+		ColumnExpr("COALESCE(actions.actions_count, 0) as expected_actions_count").
+		ColumnExpr("COALESCE(txs.signed_tx_count, 0) as expected_signed_tx_count").
+		ColumnExpr("COALESCE(txs.nonce, 0) as expected_nonce").
+		Join("left join actions on actions.address_id = address.id").
+		Join("left join txs on txs.signer_id = address.id").
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.
+				Where("address.actions_count <> COALESCE(actions.actions_count, 0)").
+				WhereOr("address.signed_tx_count <> COALESCE(txs.signed_tx_count, 0)").
+				WhereOr("address.nonce <> COALESCE(txs.nonce, 0)")
+		}).
+		OrderExpr("address.id")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err = query.Scan(ctx, &result)
+	return
+}
+
+func (a Audit) RollupMismatches(ctx context.Context, limit int) (result []storage.RollupMismatch, err error) {
+	actions := a.db.DB().NewSelect().
+		Model((*storage.RollupAction)(nil)).
+		Column("rollup_id").
+		ColumnExpr("count(*) as actions_count").
+		ColumnExpr("sum(size) as size").
+		Group("rollup_id")
+
+	bridges := a.db.DB().NewSelect().
+		Model((*storage.Bridge)(nil)).
+		Column("rollup_id").
+		ColumnExpr("count(*) as bridge_count").
+		Group("rollup_id")
+
+	query := a.db.DB().NewSelect().
+		With("actions", actions).
+		With("bridges", bridges).
+		TableExpr("rollup").
+		ColumnExpr("rollup.id, rollup.astria_id, rollup.actions_count, rollup.size, rollup.bridge_count").
+		ColumnExpr("COALESCE(actions.actions_count, 0) as expected_actions_count").
+		ColumnExpr("COALESCE(actions.size, 0) as expected_size").
+		ColumnExpr("COALESCE(bridges.bridge_count, 0) as expected_bridge_count").
+		Join("left join actions on actions.rollup_id = rollup.id").
+		Join("left join bridges on bridges.rollup_id = rollup.id").
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.
+				Where("rollup.actions_count <> COALESCE(actions.actions_count, 0)").
+				WhereOr("rollup.size <> COALESCE(actions.size, 0)").
+				WhereOr("rollup.bridge_count <> COALESCE(bridges.bridge_count, 0)")
+		}).
+		OrderExpr("rollup.id")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err = query.Scan(ctx, &result)
+	return
+}
+
+func (a Audit) ExpectedState(ctx context.Context, name string) (state storage.State, err error) {
+	err = a.db.DB().NewSelect().
+		Model(&state).
+		Column("id", "name", "last_height", "last_hash", "last_time", "chain_id", "total_validators").
+		ColumnExpr("(SELECT COALESCE(sum(tx_count), 0) FROM block_stats) as total_tx").
+		ColumnExpr("(SELECT COALESCE(sum(supply_change), 0) FROM block_stats) as total_supply").
+		ColumnExpr("(SELECT count(*) FROM address) as total_accounts").
+		ColumnExpr("(SELECT count(*) FROM rollup) as total_rollups").
+		ColumnExpr("(SELECT count(*) FROM bridge) as total_bridges").
+		ColumnExpr("(SELECT COALESCE(sum(size), 0) FROM rollup) as total_bytes").
+		Where("name = ?", name).
+		Scan(ctx)
+	return
+}
+
+func (a Audit) Addresses(ctx context.Context, fromId uint64, limit int) (result []storage.Address, err error) {
+	query := a.db.DB().NewSelect().
+		Model(&result).
+		Relation("Balance").
+		Where("address.id > ?", fromId).
+		Order("address.id asc")
+
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
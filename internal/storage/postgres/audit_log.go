@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// AuditLog -
+type AuditLog struct {
+	*postgres.Table[*storage.AuditLog]
+}
+
+// NewAuditLog -
+func NewAuditLog(db *postgres.Storage) *AuditLog {
+	return &AuditLog{
+		Table: postgres.NewTable[*storage.AuditLog](db.Connection()),
+	}
+}
+
+func (a *AuditLog) Filter(ctx context.Context, fltrs storage.AuditLogFilter) (logs []storage.AuditLog, err error) {
+	query := a.DB().NewSelect().
+		Model(&logs)
+
+	query = limitScope(query, fltrs.Limit)
+	query = offsetScope(query, fltrs.Offset)
+	query = sortScope(query, "id", fltrs.Sort)
+
+	if fltrs.OperatorId > 0 {
+		query = query.Where("operator_id = ?", fltrs.OperatorId)
+	}
+	if fltrs.Entity != "" {
+		query = query.Where("entity = ?", fltrs.Entity)
+	}
+	if fltrs.EntityId > 0 {
+		query = query.Where("entity_id = ?", fltrs.EntityId)
+	}
+	if fltrs.Action != "" {
+		query = query.Where("action = ?", fltrs.Action)
+	}
+	if !fltrs.TimeFrom.IsZero() {
+		query = query.Where("time >= ?", fltrs.TimeFrom)
+	}
+	if !fltrs.TimeTo.IsZero() {
+		query = query.Where("time < ?", fltrs.TimeTo)
+	}
+
+	err = query.Scan(ctx)
+	return
+}
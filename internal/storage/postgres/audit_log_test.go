@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestOperatorByHash() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	operator, err := s.Operators.ByHash(ctx, storage.HashKey("op_editor"))
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, operator.Id)
+	s.Require().Equal("editor", operator.Name)
+	s.Require().Equal(storage.OperatorRoleAppEditor, operator.Role)
+	s.Require().True(operator.Active)
+
+	_, err = s.Operators.ByHash(ctx, storage.HashKey("unknown"))
+	s.Require().Error(err)
+	s.Require().True(s.Operators.IsNoRows(err))
+}
+
+func (s *StorageTestSuite) TestAuditLogFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	logs, err := s.AuditLogs.Filter(ctx, storage.AuditLogFilter{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 3)
+	s.Require().EqualValues(3, logs[0].Id)
+	s.Require().Equal(storage.AuditActionDelete, logs[0].Action)
+	s.Require().Equal("admin", logs[0].OperatorName)
+	s.Require().JSONEq(`{"id":5}`, logs[0].Before)
+	s.Require().Empty(logs[0].After)
+
+	logs, err = s.AuditLogs.Filter(ctx, storage.AuditLogFilter{
+		Limit:    10,
+		Entity:   storage.AuditEntityApp,
+		EntityId: 1,
+		Action:   storage.AuditActionUpdate,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().EqualValues(2, logs[0].Id)
+	s.Require().JSONEq(`{"name":"App 1"}`, logs[0].Before)
+	s.Require().JSONEq(`{"name":"App"}`, logs[0].After)
+
+	logs, err = s.AuditLogs.Filter(ctx, storage.AuditLogFilter{
+		Limit:      10,
+		OperatorId: 2,
+		TimeFrom:   time.Date(2023, 12, 1, 10, 30, 0, 0, time.UTC),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().EqualValues(2, logs[0].Id)
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestAuditBalanceMismatches() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	mismatches, err := s.Audit.BalanceMismatches(ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(mismatches, 3)
+
+	s.Require().EqualValues(1, mismatches[0].AddressId)
+	s.Require().EqualValues("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", mismatches[0].Hash)
+	s.Require().EqualValues("asset-1", mismatches[0].Currency)
+	s.Require().EqualValues("10", mismatches[0].Total.String())
+	s.Require().EqualValues("0", mismatches[0].Expected.String())
+
+	s.Require().EqualValues(1, mismatches[1].AddressId)
+	s.Require().EqualValues("nria", mismatches[1].Currency)
+	s.Require().EqualValues("500000000000000000000", mismatches[1].Total.String())
+	s.Require().EqualValues("499999999999999999999", mismatches[1].Expected.String())
+
+	s.Require().EqualValues(9, mismatches[2].AddressId)
+	s.Require().EqualValues("asset-2", mismatches[2].Currency)
+}
+
+func (s *StorageTestSuite) TestAuditAddressMismatches() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	mismatches, err := s.Audit.AddressMismatches(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(mismatches, 1)
+
+	s.Require().EqualValues(1, mismatches[0].Id)
+	s.Require().EqualValues(1, mismatches[0].ActionsCount)
+	s.Require().EqualValues(2, mismatches[0].ExpectedActionsCount)
+	s.Require().EqualValues(2, mismatches[0].SignedTxCount)
+	s.Require().EqualValues(2, mismatches[0].ExpectedSignedTxCount)
+	s.Require().EqualValues(1, mismatches[0].Nonce)
+	s.Require().EqualValues(1, mismatches[0].ExpectedNonce)
+}
+
+func (s *StorageTestSuite) TestAuditRollupMismatches() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	mismatches, err := s.Audit.RollupMismatches(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(mismatches, 2)
+
+	s.Require().EqualValues(1, mismatches[0].Id)
+	s.Require().EqualValues(112, mismatches[0].Size)
+	s.Require().EqualValues(34, mismatches[0].ExpectedSize)
+	s.Require().EqualValues(1, mismatches[0].ActionsCount)
+	s.Require().EqualValues(1, mismatches[0].ExpectedActionsCount)
+	s.Require().EqualValues(0, mismatches[0].BridgeCount)
+	s.Require().EqualValues(1, mismatches[0].ExpectedBridgeCount)
+
+	s.Require().EqualValues(2, mismatches[1].Id)
+	s.Require().EqualValues(10, mismatches[1].ActionsCount)
+	s.Require().EqualValues(0, mismatches[1].ExpectedActionsCount)
+}
+
+func (s *StorageTestSuite) TestAuditExpectedState() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	state, err := s.Audit.ExpectedState(ctx, "dipdup_astria_indexer")
+	s.Require().NoError(err)
+	s.Require().EqualValues(7965, state.LastHeight)
+	s.Require().EqualValues(1, state.TotalTx)
+	s.Require().EqualValues(6, state.TotalAccounts)
+	s.Require().EqualValues(2, state.TotalRollups)
+	s.Require().EqualValues(1, state.TotalBridges)
+	s.Require().EqualValues(1232, state.TotalBytes)
+	s.Require().EqualValues("0", state.TotalSupply.String())
+}
+
+func (s *StorageTestSuite) TestAuditAddresses() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	addresses, err := s.Audit.Addresses(ctx, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Len(addresses, 2)
+
+	s.Require().EqualValues(2, addresses[0].Id)
+	s.Require().Len(addresses[0].Balance, 1)
+	s.Require().EqualValues(3, addresses[1].Id)
+}
@@ -50,6 +50,30 @@ func (b *Blocks) ByHeight(ctx context.Context, height types.Level, withStats boo
 	return
 }
 
+// ByHeights - returns blocks with the heights. Order of blocks is not defined.
+func (b *Blocks) ByHeights(ctx context.Context, heights []types.Level, withStats bool) (blocks []storage.Block, err error) {
+	subQuery := b.DB().NewSelect().
+		Model((*storage.Block)(nil)).
+		Where("height IN (?)", bun.In(heights))
+
+	query := b.DB().NewSelect().
+		TableExpr("(?) as block", subQuery).
+		ColumnExpr("block.*").
+		ColumnExpr("validator.id as proposer__id, validator.address as proposer__address, validator.name as proposer__name").
+		Join("left join validator on block.proposer_id = validator.id")
+
+	if withStats {
+		query = query.
+			ColumnExpr("stats.id AS stats__id, stats.height AS stats__height, stats.time AS stats__time, stats.tx_count AS stats__tx_count").
+			ColumnExpr("stats.block_time AS stats__block_time, stats.bytes_in_block AS stats__bytes_in_block").
+			ColumnExpr("stats.supply_change AS stats__supply_change").
+			Join("left join block_stats as stats ON stats.height = block.height AND stats.time = block.time")
+	}
+
+	err = query.Scan(ctx, &blocks)
+	return
+}
+
 // Last -
 func (b *Blocks) Last(ctx context.Context) (block storage.Block, err error) {
 	err = b.DB().NewSelect().Model(&block).
@@ -104,6 +128,35 @@ func (b *Blocks) ListWithStats(ctx context.Context, limit, offset uint64, order
 	return
 }
 
+// Filter -
+func (b *Blocks) Filter(ctx context.Context, fltrs storage.BlockListFilter) (blocks []*storage.Block, err error) {
+	subQuery := b.DB().NewSelect().Model(&blocks)
+	if fltrs.MaxHeight > 0 {
+		subQuery = subQuery.Where("height <= ?", fltrs.MaxHeight)
+	}
+	subQuery = sortScope(subQuery, "block.time", fltrs.Sort)
+	subQuery = limitScope(subQuery, int(fltrs.Limit))
+	subQuery = offsetScope(subQuery, int(fltrs.Offset))
+
+	if !fltrs.WithStats {
+		err = subQuery.Scan(ctx)
+		return
+	}
+
+	query := b.DB().NewSelect().
+		ColumnExpr("block.*").
+		ColumnExpr("v.id AS proposer__id, v.address as proposer__address, v.name as proposer__name").
+		ColumnExpr("stats.id AS stats__id, stats.height AS stats__height, stats.time AS stats__time, stats.tx_count AS stats__tx_count").
+		ColumnExpr("stats.block_time AS stats__block_time, stats.bytes_in_block AS stats__bytes_in_block").
+		ColumnExpr("stats.supply_change AS stats__supply_change").
+		TableExpr("(?) as block", subQuery).
+		Join("LEFT JOIN block_stats as stats ON stats.height = block.height AND stats.time = block.time").
+		Join("LEFT JOIN validator as v ON v.id = block.proposer_id")
+	query = sortScope(query, "block.time", fltrs.Sort)
+	err = query.Scan(ctx, &blocks)
+	return
+}
+
 func (b *Blocks) ByProposer(ctx context.Context, proposerId uint64, limit, offset int, order sdk.SortOrder) (blocks []storage.Block, err error) {
 	subQuery := b.DB().NewSelect().Model(&blocks).
 		Where("proposer_id = ?", proposerId)
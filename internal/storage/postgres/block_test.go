@@ -8,6 +8,8 @@ import (
 	"encoding/hex"
 	"time"
 
+	indexerStorage "github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
@@ -31,6 +33,24 @@ func (s *StorageTestSuite) TestBlockByHeight() {
 	s.Require().Nil(block.Stats)
 }
 
+func (s *StorageTestSuite) TestBlockByHeights() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	blocks, err := s.Blocks.ByHeights(ctx, []types.Level{7964, 7965, 1}, true)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 2)
+
+	for i := range blocks {
+		s.Require().Contains([]types.Level{7964, 7965}, blocks[i].Height)
+		if blocks[i].Height == 7965 {
+			s.Require().NotNil(blocks[i].Proposer)
+			s.Require().EqualValues("astria1475jkpuvznd44szgfz8wwdf9w6xh5dx9jwqgvz", blocks[i].Proposer.Address)
+			s.Require().NotNil(blocks[i].Stats)
+		}
+	}
+}
+
 func (s *StorageTestSuite) TestBlockByHeightWithStats() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -113,6 +133,33 @@ func (s *StorageTestSuite) TestBlockListWithStats() {
 	s.Require().NotNil(block.Stats)
 }
 
+func (s *StorageTestSuite) TestBlockFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	blocks, err := s.Blocks.Filter(ctx, indexerStorage.BlockListFilter{
+		Limit:     10,
+		Sort:      storage.SortOrderDesc,
+		MaxHeight: 7964,
+		WithStats: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 1)
+
+	block := blocks[0]
+	s.Require().EqualValues(7964, block.Height)
+	s.Require().NotNil(block.Proposer)
+	s.Require().NotNil(block.Stats)
+
+	blocks, err = s.Blocks.Filter(ctx, indexerStorage.BlockListFilter{
+		Limit: 10,
+		Sort:  storage.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 2)
+	s.Require().Nil(blocks[0].Stats)
+}
+
 func (s *StorageTestSuite) TestBlockByProposer() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
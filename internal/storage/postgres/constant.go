@@ -10,6 +10,7 @@ import (
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 )
 
@@ -45,6 +46,56 @@ func (constant *Constant) All(ctx context.Context) (c []storage.Constant, err er
 	return
 }
 
+// AtHeight - returns constants at the height. Current values are replaced by values before the first change after the height.
+func (constant *Constant) AtHeight(ctx context.Context, height pkgTypes.Level) ([]storage.Constant, error) {
+	var current []storage.Constant
+	if err := constant.db.Connection().DB().NewSelect().Model(&current).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	var changes []storage.ConstantHistory
+	if err := constant.db.Connection().DB().NewSelect().
+		Model(&changes).
+		DistinctOn("module, name").
+		Where("height > ?", height).
+		OrderExpr("module, name, id asc").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return constantsAtHeight(current, changes), nil
+}
+
+func constantsAtHeight(current []storage.Constant, changes []storage.ConstantHistory) []storage.Constant {
+	if len(changes) == 0 {
+		return current
+	}
+
+	type key struct {
+		module types.ModuleName
+		name   string
+	}
+	first := make(map[key]storage.ConstantHistory, len(changes))
+	for i := range changes {
+		first[key{changes[i].Module, changes[i].Name}] = changes[i]
+	}
+
+	result := make([]storage.Constant, 0, len(current))
+	for i := range current {
+		change, ok := first[key{current[i].Module, current[i].Name}]
+		if !ok {
+			result = append(result, current[i])
+			continue
+		}
+		if change.PrevValue == nil {
+			continue
+		}
+		current[i].Value = *change.PrevValue
+		result = append(result, current[i])
+	}
+	return result
+}
+
 func (constant *Constant) IsNoRows(err error) bool {
 	return errors.Is(err, sql.ErrNoRows)
 }
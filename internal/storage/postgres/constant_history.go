@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// ConstantHistory -
+type ConstantHistory struct {
+	*postgres.Table[*storage.ConstantHistory]
+}
+
+// NewConstantHistory -
+func NewConstantHistory(db *postgres.Storage) *ConstantHistory {
+	return &ConstantHistory{
+		Table: postgres.NewTable[*storage.ConstantHistory](db.Connection()),
+	}
+}
+
+func (ch *ConstantHistory) ByName(ctx context.Context, module types.ModuleName, name string, limit, offset int, sort sdk.SortOrder) (history []storage.ConstantHistory, err error) {
+	query := ch.DB().NewSelect().
+		Model(&history).
+		Where("module = ?", module).
+		Where("name = ?", name)
+
+	query = limitScope(query, limit)
+	query = offsetScope(query, offset)
+	query = sortScope(query, "id", sort)
+
+	err = query.Scan(ctx)
+	return
+}
+
+func (ch *ConstantHistory) ByHeight(ctx context.Context, height pkgTypes.Level) (history []storage.ConstantHistory, err error) {
+	err = ch.DB().NewSelect().
+		Model(&history).
+		Where("height = ?", height).
+		Order("id asc").
+		Scan(ctx)
+	return
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestConstantHistoryByName() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.ConstantHistory.ByName(ctx, types.ModuleNameGeneric, "authority_sudo_key", 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	s.Require().EqualValues(2, history[0].Id)
+	s.Require().EqualValues(7965, history[0].Height)
+	s.Require().EqualValues(2, history[0].ActionId)
+	s.Require().Equal("1c0c490f1b5528d8173c5de46d131160e4b2c0c3", history[0].Value)
+	s.Require().NotNil(history[0].PrevValue)
+	s.Require().Equal("0a0b490f1b5528d8173c5de46d131160e4b2c0c3", *history[0].PrevValue)
+
+	s.Require().EqualValues(1, history[1].Id)
+	s.Require().Nil(history[1].PrevValue)
+}
+
+func (s *StorageTestSuite) TestConstantHistoryByNameUnknown() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.ConstantHistory.ByName(ctx, types.ModuleNameGeneric, "unknown", 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(history, 0)
+}
+
+func (s *StorageTestSuite) TestConstantHistoryByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.ConstantHistory.ByHeight(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().EqualValues(2, history[0].Id)
+	s.Require().Equal("authority_sudo_key", history[0].Name)
+
+	history, err = s.ConstantHistory.ByHeight(ctx, 100)
+	s.Require().NoError(err)
+	s.Require().Len(history, 0)
+}
@@ -6,9 +6,13 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"testing"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
 )
 
 func (s *StorageTestSuite) TestConstantGet() {
@@ -49,8 +53,56 @@ func (s *StorageTestSuite) TestConstantAll() {
 	s.Require().Len(consts, 9)
 }
 
+func (s *StorageTestSuite) TestConstantAtHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	current, err := s.Constants.All(ctx)
+	s.Require().NoError(err)
+
+	consts, err := s.Constants.AtHeight(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().Len(consts, len(current))
+
+	consts, err = s.Constants.AtHeight(ctx, 7500)
+	s.Require().NoError(err)
+	s.Require().Len(consts, len(current))
+	for i := range consts {
+		if consts[i].Name == "authority_sudo_key" {
+			s.Require().Equal("0a0b490f1b5528d8173c5de46d131160e4b2c0c3", consts[i].Value)
+		}
+	}
+
+	consts, err = s.Constants.AtHeight(ctx, 6000)
+	s.Require().NoError(err)
+	s.Require().Len(consts, len(current)-1)
+	for i := range consts {
+		s.Require().NotEqual("authority_sudo_key", consts[i].Name)
+	}
+}
+
 func (s *StorageTestSuite) TestConstantIsNoRows() {
 	s.Require().True(s.Constants.IsNoRows(sql.ErrNoRows))
 	s.Require().True(s.Constants.IsNoRows(errors.Wrap(sql.ErrNoRows, "some text")))
 	s.Require().False(s.Constants.IsNoRows(errors.New("test")))
 }
+
+func TestConstantsAtHeight(t *testing.T) {
+	prev := "10"
+	current := []storage.Constant{
+		{Module: types.ModuleNameGeneric, Name: "transfer_base", Value: "14"},
+		{Module: types.ModuleNameGeneric, Name: "transfer_multiplier", Value: "0"},
+		{Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("nria"), Value: "true"},
+	}
+	changes := []storage.ConstantHistory{
+		{Id: 2, Module: types.ModuleNameGeneric, Name: "transfer_base", Value: "12", PrevValue: &prev},
+		{Id: 5, Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("nria"), Value: "true"},
+	}
+
+	consts := constantsAtHeight(current, changes)
+	require.Len(t, consts, 2)
+	require.Equal(t, "transfer_base", consts[0].Name)
+	require.Equal(t, "10", consts[0].Value)
+	require.Equal(t, "transfer_multiplier", consts[1].Name)
+	require.Equal(t, "0", consts[1].Value)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 )
@@ -65,3 +66,62 @@ func (d *Deposit) ByRollupId(ctx context.Context, rollupId uint64, limit, offset
 
 	return
 }
+
+func (d *Deposit) ByHeight(ctx context.Context, height types.Level) (deposits []storage.Deposit, err error) {
+	query := d.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		Where("height = ?", height)
+
+	err = d.DB().NewSelect().
+		TableExpr("(?) as deposit", query).
+		ColumnExpr("deposit.*").
+		ColumnExpr("tx.hash as tx__hash").
+		ColumnExpr("rollup.astria_id as rollup__astria_id").
+		ColumnExpr("bridge.address_id as bridge__address_id").
+		ColumnExpr("address.hash as bridge__address__hash").
+		Join("left join tx on tx.id = tx_id").
+		Join("left join rollup on rollup.id = deposit.rollup_id").
+		Join("left join bridge on bridge_id = bridge.id").
+		Join("left join address on address_id = address.id").
+		OrderExpr("deposit.id asc").
+		Scan(ctx, &deposits)
+	return
+}
+
+func (d *Deposit) ByDestination(ctx context.Context, rollupId uint64, destination string, limit, offset int, sort sdk.SortOrder) (deposits []storage.Deposit, err error) {
+	query := d.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		Where("rollup_id = ?", rollupId).
+		Where("lower(destination_chain_address) = lower(?)", destination)
+
+	query = limitScope(query, limit)
+	query = offsetScope(query, offset)
+	query = sortScope(query, "time", sort)
+
+	q := d.DB().NewSelect().
+		TableExpr("(?) as deposit", query).
+		ColumnExpr("deposit.*").
+		ColumnExpr("tx.hash as tx__hash").
+		ColumnExpr("bridge.address_id as bridge__address_id").
+		ColumnExpr("address.hash as bridge__address__hash").
+		Join("left join tx on tx.id = tx_id").
+		Join("left join bridge on bridge_id = bridge.id").
+		Join("left join address on address_id = address.id")
+
+	q = joinCelestials(q, "bridge__address__", "deposit.bridge_id")
+	q = sortScope(q, "deposit.time", sort)
+	err = q.Scan(ctx, &deposits)
+	return
+}
+
+func (d *Deposit) TotalsByDestination(ctx context.Context, rollupId uint64, destination string) (totals []storage.AssetTotal, err error) {
+	err = d.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		ColumnExpr("asset, sum(amount) as amount, count(*) as count").
+		Where("rollup_id = ?", rollupId).
+		Where("lower(destination_chain_address) = lower(?)", destination).
+		Group("asset").
+		Order("asset").
+		Scan(ctx, &totals)
+	return
+}
@@ -25,7 +25,7 @@ func (s *StorageTestSuite) TestDepositByBridgeId() {
 	s.Require().EqualValues(1, deposit.RollupId)
 	s.Require().EqualValues(1, deposit.BridgeId)
 	s.Require().EqualValues("100", deposit.Amount.String())
-	s.Require().EqualValues("destination_chain_address", deposit.DestinationChainAddress)
+	s.Require().EqualValues("0x7f3a5c2e9b1d4f6a8c0e2b4d6f8a1c3e5b7d9f0a", deposit.DestinationChainAddress)
 	s.Require().EqualValues("nria", deposit.Asset)
 
 	s.Require().NotNil(deposit.Tx)
@@ -50,7 +50,7 @@ func (s *StorageTestSuite) TestDepositByRollupId() {
 	s.Require().EqualValues(1, deposit.RollupId)
 	s.Require().EqualValues(1, deposit.BridgeId)
 	s.Require().EqualValues("100", deposit.Amount.String())
-	s.Require().EqualValues("destination_chain_address", deposit.DestinationChainAddress)
+	s.Require().EqualValues("0x7f3a5c2e9b1d4f6a8c0e2b4d6f8a1c3e5b7d9f0a", deposit.DestinationChainAddress)
 	s.Require().EqualValues("nria", deposit.Asset)
 
 	s.Require().NotNil(deposit.Tx)
@@ -69,3 +69,61 @@ func (s *StorageTestSuite) TestDepositByRollupId() {
 	s.Require().Nil(deposit.Action)
 	s.Require().Nil(deposit.Rollup)
 }
+
+func (s *StorageTestSuite) TestDepositByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deposits, err := s.Deposit.ByHeight(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+
+	deposit := deposits[0]
+	s.Require().EqualValues(1, deposit.Id)
+	s.Require().EqualValues("100", deposit.Amount.String())
+
+	s.Require().NotNil(deposit.Tx)
+	s.Require().Equal("a7bc8121a38725bd33e5d66b80817a2ba39e517fb6b9244a7081ad2fb210bfcc", hex.EncodeToString(deposit.Tx.Hash))
+	s.Require().NotNil(deposit.Bridge)
+	s.Require().NotNil(deposit.Bridge.Address)
+	s.Require().Equal("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", deposit.Bridge.Address.Hash)
+	s.Require().NotNil(deposit.Rollup)
+	s.Require().Equal("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539", hex.EncodeToString(deposit.Rollup.AstriaId))
+
+	deposits, err = s.Deposit.ByHeight(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 0)
+}
+
+func (s *StorageTestSuite) TestDepositByDestination() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deposits, err := s.Deposit.ByDestination(ctx, 1, "0x7F3A5C2E9B1D4F6A8C0E2B4D6F8A1C3E5B7D9F0A", 10, 0, storage.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+
+	deposit := deposits[0]
+	s.Require().EqualValues(1, deposit.RollupId)
+	s.Require().EqualValues("0x7f3a5c2e9b1d4f6a8c0e2b4d6f8a1c3e5b7d9f0a", deposit.DestinationChainAddress)
+	s.Require().NotNil(deposit.Tx)
+	s.Require().NotNil(deposit.Bridge)
+	s.Require().NotNil(deposit.Bridge.Address)
+	s.Require().EqualValues("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", deposit.Bridge.Address.Hash)
+
+	deposits, err = s.Deposit.ByDestination(ctx, 2, "0x7f3a5c2e9b1d4f6a8c0e2b4d6f8a1c3e5b7d9f0a", 10, 0, storage.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 0)
+}
+
+func (s *StorageTestSuite) TestDepositTotalsByDestination() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	totals, err := s.Deposit.TotalsByDestination(ctx, 1, "0x7f3a5c2e9b1d4f6a8c0e2b4d6f8a1c3e5b7d9f0a")
+	s.Require().NoError(err)
+	s.Require().Len(totals, 1)
+	s.Require().EqualValues("nria", totals[0].Asset)
+	s.Require().EqualValues("100", totals[0].Amount.String())
+	s.Require().EqualValues(1, totals[0].Count)
+}
@@ -26,6 +26,15 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Address)(nil)).
+			Index("address_hash_trgm_idx").
+			ColumnExpr("hash gin_trgm_ops").
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Block
 		if _, err := tx.NewCreateIndex().
@@ -54,6 +63,14 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Block)(nil)).
+			Index("block_hash_prefix_idx").
+			Column("hash").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// BlockStats
 		if _, err := tx.NewCreateIndex().
@@ -85,6 +102,14 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Tx)(nil)).
+			Index("tx_hash_prefix_idx").
+			Column("hash").
+			Exec(ctx); err != nil {
+			return err
+		}
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
 			Model((*storage.Tx)(nil)).
@@ -227,6 +252,17 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// App
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.App)(nil)).
+			Index("app_name_idx").
+			ColumnExpr("name gin_trgm_ops").
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Bridge
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -378,6 +414,23 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			Exec(ctx); err != nil {
 			return err
 		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_destination_chain_address_idx").
+			ColumnExpr("rollup_id, lower(destination_chain_address)").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_destination_chain_address_trgm_idx").
+			ColumnExpr("destination_chain_address gin_trgm_ops").
+			Using("GIN").
+			Exec(ctx); err != nil {
+			return err
+		}
 
 		// Price
 		if _, err := tx.NewCreateIndex().
@@ -390,6 +443,81 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// BalanceUpdate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// ConstantHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ConstantHistory)(nil)).
+			Index("constant_history_height_idx").
+			Column("height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Webhook
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Webhook)(nil)).
+			Index("webhook_event_idx").
+			Column("event").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// WebhookDelivery
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WebhookDelivery)(nil)).
+			Index("webhook_delivery_webhook_id_idx").
+			Column("webhook_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.WebhookDelivery)(nil)).
+			Index("webhook_delivery_status_next_attempt_at_idx").
+			Column("status", "next_attempt_at").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// AuditLog
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AuditLog)(nil)).
+			Index("audit_log_time_idx").
+			Column("time").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AuditLog)(nil)).
+			Index("audit_log_entity_idx").
+			Column("entity", "entity_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.AuditLog)(nil)).
+			Index("audit_log_operator_id_idx").
+			Column("operator_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,27 @@
+ALTER TABLE public."fee" ADD IF NOT EXISTS action_type action_type NULL;
+
+--bun:split
+
+ALTER TABLE public."fee" ADD IF NOT EXISTS rollup_id int8 DEFAULT 0 NOT NULL;
+
+--bun:split
+
+COMMENT ON COLUMN public."fee".action_type IS 'Action type';
+
+--bun:split
+
+COMMENT ON COLUMN public."fee".rollup_id IS 'Rollup which data submission paid the fee';
+
+--bun:split
+
+update fee
+set action_type = action.type
+from action
+where action.id = fee.action_id and action.time = fee.time and fee.action_type is null;
+
+--bun:split
+
+update fee
+set rollup_id = rollup_action.rollup_id
+from rollup_action
+where rollup_action.action_id = fee.action_id and rollup_action.time = fee.time and fee.rollup_id = 0;
@@ -0,0 +1,22 @@
+insert into "constant" (module, name, value)
+select 'generic', 'allowed_fee_asset:' || assets.asset, 'true'
+from (
+	select distinct asset from fee where asset <> ''
+	union
+	select distinct fee_asset as asset from bridge where fee_asset <> ''
+) as assets
+on conflict (module, name) do nothing;
+
+--bun:split
+
+insert into "constant" (module, name, value)
+select distinct on (changes.asset) 'generic', 'allowed_fee_asset:' || changes.asset, changes.value
+from (
+	select data->>'addition' as asset, 'true' as value, height, position from action
+	where type = 'fee_asset_change' and data->>'addition' is not null
+	union all
+	select data->>'removal' as asset, 'false' as value, height, position from action
+	where type = 'fee_asset_change' and data->>'removal' is not null
+) as changes
+order by changes.asset, changes.height desc, changes.position desc
+on conflict (module, name) do update set value = excluded.value;
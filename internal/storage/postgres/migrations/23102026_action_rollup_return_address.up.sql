@@ -0,0 +1,15 @@
+ALTER TABLE public."action" ADD IF NOT EXISTS rollup_return_address text NULL;
+
+--bun:split
+
+COMMENT ON COLUMN public."action".rollup_return_address IS 'Rollup-side sender of ICS20 withdrawal from rollup. It''s taken from withdrawal memo';
+
+--bun:split
+
+update action
+set rollup_return_address = nullif(substring(data->>'memo' from '"rollupReturnAddress"\s*:\s*"([^"]*)"'), '')
+where type = 'ics20_withdrawal' and data->>'memo' is not null and rollup_return_address is null;
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS action_rollup_return_address_idx ON public."action" (lower(rollup_return_address)) WHERE rollup_return_address IS NOT NULL;
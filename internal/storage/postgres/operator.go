@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Operator -
+type Operator struct {
+	*postgres.Table[*storage.Operator]
+}
+
+// NewOperator -
+func NewOperator(db *postgres.Storage) *Operator {
+	return &Operator{
+		Table: postgres.NewTable[*storage.Operator](db.Connection()),
+	}
+}
+
+func (o *Operator) ByHash(ctx context.Context, hash string) (operator storage.Operator, err error) {
+	err = o.DB().NewSelect().
+		Model(&operator).
+		Where("key_hash = ?", hash).
+		Limit(1).
+		Scan(ctx)
+	return
+}
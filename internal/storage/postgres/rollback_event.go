@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+type RollbackEvent struct {
+	*postgres.Table[*storage.RollbackEvent]
+}
+
+func NewRollbackEvent(db *postgres.Storage) *RollbackEvent {
+	return &RollbackEvent{
+		Table: postgres.NewTable[*storage.RollbackEvent](db.Connection()),
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestRollbackEventList() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	events, err := s.RollbackEvents.List(ctx, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+
+	event := events[0]
+	s.Require().EqualValues(2, event.Id)
+	s.Require().EqualValues(7964, event.FromHeight)
+	s.Require().EqualValues(7964, event.ToHeight)
+	s.Require().EqualValues(1, event.Depth)
+	s.Require().EqualValues(1, event.TxCount)
+	s.Require().EqualValues(1, event.RollupsCount)
+	s.Require().Len(event.OldHash, 32)
+	s.Require().Len(event.NewHash, 32)
+
+	s.Require().EqualValues(1, events[1].Id)
+	s.Require().EqualValues(2, events[1].Depth)
+}
@@ -101,6 +101,9 @@ func (r *Rollup) ListRollupsByAddress(ctx context.Context, addressId uint64, lim
 
 func (r *Rollup) ListExt(ctx context.Context, fltrs storage.RollupListFilter) (rollups []storage.Rollup, err error) {
 	query := r.DB().NewSelect().Model(&rollups)
+	if fltrs.MaxHeight > 0 {
+		query = query.Where("first_height <= ?", fltrs.MaxHeight)
+	}
 
 	query = limitScope(query, fltrs.Limit)
 	switch fltrs.SortField {
@@ -114,3 +117,38 @@ func (r *Rollup) ListExt(ctx context.Context, fltrs storage.RollupListFilter) (r
 	err = query.Scan(ctx)
 	return
 }
+
+func (r *Rollup) ByFirstHeight(ctx context.Context, height types.Level) (rollups []storage.Rollup, err error) {
+	err = r.DB().NewSelect().
+		Model(&rollups).
+		Where("first_height = ?", height).
+		Order("id asc").
+		Scan(ctx)
+	return
+}
+
+// ByHeight - returns rollups which received data, deposits or actions of their bridges in the block
+func (r *Rollup) ByHeight(ctx context.Context, height types.Level) (rollups []storage.Rollup, err error) {
+	actionsQuery := r.DB().NewSelect().
+		Model((*storage.RollupAction)(nil)).
+		Column("rollup_id").
+		Where("height = ?", height)
+
+	depositsQuery := r.DB().NewSelect().
+		Model((*storage.Deposit)(nil)).
+		Column("rollup_id").
+		Where("height = ?", height)
+
+	bridgesQuery := r.DB().NewSelect().
+		Model((*storage.Bridge)(nil)).
+		Column("bridge.rollup_id").
+		Join("join address_action on address_action.address_id = bridge.address_id").
+		Where("address_action.height = ?", height)
+
+	err = r.DB().NewSelect().
+		Model(&rollups).
+		Where("id IN (?)", actionsQuery.Union(depositsQuery).Union(bridgesQuery)).
+		Order("id asc").
+		Scan(ctx)
+	return
+}
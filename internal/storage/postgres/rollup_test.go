@@ -9,6 +9,7 @@ import (
 	"time"
 
 	models "github.com/celenium-io/astria-indexer/internal/storage"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
@@ -133,3 +134,33 @@ func (s *StorageTestSuite) TestListExt() {
 	}
 
 }
+
+func (s *StorageTestSuite) TestRollupByFirstHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	rollups, err := s.Rollup.ByFirstHeight(ctx, 7316)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 1)
+	s.Require().EqualValues(1, rollups[0].Id)
+
+	rollups, err = s.Rollup.ByFirstHeight(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 0)
+}
+
+func (s *StorageTestSuite) TestRollupByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, height := range []pkgTypes.Level{7316, 7965} {
+		rollups, err := s.Rollup.ByHeight(ctx, height)
+		s.Require().NoError(err)
+		s.Require().Len(rollups, 1, height)
+		s.Require().EqualValues(1, rollups[0].Id)
+	}
+
+	rollups, err := s.Rollup.ByHeight(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 0)
+}
@@ -41,6 +41,9 @@ func sortScope(q *bun.SelectQuery, field string, sort sdk.SortOrder) *bun.Select
 func addressListFilter(query *bun.SelectQuery, fltrs storage.AddressListFilter) *bun.SelectQuery {
 	query = limitScope(query, fltrs.Limit)
 	query = sortScope(query, "id", fltrs.Sort)
+	if fltrs.MaxHeight > 0 {
+		query = query.Where("address.height <= ?", fltrs.MaxHeight)
+	}
 	return query
 }
 
@@ -64,6 +67,9 @@ func txFilter(query *bun.SelectQuery, fltrs storage.TxFilter) *bun.SelectQuery {
 	if fltrs.Height > 0 {
 		query = query.Where("tx.height = ?", fltrs.Height)
 	}
+	if fltrs.MaxHeight > 0 {
+		query = query.Where("tx.height <= ?", fltrs.MaxHeight)
+	}
 
 	if !fltrs.TimeFrom.IsZero() {
 		query = query.Where("tx.time >= ?", fltrs.TimeFrom)
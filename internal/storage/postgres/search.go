@@ -7,13 +7,34 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/celenium-io/astria-indexer/internal/astria"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	celestials "github.com/celenium-io/celestial-module/pkg/storage"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+const (
+	searchLimit = 10
+
+	// minimal length of hex prefix of block, transaction or rollup hash
+	minHashPrefixLength = 8
+	// minimal length of address prefix: `astria1` and 4 data characters
+	minAddressPrefixLength = len(astria.Prefix) + 5
+	// minimal length of destination chain address prefix
+	minDestinationPrefixLength = 8
+)
+
+var (
+	// IBC denom is searched only with `ibc/` prefix: matching of the hash requires hashing of all asset denoms
+	ibcDenomRe = regexp.MustCompile(`^(?i)ibc/([0-9a-f]{64})$`)
+	// destination chain address is searched only if query looks like prefix of EVM or bech32 address
+	destinationRe = regexp.MustCompile(`^(0[xX][0-9a-fA-F]+|[a-z][a-z0-9]*1[02-9ac-hj-np-z]+)$`)
 )
 
 // Search -
@@ -28,26 +49,28 @@ func NewSearch(db *postgres.Storage) *Search {
 	}
 }
 
+// Search - searches entities by text, hash, hash prefix or address. Results are ranked by trigram similarity of value to the query, exact matches go first.
 func (s *Search) Search(ctx context.Context, query string) (results []storage.SearchResult, err error) {
-	text := "%" + query + "%"
+	query = strings.TrimSpace(query)
+	text := "%" + escapeLike(query) + "%"
 	searchQuery := s.db.DB().NewSelect().
 		Model((*storage.Validator)(nil)).
-		ColumnExpr("id, name as value, 'validator' as type").
+		ColumnExpr("id, name as value, 'validator' as type, similarity(name, ?) as rank", query).
 		Where("name ILIKE ?", text)
 
 	bridgeQuery := s.db.DB().NewSelect().
 		Model((*storage.Bridge)(nil)).
-		ColumnExpr("id, asset as value, 'bridge' as type").
+		ColumnExpr("id, asset as value, 'bridge' as type, similarity(asset, ?) as rank", query).
 		Where("asset ILIKE ?", text)
 
 	appQuery := s.db.DB().NewSelect().
 		Model((*storage.App)(nil)).
-		ColumnExpr("id, name as value, 'app' as type").
+		ColumnExpr("id, name as value, 'app' as type, similarity(name, ?) as rank", query).
 		Where("name ILIKE ?", text)
 
 	celestialsQuery := s.db.DB().NewSelect().
 		Model((*celestials.Celestial)(nil)).
-		ColumnExpr("address_id as id, id as value, 'celestial' as type").
+		ColumnExpr("address_id as id, id as value, 'celestial' as type, similarity(id, ?) as rank", query).
 		Where("id ILIKE ?", text)
 
 	searchQuery = searchQuery.
@@ -58,63 +81,187 @@ func (s *Search) Search(ctx context.Context, query string) (results []storage.Se
 	if height, err := strconv.ParseInt(query, 10, 64); err == nil {
 		heightQuery := s.db.DB().NewSelect().
 			Model((*storage.Block)(nil)).
-			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type").
+			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type, 1::real as rank").
 			Where("height = ?", height)
 
 		searchQuery = searchQuery.UnionAll(heightQuery)
 	}
 
 	if hash, err := hex.DecodeString(query); err == nil {
-		blockQuery := s.db.DB().NewSelect().
-			Model((*storage.Block)(nil)).
-			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type").
-			Where("hash = ?", hash)
-		txQuery := s.db.DB().NewSelect().
-			Model((*storage.Tx)(nil)).
-			ColumnExpr("id, encode(hash, 'hex') as value, 'tx' as type").
-			Where("hash = ?", hash)
-		rollupQuery := s.db.DB().NewSelect().
-			Model((*storage.Rollup)(nil)).
-			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type").
-			Where("astria_id = ?", hash)
+		searchQuery = s.searchHash(searchQuery, hash)
+	}
 
-		searchQuery = searchQuery.
-			UnionAll(blockQuery).
-			UnionAll(txQuery).
-			UnionAll(rollupQuery)
+	if matches := ibcDenomRe.FindStringSubmatch(query); len(matches) == 2 {
+		searchQuery = searchQuery.UnionAll(s.searchIbcDenom(matches[1]))
 	}
 
-	if astria.IsAddress(query) {
+	address := query
+	if astria.IsCompatAddress(query) {
+		if converted, err := astria.CompatToAstria(query); err == nil {
+			address = converted
+		}
+	}
+
+	switch {
+	case astria.IsAddress(address):
 		addressQuery := s.db.DB().NewSelect().
 			Model((*storage.Address)(nil)).
-			ColumnExpr("id, hash as value, 'address' as type").
-			Where("hash = ?", query)
+			ColumnExpr("id, hash as value, 'address' as type, 1::real as rank").
+			Where("hash = ?", address)
 
 		validatorQuery := s.db.DB().NewSelect().
 			Model((*storage.Validator)(nil)).
-			ColumnExpr("id, name as value, 'validator' as type").
-			Where("address = ?", query)
+			ColumnExpr("id, name as value, 'validator' as type, 1::real as rank").
+			Where("address = ?", address)
 
 		searchQuery = searchQuery.
 			UnionAll(addressQuery).
 			UnionAll(validatorQuery)
+	case strings.HasPrefix(query, astria.Prefix+"1") && len(query) >= minAddressPrefixLength:
+		addressQuery := s.db.DB().NewSelect().
+			Model((*storage.Address)(nil)).
+			ColumnExpr("id, hash as value, 'address' as type, similarity(hash, ?) as rank", query).
+			Where("hash LIKE ?", escapeLike(query)+"%")
+
+		searchQuery = searchQuery.UnionAll(addressQuery)
 	}
 
-	if decoded, err := base64.StdEncoding.DecodeString(query); err == nil {
+	if decoded, ok := decodeBase64(query); ok {
 		rollupQuery := s.db.DB().NewSelect().
 			Model((*storage.Rollup)(nil)).
-			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type").
+			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type, 1::real as rank").
 			Where("astria_id = ?", decoded)
 
 		searchQuery = searchQuery.
 			UnionAll(rollupQuery)
 	}
 
+	if isDestinationPrefix(query) {
+		destinationQuery := s.db.DB().NewSelect().
+			Model((*storage.Deposit)(nil)).
+			ColumnExpr("rollup_id as id, destination_chain_address as value, 'rollup_account' as type, similarity(destination_chain_address, ?) as rank", query).
+			Where("destination_chain_address ILIKE ?", escapeLike(query)+"%").
+			Group("rollup_id", "destination_chain_address")
+
+		searchQuery = searchQuery.UnionAll(destinationQuery)
+	}
+
 	err = s.db.DB().NewSelect().
 		TableExpr("(?) as search", searchQuery).
-		Limit(10).
+		Column("id", "value", "type").
+		OrderExpr("rank desc, type asc, value asc").
+		Limit(searchLimit).
 		Offset(0).
 		Scan(ctx, &results)
 
 	return
 }
+
+// searchHash - adds exact search of blocks, transactions and rollups by hash and search by hash prefix if hash is shorter than 32 bytes
+func (s *Search) searchHash(searchQuery *bun.SelectQuery, hash []byte) *bun.SelectQuery {
+	blockQuery := s.db.DB().NewSelect().
+		Model((*storage.Block)(nil)).
+		ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type, 1::real as rank").
+		Where("hash = ?", hash)
+	txQuery := s.db.DB().NewSelect().
+		Model((*storage.Tx)(nil)).
+		ColumnExpr("id, encode(hash, 'hex') as value, 'tx' as type, 1::real as rank").
+		Where("hash = ?", hash)
+	rollupQuery := s.db.DB().NewSelect().
+		Model((*storage.Rollup)(nil)).
+		ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type, 1::real as rank").
+		Where("astria_id = ?", hash)
+
+	searchQuery = searchQuery.
+		UnionAll(blockQuery).
+		UnionAll(txQuery).
+		UnionAll(rollupQuery)
+
+	if len(hash)*2 < minHashPrefixLength || len(hash) >= 32 {
+		return searchQuery
+	}
+
+	upper := prefixUpperBound(hash)
+	blockPrefixQuery := hashPrefixScope(
+		s.db.DB().NewSelect().
+			Model((*storage.Block)(nil)).
+			ColumnExpr("id, encode(hash, 'hex') as value, 'block' as type, 0.5::real as rank"),
+		"hash", hash, upper,
+	)
+	txPrefixQuery := hashPrefixScope(
+		s.db.DB().NewSelect().
+			Model((*storage.Tx)(nil)).
+			ColumnExpr("id, encode(hash, 'hex') as value, 'tx' as type, 0.5::real as rank"),
+		"hash", hash, upper,
+	)
+	rollupPrefixQuery := hashPrefixScope(
+		s.db.DB().NewSelect().
+			Model((*storage.Rollup)(nil)).
+			ColumnExpr("id, encode(astria_id, 'hex') as value, 'rollup' as type, 0.5::real as rank"),
+		"astria_id", hash, upper,
+	)
+
+	return searchQuery.
+		UnionAll(blockPrefixQuery).
+		UnionAll(txPrefixQuery).
+		UnionAll(rollupPrefixQuery)
+}
+
+// searchIbcDenom - finds asset which IBC denom hash is equal to the given one. Hash of denom is sha256 of its full trace path.
+func (s *Search) searchIbcDenom(hash string) *bun.SelectQuery {
+	assets := s.db.DB().NewSelect().
+		Model((*storage.Balance)(nil)).
+		Column("currency").
+		Distinct()
+
+	return s.db.DB().NewSelect().
+		TableExpr("(?) as assets", assets).
+		ColumnExpr("0 as id, currency as value, 'asset' as type, 1::real as rank").
+		Where("encode(sha256(convert_to(currency, 'UTF8')), 'hex') = ?", strings.ToLower(hash)).
+		WhereOr("lower(currency) = ?", "ibc/"+strings.ToLower(hash))
+}
+
+// hashPrefixScope - filters rows which hash starts with the prefix. Range condition allows to use btree index.
+func hashPrefixScope(q *bun.SelectQuery, column string, prefix, upper []byte) *bun.SelectQuery {
+	q = q.Where("? >= ?", bun.Ident(column), prefix)
+	if upper != nil {
+		q = q.Where("? < ?", bun.Ident(column), upper)
+	}
+	return q
+}
+
+// prefixUpperBound - returns the least byte string which is greater than all strings with the prefix. Returns nil if there is no such string.
+func prefixUpperBound(prefix []byte) []byte {
+	upper := make([]byte, len(prefix))
+	copy(upper, prefix)
+	for i := len(upper) - 1; i >= 0; i-- {
+		if upper[i] < 0xff {
+			upper[i]++
+			return upper[:i+1]
+		}
+	}
+	return nil
+}
+
+// isDestinationPrefix - returns true if query may be prefix of destination chain address of deposit
+func isDestinationPrefix(query string) bool {
+	return len(query) >= minDestinationPrefixLength && destinationRe.MatchString(query)
+}
+
+func decodeBase64(s string) ([]byte, bool) {
+	for _, encoding := range []*base64.Encoding{
+		base64.StdEncoding,
+		base64.URLEncoding,
+		base64.RawStdEncoding,
+		base64.RawURLEncoding,
+	} {
+		if decoded, err := encoding.DecodeString(s); err == nil {
+			return decoded, true
+		}
+	}
+	return nil, false
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -5,7 +5,12 @@ package postgres
 
 import (
 	"context"
+	"testing"
 	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/astria"
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/stretchr/testify/require"
 )
 
 func (s *StorageTestSuite) TestSearchBlock() {
@@ -161,3 +166,117 @@ func (s *StorageTestSuite) TestSearchCelestials() {
 	s.Require().EqualValues("celestial", result.Type)
 	s.Require().EqualValues(4, result.Id)
 }
+
+func (s *StorageTestSuite) TestSearchAddressPrefix() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.Search.Search(ctx, "astria1e9q7eg")
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("astria1e9q7egqgz8rz6aej8nr57swqgaeujhz04vd9q5", result.Value)
+	s.Require().EqualValues("address", result.Type)
+	s.Require().EqualValues(8, result.Id)
+
+	results, err = s.Search.Search(ctx, "astria1e")
+	s.Require().NoError(err)
+	s.Require().Len(results, 0)
+}
+
+func (s *StorageTestSuite) TestSearchCompatAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, data, err := astria.DecodeAddress("astria1e9q7egqgz8rz6aej8nr57swqgaeujhz04vd9q5")
+	s.Require().NoError(err)
+	compat, err := bech32.EncodeFromBase256(astria.PrefixCompat, data)
+	s.Require().NoError(err)
+
+	results, err := s.Search.Search(ctx, compat)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("astria1e9q7egqgz8rz6aej8nr57swqgaeujhz04vd9q5", result.Value)
+	s.Require().EqualValues("address", result.Type)
+	s.Require().EqualValues(8, result.Id)
+}
+
+func (s *StorageTestSuite) TestSearchHashPrefix() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for query, typ := range map[string]string{
+		"b15d072afc50": "block",
+		"20b0e631":     "tx",
+		"19ba8abb3e4b": "rollup",
+	} {
+		results, err := s.Search.Search(ctx, query)
+		s.Require().NoError(err, query)
+		s.Require().Len(results, 1, query)
+		s.Require().EqualValues(typ, results[0].Type, query)
+	}
+}
+
+func (s *StorageTestSuite) TestSearchRollupByRawBase64Url() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.Search.Search(ctx, "GbqKuz5LVqMJ32dWxHuX4pjjpy2IRJ02oPrbHKc2ZTk")
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("19ba8abb3e4b56a309df6756c47b97e298e3a72d88449d36a0fadb1ca7366539", result.Value)
+	s.Require().EqualValues("rollup", result.Type)
+}
+
+func (s *StorageTestSuite) TestSearchIbcDenom() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.Search.Search(ctx, "ibc/1C49A083A74ED4445C804CC9CB9AB63D9BE7D9451073AB200BC41A2C7131AFBB")
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("asset-1", result.Value)
+	s.Require().EqualValues("asset", result.Type)
+
+	results, err = s.Search.Search(ctx, "1C49A083A74ED4445C804CC9CB9AB63D9BE7D9451073AB200BC41A2C7131AFBB")
+	s.Require().NoError(err)
+	for i := range results {
+		s.Require().NotEqual("asset", results[i].Type)
+	}
+}
+
+func (s *StorageTestSuite) TestSearchDestinationAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	results, err := s.Search.Search(ctx, "0x7F3A5C2E")
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+
+	result := results[0]
+	s.Require().EqualValues("0x7f3a5c2e9b1d4f6a8c0e2b4d6f8a1c3e5b7d9f0a", result.Value)
+	s.Require().EqualValues("rollup_account", result.Type)
+	s.Require().EqualValues(1, result.Id)
+}
+
+func TestIsDestinationPrefix(t *testing.T) {
+	for query, want := range map[string]bool{
+		"0x7f3a5c2e":          true,
+		"0X7F3A5C2E9B1D4F6A8": true,
+		"osmo1qypqxpq9":       true,
+		"0x7f3a5":             false,
+		"0x7f3a5c2g":          false,
+		"destination_chain":   false,
+		"osmo1qypqxpqb":       false,
+		"some long text":      false,
+	} {
+		require.Equal(t, want, isDestinationPrefix(query), query)
+	}
+}
@@ -5,8 +5,11 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/celenium-io/astria-indexer/internal/currency"
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
@@ -25,6 +28,10 @@ func NewStats(conn *postgres.Storage) Stats {
 }
 
 func (s Stats) Series(ctx context.Context, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	if !timeframe.IsFixed() {
+		return s.customSeries(ctx, timeframe, name, req)
+	}
+
 	var view string
 	switch timeframe {
 	case storage.TimeframeHour:
@@ -72,6 +79,20 @@ func (s Stats) Series(ctx context.Context, timeframe storage.Timeframe, name str
 }
 
 func (s Stats) RollupSeries(ctx context.Context, rollupId uint64, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	if !timeframe.IsFixed() {
+		query, err := s.rollupSeriesQuery(timeframe, name, req)
+		if err != nil {
+			return nil, err
+		}
+		err = query.
+			Where("rollup_id = ?", rollupId).
+			GroupExpr("1").
+			OrderExpr("1 desc").
+			Limit(storage.MaxSeriesPoints).
+			Scan(ctx, &response)
+		return response, err
+	}
+
 	var view string
 	switch timeframe {
 	case storage.TimeframeHour:
@@ -113,6 +134,299 @@ func (s Stats) RollupSeries(ctx context.Context, rollupId uint64, timeframe stor
 	return
 }
 
+// seriesSource - table which custom series is computed from
+type seriesSource struct {
+	table      string
+	timeColumn string
+	raw        bool
+}
+
+// newSeriesSource - chooses the largest aggregate which width divides the bucket. Otherwise series is computed from the raw hypertable.
+func newSeriesSource(bucket time.Duration, raw, hour, day string) seriesSource {
+	switch {
+	case bucket%(24*time.Hour) == 0:
+		return seriesSource{table: day, timeColumn: "ts"}
+	case bucket%time.Hour == 0:
+		return seriesSource{table: hour, timeColumn: "ts"}
+	default:
+		return seriesSource{table: raw, timeColumn: "time", raw: true}
+	}
+}
+
+// seriesQuery - returns query of series bucketed by timeframe in time window limited by storage.MaxSeriesPoints buckets
+func (s Stats) seriesQuery(timeframe storage.Timeframe, req storage.SeriesRequest, source seriesSource) (*bun.SelectQuery, error) {
+	req, err := timeframe.Window(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.DB().NewSelect().
+		Table(source.table).
+		Where("? < ?", bun.Ident(source.timeColumn), req.To)
+
+	if timeframe.IsFixed() {
+		return query.
+			ColumnExpr("? as ts", bun.Ident(source.timeColumn)).
+			Where("? >= ?", bun.Ident(source.timeColumn), req.From), nil
+	}
+
+	bucket, err := timeframe.Bucket()
+	if err != nil {
+		return nil, err
+	}
+	interval := fmt.Sprintf("%d seconds", int64(bucket.Seconds()))
+	return query.
+		ColumnExpr("time_bucket(?::interval, ?) as ts", interval, bun.Ident(source.timeColumn)).
+		Where("? >= time_bucket(?::interval, ?::timestamptz)", bun.Ident(source.timeColumn), interval, req.From), nil
+}
+
+func (s Stats) customSeries(ctx context.Context, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	bucket, err := timeframe.Bucket()
+	if err != nil {
+		return nil, err
+	}
+	source := newSeriesSource(bucket, storage.BlockStats{}.TableName(), storage.ViewBlockStatsByHour, storage.ViewBlockStatsByDay)
+
+	query, err := s.seriesQuery(timeframe, req, source)
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := bucket.Seconds()
+	switch name {
+	case storage.SeriesDataSize:
+		query = query.ColumnExpr("sum(data_size) as value")
+	case storage.SeriesSupplyChange:
+		query = query.ColumnExpr("sum(supply_change) as value")
+	case storage.SeriesTxCount:
+		query = query.ColumnExpr("sum(tx_count) as value")
+	case storage.SeriesBytesInBlock:
+		query = query.ColumnExpr("sum(bytes_in_block) as value")
+	case storage.SeriesBlockTime:
+		if source.raw {
+			query = query.ColumnExpr("avg(block_time) as value")
+		} else {
+			query = query.ColumnExpr("mean(rollup(block_time_pct)) as value")
+		}
+	case storage.SeriesTPS:
+		query = rateSeriesColumns(query, source.raw, "tx_count", "tps", seconds)
+	case storage.SeriesBPS:
+		query = rateSeriesColumns(query, source.raw, "bytes_in_block", "bps", seconds)
+	case storage.SeriesRBPS:
+		query = rateSeriesColumns(query, source.raw, "data_size", "rbps", seconds)
+	default:
+		return nil, errors.Errorf("unexpected series name: %s", name)
+	}
+
+	err = query.
+		GroupExpr("1").
+		OrderExpr("1 desc").
+		Limit(storage.MaxSeriesPoints).
+		Scan(ctx, &response)
+	return
+}
+
+// rateSeriesColumns - adds average per second value of column in bucket and its maximum and minimum per block
+func rateSeriesColumns(query *bun.SelectQuery, raw bool, column, rate string, seconds float64) *bun.SelectQuery {
+	query = query.ColumnExpr("sum(?) / ? as value", bun.Ident(column), seconds)
+	if raw {
+		return query.
+			ColumnExpr("max(case when block_time > 0 then ?::float/(block_time/1000.0) else 0 end) as max", bun.Ident(column)).
+			ColumnExpr("min(case when block_time > 0 then ?::float/(block_time/1000.0) else 0 end) as min", bun.Ident(column))
+	}
+	return query.
+		ColumnExpr("max(?) as max", bun.Ident(rate+"_max")).
+		ColumnExpr("min(?) as min", bun.Ident(rate+"_min"))
+}
+
+// histogramBuckets - returns count of histogram buckets. It returns false if histogram is already filled: distribution is empty or has the only value.
+func histogramBuckets(response *storage.Distribution, buckets int) (int, bool) {
+	response.Histogram = make([]storage.HistogramBucket, 0)
+	switch {
+	case response.Count == 0:
+		return 0, false
+	case response.Min == response.Max:
+		response.Histogram = append(response.Histogram, storage.HistogramBucket{
+			From:  response.Min,
+			To:    response.Max,
+			Count: response.Count,
+		})
+		return 0, false
+	}
+
+	if buckets < 1 || buckets > storage.MaxHistogramBuckets {
+		buckets = storage.DefaultHistogramBuckets
+	}
+	return buckets, true
+}
+
+// histogramRanges - returns empty histogram with buckets of equal width from min to max
+func histogramRanges(minValue, maxValue float64, buckets int) []storage.HistogramBucket {
+	width := (maxValue - minValue) / float64(buckets)
+	histogram := make([]storage.HistogramBucket, buckets)
+	for i := range histogram {
+		histogram[i].From = minValue + width*float64(i)
+		histogram[i].To = minValue + width*float64(i+1)
+	}
+	histogram[buckets-1].To = maxValue
+	return histogram
+}
+
+type percentileRank struct {
+	Value float64 `bun:"value"`
+	Rank  float64 `bun:"rank"`
+}
+
+// rollupSizeDistribution - computes distribution of data submission sizes from percentile aggregates of hourly data submission stats. Percentiles and histogram are approximate, the time window is aligned to hours.
+func (s Stats) rollupSizeDistribution(ctx context.Context, fltrs storage.DistributionFilter) (response storage.Distribution, err error) {
+	interval, err := distributionInterval(fltrs.Timeframe)
+	if err != nil {
+		return
+	}
+
+	source := s.db.DB().NewSelect().
+		Table(storage.ViewRollupSizeStatsByHour).
+		ColumnExpr("rollup(size_pct) as pct").
+		ColumnExpr("min(min_size)::float8 as min").
+		ColumnExpr("max(max_size)::float8 as max").
+		Where("ts >= time_bucket('1 hour'::interval, now() - ?::interval)", interval)
+	if fltrs.RollupId > 0 {
+		source = source.Where("rollup_id = ?", fltrs.RollupId)
+	}
+
+	err = s.db.DB().NewSelect().
+		With("source", source).
+		Table("source").
+		ColumnExpr("coalesce(num_vals(pct), 0)::int8 as count").
+		ColumnExpr("coalesce(min, 0) as min").
+		ColumnExpr("coalesce(max, 0) as max").
+		ColumnExpr("coalesce(mean(pct), 0) as avg").
+		ColumnExpr("coalesce(approx_percentile(0.5, pct), 0) as p50").
+		ColumnExpr("coalesce(approx_percentile(0.9, pct), 0) as p90").
+		ColumnExpr("coalesce(approx_percentile(0.99, pct), 0) as p99").
+		Scan(ctx, &response)
+	if err != nil {
+		return
+	}
+
+	buckets, ok := histogramBuckets(&response, fltrs.Buckets)
+	if !ok {
+		return
+	}
+	response.Histogram = histogramRanges(response.Min, response.Max, buckets)
+
+	// bucket count is estimated as difference of percentile ranks of its bounds
+	bounds := make([]float64, buckets-1)
+	for i := range bounds {
+		bounds[i] = response.Histogram[i].To
+	}
+	var ranks []percentileRank
+	err = s.db.DB().NewSelect().
+		With("source", source).
+		TableExpr("source, unnest(array[?]::float8[]) as bounds(value)", bun.In(bounds)).
+		ColumnExpr("bounds.value as value").
+		ColumnExpr("coalesce(approx_percentile_rank(bounds.value, source.pct), 0) as rank").
+		OrderExpr("bounds.value").
+		Scan(ctx, &ranks)
+	if err != nil {
+		return
+	}
+
+	var (
+		prev  float64
+		total int64
+	)
+	for i := range response.Histogram {
+		rank := 1.0
+		if i < len(ranks) {
+			rank = min(max(ranks[i].Rank, prev), 1)
+		}
+		count := int64(math.Round((rank - prev) * float64(response.Count)))
+		if i == len(response.Histogram)-1 || total+count > response.Count {
+			count = response.Count - total
+		}
+		response.Histogram[i].Count = count
+		total += count
+		prev = rank
+	}
+	return
+}
+
+// rollupSeriesQuery - returns query of rollup series which has to be filtered by rollups and grouped by bucket
+func (s Stats) rollupSeriesQuery(timeframe storage.Timeframe, name string, req storage.SeriesRequest) (*bun.SelectQuery, error) {
+	var source seriesSource
+	switch timeframe {
+	case storage.TimeframeHour:
+		source = seriesSource{table: storage.ViewRollupStatsByHour, timeColumn: "ts"}
+	case storage.TimeframeDay:
+		source = seriesSource{table: storage.ViewRollupStatsByDay, timeColumn: "ts"}
+	case storage.TimeframeMonth:
+		source = seriesSource{table: storage.ViewRollupStatsByMonth, timeColumn: "ts"}
+	default:
+		bucket, err := timeframe.Bucket()
+		if err != nil {
+			return nil, err
+		}
+		source = newSeriesSource(bucket, storage.RollupAction{}.TableName(), storage.ViewRollupStatsByHour, storage.ViewRollupStatsByDay)
+	}
+
+	query, err := s.seriesQuery(timeframe, req, source)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case storage.RollupSeriesActionsCount:
+		if source.raw {
+			query = query.ColumnExpr("count(*) as value")
+		} else {
+			query = query.ColumnExpr("sum(actions_count) as value")
+		}
+	case storage.RollupSeriesSize:
+		query = query.ColumnExpr("sum(size) as value")
+	case storage.RollupSeriesAvgSize:
+		if source.raw {
+			query = query.ColumnExpr("avg(size) as value")
+		} else {
+			query = query.ColumnExpr("mean(rollup(size_pct)) as value")
+		}
+	case storage.RollupSeriesMinSize:
+		if source.raw {
+			query = query.ColumnExpr("min(size) as value")
+		} else {
+			query = query.ColumnExpr("min(min_size) as value")
+		}
+	case storage.RollupSeriesMaxSize:
+		if source.raw {
+			query = query.ColumnExpr("max(size) as value")
+		} else {
+			query = query.ColumnExpr("max(max_size) as value")
+		}
+	default:
+		return nil, errors.Errorf("unexpected series name: %s", name)
+	}
+	return query, nil
+}
+
+func (s Stats) CompareRollupSeries(ctx context.Context, rollupIds []uint64, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.RollupSeriesPoint, err error) {
+	if len(rollupIds) == 0 {
+		return nil, nil
+	}
+
+	query, err := s.rollupSeriesQuery(timeframe, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Column("rollup_id").
+		Where("rollup_id IN (?)", bun.In(rollupIds)).
+		GroupExpr("1, rollup_id").
+		OrderExpr("1 desc, rollup_id asc").
+		Scan(ctx, &response)
+	return
+}
+
 func (s Stats) Summary(ctx context.Context) (summary storage.NetworkSummary, err error) {
 	err = s.db.DB().NewSelect().Table(storage.ViewBlockStatsByMonth).
 		ColumnExpr("sum(data_size) as data_size, sum(supply_change) as supply, sum(tx_count) as tx_count, sum(bytes_in_block) as bytes_in_block").
@@ -211,6 +525,24 @@ func (s Stats) TokenTransferDistribution(ctx context.Context, limit int) (items
 	return
 }
 
+func (s Stats) RollupCadence(ctx context.Context, since time.Time) (response []storage.RollupCadence, err error) {
+	query := s.db.DB().NewSelect().
+		Table(storage.ViewRollupStatsByHour).
+		ColumnExpr("rollup_id, sum(actions_count) as actions_count, min(first_time) as first_time, max(last_time) as last_time").
+		Group("rollup_id")
+	if !since.IsZero() {
+		query = query.Where("ts >= ?", since.Truncate(time.Hour))
+	}
+
+	err = s.db.DB().NewSelect().
+		TableExpr("(?) as cadence", query).
+		ColumnExpr("cadence.*").
+		ColumnExpr("rollup.astria_id as rollup__astria_id").
+		Join("left join rollup on rollup.id = cadence.rollup_id").
+		Scan(ctx, &response)
+	return
+}
+
 func (s Stats) ActiveAddressesCount(ctx context.Context) (val int64, err error) {
 	err = s.db.DB().NewSelect().
 		Model((*storage.Tx)(nil)).
@@ -219,3 +551,360 @@ func (s Stats) ActiveAddressesCount(ctx context.Context) (val int64, err error)
 		Scan(ctx, &val)
 	return
 }
+
+func (s Stats) AddressSeries(ctx context.Context, timeframe storage.Timeframe, name string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	var (
+		view     string
+		interval string
+		unit     string
+	)
+	switch timeframe {
+	case storage.TimeframeDay:
+		view = storage.ViewSignerStatsByDay
+		interval = "1 day"
+		unit = "day"
+	case storage.TimeframeWeek:
+		view = storage.ViewSignerStatsByWeek
+		interval = "1 week"
+		unit = "week"
+	case storage.TimeframeMonth:
+		view = storage.ViewSignerStatsByMonth
+		interval = "1 month"
+		unit = "month"
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+
+	var query *bun.SelectQuery
+	switch name {
+	case storage.AddressSeriesActive:
+		query = s.db.DB().NewSelect().
+			TableExpr("? as cur", bun.Ident(view)).
+			ColumnExpr("cur.ts as ts, count(*) as value")
+	case storage.AddressSeriesNew:
+		query = s.db.DB().NewSelect().
+			TableExpr("(?) as cur", s.db.DB().NewSelect().
+				Table(storage.ViewNewAddressesByDay).
+				ColumnExpr("time_bucket(?::interval, ts) as ts, addresses_count", interval),
+			).
+			ColumnExpr("cur.ts as ts, sum(addresses_count) as value")
+	case storage.AddressSeriesReturning:
+		// signers which were active in the period and in the previous period
+		query = s.db.DB().NewSelect().
+			TableExpr("? as cur", bun.Ident(view)).
+			ColumnExpr("cur.ts as ts, count(*) as value").
+			Join("inner join ? as prev on prev.signer_id = cur.signer_id and prev.ts = cur.ts - ?::interval", bun.Ident(view), interval)
+	case storage.AddressSeriesChurned:
+		// signers which were active in the previous period and were not active in the period. Only closed periods are counted: signer may still be active in the current one.
+		query = s.db.DB().NewSelect().
+			TableExpr("(?) as cur", s.db.DB().NewSelect().
+				TableExpr("? as prev", bun.Ident(view)).
+				ColumnExpr("prev.ts + ?::interval as ts", interval).
+				Join("left join ? as next on next.signer_id = prev.signer_id and next.ts = prev.ts + ?::interval", bun.Ident(view), interval).
+				Where("next.signer_id is null").
+				Where("prev.ts + ?::interval < date_trunc(?, now(), 'UTC')", interval, unit),
+			).
+			ColumnExpr("cur.ts as ts, count(*) as value")
+	default:
+		return nil, errors.Errorf("unexpected series name: %s", name)
+	}
+
+	if !req.From.IsZero() {
+		query = query.Where("cur.ts >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("cur.ts < ?", req.To)
+	}
+
+	err = query.
+		Group("cur.ts").
+		Order("cur.ts desc").
+		Limit(100).
+		Scan(ctx, &response)
+	return
+}
+
+func (s Stats) ActionSeries(ctx context.Context, timeframe storage.Timeframe, actionType string, req storage.SeriesRequest) (response []storage.SeriesItem, err error) {
+	var view string
+	switch timeframe {
+	case storage.TimeframeHour:
+		view = storage.ViewActionStatsByHour
+	case storage.TimeframeDay:
+		view = storage.ViewActionStatsByDay
+	case storage.TimeframeMonth:
+		view = storage.ViewActionStatsByMonth
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+
+	query := s.db.DB().NewSelect().
+		Table(view).
+		ColumnExpr("ts, actions_count as value").
+		Where("action_type = ?", actionType).
+		Order("ts desc")
+
+	if !req.From.IsZero() {
+		query = query.Where("ts >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("ts < ?", req.To)
+	}
+
+	err = query.Limit(100).Scan(ctx, &response)
+	return
+}
+
+func (s Stats) ActionBreakdown(ctx context.Context, timeframe storage.Timeframe) (response []storage.ActionTypeShare, err error) {
+	query := s.db.DB().NewSelect().
+		ColumnExpr("action_type, sum(actions_count) as actions_count").
+		ColumnExpr("sum(actions_count) * 100.0 / sum(sum(actions_count)) over () as share").
+		Group("action_type").
+		Order("actions_count desc")
+
+	switch timeframe {
+	case storage.TimeframeDay:
+		query = query.
+			Table(storage.ViewActionStatsByHour).
+			Where("ts > now() - '1 day'::interval")
+	case storage.TimeframeWeek:
+		query = query.
+			Table(storage.ViewActionStatsByDay).
+			Where("ts > now() - '7 days'::interval")
+	case storage.TimeframeMonth:
+		query = query.
+			Table(storage.ViewActionStatsByDay).
+			Where("ts > now() - '1 month'::interval")
+	case "":
+		query = query.Table(storage.ViewActionStatsByMonth)
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+
+	err = query.Scan(ctx, &response)
+	return
+}
+
+func (s Stats) FeeByActionType(ctx context.Context) (response []storage.FeeActionSummary, err error) {
+	err = s.db.DB().NewSelect().
+		Table(storage.ViewFeeActionStatsByMonth).
+		ColumnExpr("sum(amount) as amount").
+		ColumnExpr("sum(fee_count) as fee_count").
+		Column("action_type", "asset").
+		Where("action_type is not null").
+		Group("action_type", "asset").
+		Order("amount desc").
+		Scan(ctx, &response)
+	return
+}
+
+func (s Stats) FeeSeries(ctx context.Context, timeframe storage.Timeframe, req storage.SeriesRequest, fltrs storage.FeeSeriesFilter) (response []storage.SeriesItem, err error) {
+	var view string
+	switch timeframe {
+	case storage.TimeframeHour:
+		view = storage.ViewFeeActionStatsByHour
+	case storage.TimeframeDay:
+		view = storage.ViewFeeActionStatsByDay
+	case storage.TimeframeMonth:
+		view = storage.ViewFeeActionStatsByMonth
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+
+	query := s.db.DB().NewSelect().
+		Table(view).
+		ColumnExpr("ts, sum(amount) as value, max(max_amount) as max, min(min_amount) as min").
+		Group("ts").
+		Order("ts desc")
+
+	if fltrs.ActionType != "" {
+		query = query.Where("action_type = ?", fltrs.ActionType)
+	}
+	if fltrs.Asset != "" {
+		query = query.Where("asset = ?", fltrs.Asset)
+	}
+	if !req.From.IsZero() {
+		query = query.Where("ts >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("ts < ?", req.To)
+	}
+
+	err = query.Limit(100).Scan(ctx, &response)
+	return
+}
+
+func (s Stats) TopFeePayers(ctx context.Context, fltrs storage.TopFeePayersFilter) (response []storage.FeePayer, err error) {
+	query := s.db.DB().NewSelect().
+		Table(storage.ViewFeePayerStatsByDay).
+		ColumnExpr("payer_id, asset, sum(amount) as amount, sum(fee_count) as fee_count").
+		Group("payer_id", "asset").
+		Order("amount desc")
+
+	switch fltrs.Timeframe {
+	case storage.TimeframeDay:
+		query = query.Where("ts > now() - '1 day'::interval")
+	case storage.TimeframeWeek:
+		query = query.Where("ts > now() - '7 days'::interval")
+	case storage.TimeframeMonth:
+		query = query.Where("ts > now() - '1 month'::interval")
+	case "":
+	default:
+		return nil, errors.Errorf("unexpected timeframe %s", fltrs.Timeframe)
+	}
+
+	if fltrs.Asset != "" {
+		query = query.Where("asset = ?", fltrs.Asset)
+	}
+	query = limitScope(query, fltrs.Limit)
+
+	q := s.db.DB().NewSelect().
+		TableExpr("(?) as payers", query).
+		ColumnExpr("payers.*").
+		ColumnExpr("address.hash as payer__hash").
+		Join("left join address on address.id = payers.payer_id").
+		Order("amount desc")
+	q = joinCelestials(q, "payer__", "payers.payer_id")
+
+	err = q.Scan(ctx, &response)
+	return
+}
+
+func (s Stats) RollupFees(ctx context.Context, fltrs storage.RollupFeeFilter) (response []storage.RollupFee, err error) {
+	query := s.db.DB().NewSelect().
+		Table(storage.ViewFeeRollupStatsByMonth).
+		ColumnExpr("rollup_id, asset, sum(amount) as amount, sum(fee_count) as fee_count").
+		Group("rollup_id", "asset").
+		Order("amount desc")
+
+	if len(fltrs.RollupIds) > 0 {
+		query = query.Where("rollup_id IN (?)", bun.In(fltrs.RollupIds))
+	}
+	if fltrs.Asset != "" {
+		query = query.Where("asset = ?", fltrs.Asset)
+	}
+	query = limitScope(query, fltrs.Limit)
+	query = offsetScope(query, fltrs.Offset)
+
+	err = s.db.DB().NewSelect().
+		TableExpr("(?) as fees", query).
+		ColumnExpr("fees.*").
+		ColumnExpr("rollup.astria_id as rollup__astria_id").
+		Join("left join rollup on rollup.id = fees.rollup_id").
+		Order("amount desc").
+		Scan(ctx, &response)
+	return
+}
+
+// distributionInterval - returns time window of distribution
+func distributionInterval(timeframe storage.Timeframe) (string, error) {
+	switch timeframe {
+	case storage.TimeframeDay, "":
+		return "1 day", nil
+	case storage.TimeframeWeek:
+		return "7 days", nil
+	case storage.TimeframeMonth:
+		return "1 month", nil
+	default:
+		return "", errors.Errorf("unexpected timeframe %s", timeframe)
+	}
+}
+
+// distributionSource - returns query of values in time window which distribution is computed
+func (s Stats) distributionSource(name string, fltrs storage.DistributionFilter) (*bun.SelectQuery, error) {
+	interval, err := distributionInterval(fltrs.Timeframe)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.DB().NewSelect()
+	switch name {
+	case storage.DistributionBlockTime:
+		query = query.
+			Table(storage.BlockStats{}.TableName()).
+			ColumnExpr("block_time::float8 as value").
+			Where("block_time > 0")
+	case storage.DistributionTxSize:
+		query = query.
+			Table(storage.BlockStats{}.TableName()).
+			ColumnExpr("bytes_in_block::float8 / tx_count as value").
+			Where("tx_count > 0")
+	case storage.DistributionFee:
+		asset := fltrs.Asset
+		if asset == "" {
+			asset = currency.DefaultCurrency
+		}
+		query = query.
+			Table(storage.Fee{}.TableName()).
+			ColumnExpr("amount::float8 as value").
+			Where("asset = ?", asset)
+		if fltrs.RollupId > 0 {
+			query = query.Where("rollup_id = ?", fltrs.RollupId)
+		}
+	default:
+		return nil, errors.Errorf("unexpected distribution name: %s", name)
+	}
+
+	if fltrs.RollupId > 0 && name != storage.DistributionFee {
+		return nil, errors.Errorf("distribution %s can not be filtered by rollup", name)
+	}
+
+	return query.Where("time > now() - ?::interval", interval), nil
+}
+
+type histogramCount struct {
+	Bucket int   `bun:"bucket"`
+	Count  int64 `bun:"count"`
+}
+
+func (s Stats) Distribution(ctx context.Context, name string, fltrs storage.DistributionFilter) (response storage.Distribution, err error) {
+	if name == storage.DistributionRollupSize {
+		return s.rollupSizeDistribution(ctx, fltrs)
+	}
+
+	source, err := s.distributionSource(name, fltrs)
+	if err != nil {
+		return
+	}
+
+	err = s.db.DB().NewSelect().
+		With("source", source).
+		Table("source").
+		ColumnExpr("count(*) as count").
+		ColumnExpr("coalesce(min(value), 0) as min").
+		ColumnExpr("coalesce(max(value), 0) as max").
+		ColumnExpr("coalesce(avg(value), 0) as avg").
+		ColumnExpr("coalesce(percentile_cont(0.5) within group (order by value), 0) as p50").
+		ColumnExpr("coalesce(percentile_cont(0.9) within group (order by value), 0) as p90").
+		ColumnExpr("coalesce(percentile_cont(0.99) within group (order by value), 0) as p99").
+		Scan(ctx, &response)
+	if err != nil {
+		return
+	}
+
+	buckets, ok := histogramBuckets(&response, fltrs.Buckets)
+	if !ok {
+		return
+	}
+
+	// values equal to maximum and values which appeared after the first query are put to the edge buckets
+	var counts []histogramCount
+	err = s.db.DB().NewSelect().
+		With("source", source).
+		Table("source").
+		ColumnExpr("greatest(least(width_bucket(value, ?::float8, ?::float8, ?), ?), 1) as bucket", response.Min, response.Max, buckets, buckets).
+		ColumnExpr("count(*) as count").
+		GroupExpr("1").
+		Scan(ctx, &counts)
+	if err != nil {
+		return
+	}
+
+	response.Histogram = histogramRanges(response.Min, response.Max, buckets)
+	for i := range counts {
+		if counts[i].Bucket < 1 || counts[i].Bucket > buckets {
+			continue
+		}
+		response.Histogram[counts[i].Bucket-1].Count += counts[i].Count
+	}
+	return
+}
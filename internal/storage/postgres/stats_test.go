@@ -15,6 +15,7 @@ import (
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -112,6 +113,63 @@ func (s *StatsTestSuite) TestRollupSeriesWithFrom() {
 	s.Require().Len(items, 0)
 }
 
+func (s *StatsTestSuite) TestSeriesCustomBucket() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	req := storage.SeriesRequest{
+		To: time.Date(2023, 12, 1, 1, 0, 0, 0, time.UTC),
+	}
+	for _, tf := range []storage.Timeframe{"15m", "4h", storage.TimeframeWeek} {
+		items, err := s.Stats.Series(ctx, tf, storage.SeriesTPS, req)
+		s.Require().NoError(err, tf)
+		s.Require().Len(items, 1, tf)
+	}
+
+	_, err := s.Stats.Series(ctx, "1y", storage.SeriesTPS, req)
+	s.Require().Error(err)
+}
+
+func (s *StatsTestSuite) TestRollupSeriesCustomBucket() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	req := storage.SeriesRequest{
+		To: time.Date(2023, 12, 1, 1, 0, 0, 0, time.UTC),
+	}
+	for _, tf := range []storage.Timeframe{"5m", "2h", "1w"} {
+		items, err := s.Stats.RollupSeries(ctx, 1, tf, storage.RollupSeriesActionsCount, req)
+		s.Require().NoError(err, tf)
+		s.Require().Len(items, 1, tf)
+	}
+}
+
+func (s *StatsTestSuite) TestCompareRollupSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	req := storage.SeriesRequest{
+		To: time.Date(2023, 12, 1, 1, 0, 0, 0, time.UTC),
+	}
+	for _, tf := range []storage.Timeframe{storage.TimeframeDay, "15m"} {
+		points, err := s.Stats.CompareRollupSeries(ctx, []uint64{1, 2}, tf, storage.RollupSeriesSize, req)
+		s.Require().NoError(err, tf)
+		s.Require().NotEmpty(points, tf)
+
+		for i := range points {
+			s.Require().Contains([]uint64{1, 2}, points[i].RollupId)
+			s.Require().NotEmpty(points[i].Value)
+			if i > 0 {
+				s.Require().False(points[i].Time.After(points[i-1].Time))
+			}
+		}
+	}
+
+	points, err := s.Stats.CompareRollupSeries(ctx, nil, storage.TimeframeDay, storage.RollupSeriesSize, req)
+	s.Require().NoError(err)
+	s.Require().Len(points, 0)
+}
+
 func (s *StatsTestSuite) TestSummary() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -152,6 +210,117 @@ func (s *StatsTestSuite) TestFeeSummary() {
 	s.Require().Len(summary, 2)
 }
 
+func (s *StatsTestSuite) TestFeeByActionType() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	summary, err := s.Stats.FeeByActionType(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(summary, 2)
+
+	for _, item := range summary {
+		switch item.ActionType {
+		case "rollup_data_submission":
+			s.Require().Equal("nria", item.Asset)
+		case "transfer":
+			s.Require().Equal("asset-2", item.Asset)
+		default:
+			s.T().Errorf("unexpected action type: %s", item.ActionType)
+		}
+		s.Require().EqualValues(1, item.FeeCount)
+		s.Require().Equal("100", item.Amount)
+	}
+}
+
+func (s *StatsTestSuite) TestFeeSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		series, err := s.Stats.FeeSeries(ctx, tf, storage.SeriesRequest{}, storage.FeeSeriesFilter{})
+		s.Require().NoError(err, tf)
+		s.Require().Len(series, 1, tf)
+		s.Require().Equal("200", series[0].Value, tf)
+
+		series, err = s.Stats.FeeSeries(ctx, tf, storage.SeriesRequest{}, storage.FeeSeriesFilter{
+			ActionType: "transfer",
+			Asset:      "asset-2",
+		})
+		s.Require().NoError(err, tf)
+		s.Require().Len(series, 1, tf)
+		s.Require().Equal("100", series[0].Value, tf)
+	}
+}
+
+func (s *StatsTestSuite) TestTopFeePayers() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	payers, err := s.Stats.TopFeePayers(ctx, storage.TopFeePayersFilter{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(payers, 2)
+	for i := range payers {
+		s.Require().NotNil(payers[i].Payer)
+		s.Require().NotEmpty(payers[i].Payer.Hash)
+	}
+
+	payers, err = s.Stats.TopFeePayers(ctx, storage.TopFeePayersFilter{
+		Timeframe: storage.TimeframeDay,
+		Limit:     10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(payers, 0)
+}
+
+func (s *StatsTestSuite) TestRollupFees() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	fees, err := s.Stats.RollupFees(ctx, storage.RollupFeeFilter{
+		Limit: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(fees, 1)
+	s.Require().EqualValues(1, fees[0].RollupId)
+	s.Require().Equal("nria", fees[0].Asset)
+	s.Require().Equal("100", fees[0].Amount)
+	s.Require().EqualValues(1, fees[0].FeeCount)
+	s.Require().NotNil(fees[0].Rollup)
+	s.Require().NotEmpty(fees[0].Rollup.AstriaId)
+
+	fees, err = s.Stats.RollupFees(ctx, storage.RollupFeeFilter{
+		RollupIds: []uint64{2},
+		Limit:     10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(fees, 0)
+}
+
+func (s *StatsTestSuite) TestRollupCadence() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	cadence, err := s.Stats.RollupCadence(ctx, time.Date(2023, 11, 30, 23, 30, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Len(cadence, 1)
+	s.Require().EqualValues(1, cadence[0].RollupId)
+	s.Require().EqualValues(1, cadence[0].ActionsCount)
+	s.Require().False(cadence[0].FirstTime.IsZero())
+	s.Require().False(cadence[0].LastTime.IsZero())
+	s.Require().NotNil(cadence[0].Rollup)
+	s.Require().NotEmpty(cadence[0].Rollup.AstriaId)
+
+	cadence, err = s.Stats.RollupCadence(ctx, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Len(cadence, 0)
+}
+
 func (s *StatsTestSuite) TestTokenTransferDistribution() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -183,6 +352,198 @@ func (s *StatsTestSuite) TestActiveAddressCount() {
 	s.Require().EqualValues(1, value)
 }
 
+func (s *StatsTestSuite) TestAddressSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	_, err := s.storage.Connection().Exec(ctx, "REFRESH MATERIALIZED VIEW new_addresses_by_day;")
+	s.Require().NoError(err)
+
+	req := storage.SeriesRequest{
+		To: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	active, err := s.Stats.AddressSeries(ctx, storage.TimeframeDay, storage.AddressSeriesActive, req)
+	s.Require().NoError(err)
+	s.Require().Len(active, 2)
+	s.Require().Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), active[0].Time.UTC())
+	s.Require().Equal("1", active[0].Value)
+	s.Require().Equal(time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC), active[1].Time.UTC())
+	s.Require().Equal("1", active[1].Value)
+
+	returning, err := s.Stats.AddressSeries(ctx, storage.TimeframeDay, storage.AddressSeriesReturning, req)
+	s.Require().NoError(err)
+	s.Require().Len(returning, 1)
+	s.Require().Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), returning[0].Time.UTC())
+	s.Require().Equal("1", returning[0].Value)
+
+	churned, err := s.Stats.AddressSeries(ctx, storage.TimeframeDay, storage.AddressSeriesChurned, req)
+	s.Require().NoError(err)
+	s.Require().Len(churned, 1)
+	s.Require().Equal(time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC), churned[0].Time.UTC())
+	s.Require().Equal("1", churned[0].Value)
+
+	newAddresses, err := s.Stats.AddressSeries(ctx, storage.TimeframeDay, storage.AddressSeriesNew, req)
+	s.Require().NoError(err)
+	s.Require().Len(newAddresses, 1)
+	s.Require().Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), newAddresses[0].Time.UTC())
+	s.Require().Equal("1", newAddresses[0].Value)
+
+	monthly, err := s.Stats.AddressSeries(ctx, storage.TimeframeMonth, storage.AddressSeriesReturning, req)
+	s.Require().NoError(err)
+	s.Require().Len(monthly, 1)
+	s.Require().Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), monthly[0].Time.UTC())
+
+	weekly, err := s.Stats.AddressSeries(ctx, storage.TimeframeWeek, storage.AddressSeriesActive, req)
+	s.Require().NoError(err)
+	s.Require().Len(weekly, 1)
+	s.Require().Equal("1", weekly[0].Value)
+
+	_, err = s.Stats.AddressSeries(ctx, storage.TimeframeHour, storage.AddressSeriesActive, req)
+	s.Require().Error(err)
+}
+
+func (s *StatsTestSuite) TestActionSeries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, tf := range []storage.Timeframe{
+		storage.TimeframeHour,
+		storage.TimeframeDay,
+		storage.TimeframeMonth,
+	} {
+		series, err := s.Stats.ActionSeries(ctx, tf, string(types.ActionTypeRollupDataSubmission), storage.SeriesRequest{})
+		s.Require().NoError(err, tf)
+		s.Require().Len(series, 1, tf)
+		s.Require().Equal("1", series[0].Value, tf)
+	}
+
+	series, err := s.Stats.ActionSeries(ctx, storage.TimeframeDay, string(types.ActionTypeBridgeLock), storage.SeriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(series, 0)
+}
+
+func (s *StatsTestSuite) TestDistribution() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	const asset = "distribution_test"
+	now := time.Now().UTC()
+	fees := make([]storage.Fee, 0, 4)
+	for i, amount := range []int64{1000, 2000, 3000, 3000} {
+		fees = append(fees, storage.Fee{
+			Height:     10000,
+			Time:       now.Add(-time.Duration(i) * time.Minute),
+			Asset:      asset,
+			Amount:     decimal.NewFromInt(amount),
+			ActionType: types.ActionTypeTransfer,
+		})
+	}
+	_, err := s.storage.Connection().DB().NewInsert().Model(&fees).Exec(ctx)
+	s.Require().NoError(err)
+
+	distribution, err := s.Stats.Distribution(ctx, storage.DistributionFee, storage.DistributionFilter{
+		Timeframe: storage.TimeframeDay,
+		Asset:     asset,
+		Buckets:   2,
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(4, distribution.Count)
+	s.Require().EqualValues(1000, distribution.Min)
+	s.Require().EqualValues(3000, distribution.Max)
+	s.Require().EqualValues(2250, distribution.Avg)
+	s.Require().EqualValues(2500, distribution.P50)
+	s.Require().Len(distribution.Histogram, 2)
+	s.Require().EqualValues(1000, distribution.Histogram[0].From)
+	s.Require().EqualValues(2000, distribution.Histogram[0].To)
+	s.Require().EqualValues(1, distribution.Histogram[0].Count)
+	s.Require().EqualValues(3000, distribution.Histogram[1].To)
+	s.Require().EqualValues(3, distribution.Histogram[1].Count)
+
+	_, err = s.storage.Connection().DB().NewDelete().
+		Model((*storage.Fee)(nil)).
+		Where("asset = ?", asset).
+		Exec(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *StatsTestSuite) TestDistributionRollupSize() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	now := time.Now().UTC()
+	actions := []storage.RollupAction{
+		{RollupId: 100, ActionId: 10001, Time: now, Height: 10000, Size: 100, ActionType: types.ActionTypeRollupDataSubmission},
+		{RollupId: 100, ActionId: 10002, Time: now, Height: 10000, Size: 300, ActionType: types.ActionTypeRollupDataSubmission},
+		{RollupId: 100, ActionId: 10003, Time: now, Height: 10000, Size: 0, ActionType: types.ActionTypeInitBridgeAccount},
+	}
+	_, err := s.storage.Connection().DB().NewInsert().Model(&actions).Exec(ctx)
+	s.Require().NoError(err)
+
+	distribution, err := s.Stats.Distribution(ctx, storage.DistributionRollupSize, storage.DistributionFilter{
+		RollupId: 100,
+		Buckets:  2,
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, distribution.Count)
+	s.Require().EqualValues(100, distribution.Min)
+	s.Require().EqualValues(300, distribution.Max)
+	s.Require().InDelta(200, distribution.Avg, 1)
+	s.Require().Len(distribution.Histogram, 2)
+	s.Require().EqualValues(1, distribution.Histogram[0].Count)
+	s.Require().EqualValues(1, distribution.Histogram[1].Count)
+
+	_, err = s.storage.Connection().DB().NewDelete().
+		Model((*storage.RollupAction)(nil)).
+		Where("rollup_id = ?", 100).
+		Exec(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *StatsTestSuite) TestDistributionEmpty() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	for _, name := range []string{
+		storage.DistributionRollupSize,
+		storage.DistributionBlockTime,
+		storage.DistributionTxSize,
+	} {
+		distribution, err := s.Stats.Distribution(ctx, name, storage.DistributionFilter{
+			Timeframe: storage.TimeframeWeek,
+		})
+		s.Require().NoError(err, name)
+		s.Require().EqualValues(0, distribution.Count, name)
+		s.Require().Len(distribution.Histogram, 0, name)
+	}
+
+	_, err := s.Stats.Distribution(ctx, storage.DistributionBlockTime, storage.DistributionFilter{
+		RollupId: 1,
+	})
+	s.Require().Error(err)
+}
+
+func (s *StatsTestSuite) TestActionBreakdown() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	shares, err := s.Stats.ActionBreakdown(ctx, "")
+	s.Require().NoError(err)
+	s.Require().Len(shares, 2)
+
+	var total float64
+	for i := range shares {
+		s.Require().EqualValues(1, shares[i].ActionsCount)
+		s.Require().InDelta(50, shares[i].Share, 0.001)
+		total += shares[i].Share
+	}
+	s.Require().InDelta(100, total, 0.001)
+
+	shares, err = s.Stats.ActionBreakdown(ctx, storage.TimeframeDay)
+	s.Require().NoError(err)
+	s.Require().Len(shares, 0)
+}
+
 func TestSuiteStats_Run(t *testing.T) {
 	suite.Run(t, new(StatsTestSuite))
 }
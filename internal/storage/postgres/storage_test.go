@@ -26,27 +26,38 @@ type StorageTestSuite struct {
 
 	storage *postgres.Storage
 
-	Blocks          storage.IBlock
-	BlockStats      storage.IBlockStats
-	Bridges         storage.IBridge
-	Constants       storage.IConstant
-	Tx              storage.ITx
-	Transfers       storage.ITransfer
-	Fee             storage.IFee
-	Deposit         storage.IDeposit
-	Action          storage.IAction
-	Address         storage.IAddress
-	Rollup          storage.IRollup
-	BlockSignatures storage.IBlockSignature
-	Validator       storage.IValidator
-	State           storage.IState
-	Search          storage.ISearch
-	App             storage.IApp
-	Asset           storage.IAsset
-	Price           storage.IPrice
-	Market          storage.IMarket
-	Celestials      celestials.ICelestial
-	CelestialState  celestials.ICelestialState
+	Blocks            storage.IBlock
+	BlockStats        storage.IBlockStats
+	Bridges           storage.IBridge
+	Constants         storage.IConstant
+	Tx                storage.ITx
+	Transfers         storage.ITransfer
+	Fee               storage.IFee
+	Deposit           storage.IDeposit
+	Action            storage.IAction
+	Address           storage.IAddress
+	Rollup            storage.IRollup
+	BlockSignatures   storage.IBlockSignature
+	Validator         storage.IValidator
+	State             storage.IState
+	Search            storage.ISearch
+	App               storage.IApp
+	Asset             storage.IAsset
+	Price             storage.IPrice
+	Market            storage.IMarket
+	Audit             storage.IAudit
+	RollbackEvents    storage.IRollbackEvent
+	ConstantHistory   storage.IConstantHistory
+	Webhooks          storage.IWebhook
+	WebhookDeliveries storage.IWebhookDelivery
+	WebhookState      storage.IWebhookState
+	ApiKeys           storage.IApiKey
+	ApiTiers          storage.IApiTier
+	ApiKeyUsage       storage.IApiKeyUsage
+	Operators         storage.IOperator
+	AuditLogs         storage.IAuditLog
+	Celestials        celestials.ICelestial
+	CelestialState    celestials.ICelestialState
 }
 
 // SetupSuite -
@@ -94,6 +105,17 @@ func (s *StorageTestSuite) SetupSuite() {
 	s.Asset = NewAsset(s.storage)
 	s.Price = NewPrice(s.storage)
 	s.Market = NewMarket(s.storage)
+	s.Audit = NewAudit(s.storage)
+	s.RollbackEvents = NewRollbackEvent(s.storage)
+	s.ConstantHistory = NewConstantHistory(s.storage)
+	s.Webhooks = NewWebhook(s.storage)
+	s.WebhookDeliveries = NewWebhookDelivery(s.storage)
+	s.WebhookState = NewWebhookState(s.storage)
+	s.ApiKeys = NewApiKey(s.storage)
+	s.ApiTiers = NewApiTier(s.storage)
+	s.ApiKeyUsage = NewApiKeyUsage(s.storage)
+	s.Operators = NewOperator(s.storage)
+	s.AuditLogs = NewAuditLog(s.storage)
 	s.Celestials = celestialsPg.NewCelestials(s.storage.Connection())
 	s.CelestialState = celestialsPg.NewCelestialState(s.storage.Connection())
 
@@ -5,9 +5,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
 
 	models "github.com/celenium-io/astria-indexer/internal/storage"
@@ -32,6 +34,15 @@ func (tx Transaction) SaveConstants(ctx context.Context, constants ...models.Con
 	return err
 }
 
+func (tx Transaction) SaveConstantHistory(ctx context.Context, history ...*models.ConstantHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&history).Returning("id").Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveTransactions(ctx context.Context, txs ...*models.Tx) error {
 	if len(txs) == 0 {
 		return nil
@@ -324,6 +335,14 @@ func (tx Transaction) SaveBridges(ctx context.Context, bridges ...*models.Bridge
 			query.Set("fee_asset = ?", bridges[i].FeeAsset)
 		}
 
+		if bridges[i].RollupId > 0 {
+			query.Set("rollup_id = ?", bridges[i].RollupId)
+		}
+
+		if bridges[i].Asset != "" {
+			query.Set("asset = ?", bridges[i].Asset)
+		}
+
 		if _, err := query.Returning("xmax, id").Exec(ctx); err != nil {
 			return count, err
 		}
@@ -378,6 +397,11 @@ func (tx Transaction) RollbackTxs(ctx context.Context, height types.Level) (txs
 	return
 }
 
+func (tx Transaction) RollbackConstantHistory(ctx context.Context, height types.Level) (history []models.ConstantHistory, err error) {
+	_, err = tx.Tx().NewDelete().Model(&history).Where("height = ?", height).Returning("*").Exec(ctx)
+	return
+}
+
 func (tx Transaction) RollbackActions(ctx context.Context, height types.Level) (actions []models.Action, err error) {
 	_, err = tx.Tx().NewDelete().Model(&actions).Where("height = ?", height).Returning("*").Exec(ctx)
 	return
@@ -498,6 +522,22 @@ func (tx Transaction) UpdateAddresses(ctx context.Context, addresses ...*models.
 	return err
 }
 
+func (tx Transaction) SetAddressesNonce(ctx context.Context, addresses ...*models.Address) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	values := tx.Tx().NewValues(&addresses)
+
+	_, err := tx.Tx().NewUpdate().
+		With("_data", values).
+		Model((*models.Address)(nil)).
+		TableExpr("_data").
+		Set("nonce = _data.nonce").
+		Where("address.id = _data.id").
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) UpdateRollups(ctx context.Context, rollups ...*models.Rollup) error {
 	if len(rollups) == 0 {
 		return nil
@@ -510,11 +550,78 @@ func (tx Transaction) UpdateRollups(ctx context.Context, rollups ...*models.Roll
 		TableExpr("_data").
 		Set("actions_count = rollup.actions_count + _data.actions_count").
 		Set("size = rollup.size + _data.size").
+		Set("bridge_count = rollup.bridge_count + _data.bridge_count").
 		Where("rollup.id = _data.id").
 		Exec(ctx)
 	return err
 }
 
+func (tx Transaction) RecalculateAddresses(ctx context.Context, fromHeight types.Level) error {
+	_, err := recalculateAddressesQuery(tx.Tx()).
+		Where("address.id IN (SELECT address_id FROM address_action WHERE height >= ?0 UNION SELECT signer_id FROM tx WHERE height >= ?0)", fromHeight).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RecalculateAddressesById(ctx context.Context, ids ...uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := recalculateAddressesQuery(tx.Tx()).
+		Where("address.id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RecalculateRollups(ctx context.Context, fromHeight types.Level) error {
+	_, err := recalculateRollupsQuery(tx.Tx()).
+		Where("rollup.id IN (SELECT rollup_id FROM rollup_action WHERE height >= ?0 UNION SELECT rollup_id FROM bridge WHERE init_height >= ?0)", fromHeight).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RecalculateRollupsById(ctx context.Context, ids ...uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := recalculateRollupsQuery(tx.Tx()).
+		Where("rollup.id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	return err
+}
+
+func recalculateAddressesQuery(db bun.IDB) *bun.UpdateQuery {
+	return db.NewUpdate().
+		Model((*models.Address)(nil)).
+		Set("actions_count = (SELECT count(*) FROM address_action WHERE address_action.address_id = address.id)").
+		Set("signed_tx_count = (SELECT count(*) FROM tx WHERE tx.signer_id = address.id)").
+		Set("nonce = COALESCE((SELECT tx.nonce FROM tx WHERE tx.signer_id = address.id ORDER BY tx.id DESC LIMIT 1), 0)")
+}
+
+func recalculateRollupsQuery(db bun.IDB) *bun.UpdateQuery {
+	return db.NewUpdate().
+		Model((*models.Rollup)(nil)).
+		Set("actions_count = (SELECT count(*) FROM rollup_action WHERE rollup_action.rollup_id = rollup.id)").
+		Set("size = (SELECT COALESCE(sum(rollup_action.size), 0) FROM rollup_action WHERE rollup_action.rollup_id = rollup.id)").
+		Set("bridge_count = (SELECT count(*) FROM bridge WHERE bridge.rollup_id = rollup.id)")
+}
+
+func (tx Transaction) RecalculateState(ctx context.Context, name string) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.State)(nil)).
+		Set("total_tx = (SELECT COALESCE(sum(tx_count), 0) FROM block_stats)").
+		Set("total_supply = (SELECT COALESCE(sum(supply_change), 0) FROM block_stats)").
+		Set("total_accounts = (SELECT count(*) FROM address)").
+		Set("total_rollups = (SELECT count(*) FROM rollup)").
+		Set("total_bridges = (SELECT count(*) FROM bridge)").
+		Set("total_bytes = (SELECT COALESCE(sum(size), 0) FROM rollup)").
+		Where("name = ?", name).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) LastNonce(ctx context.Context, id uint64) (uint32, error) {
 	var nonce uint32
 	_, err := tx.Tx().NewSelect().
@@ -556,19 +663,46 @@ func (tx Transaction) UpdateConstants(ctx context.Context, constants ...*models.
 	if len(constants) == 0 {
 		return nil
 	}
-	values := tx.Tx().NewValues(&constants)
 
-	_, err := tx.Tx().NewUpdate().
-		With("_data", values).
+	_, err := tx.Tx().NewInsert().Model(&constants).
+		On("CONFLICT (module, name) DO UPDATE").
+		Set("value = EXCLUDED.value").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) DeleteConstants(ctx context.Context, constants ...*models.Constant) error {
+	if len(constants) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewDelete().
 		Model((*models.Constant)(nil)).
-		TableExpr("_data").
-		Set("value = _data.value").
-		Where("constant.module = _data.module").
-		Where("constant.name = _data.name").
+		Where("(module, name) IN (?)", bun.In(constantKeys(constants))).
 		Exec(ctx)
 	return err
 }
 
+func (tx Transaction) GetConstants(ctx context.Context, constants ...*models.Constant) (result []models.Constant, err error) {
+	if len(constants) == 0 {
+		return
+	}
+
+	err = tx.Tx().NewSelect().
+		Model(&result).
+		Where("(module, name) IN (?)", bun.In(constantKeys(constants))).
+		Scan(ctx)
+	return
+}
+
+func constantKeys(constants []*models.Constant) [][]string {
+	keys := make([][]string, len(constants))
+	for i := range constants {
+		keys[i] = []string{string(constants[i].Module), constants[i].Name}
+	}
+	return keys
+}
+
 func (tx Transaction) GetRollup(ctx context.Context, rollupId []byte) (rollup models.Rollup, err error) {
 	err = tx.Tx().NewSelect().
 		Model(&rollup).
@@ -666,10 +800,35 @@ func (tx Transaction) UpdateApp(ctx context.Context, app *models.App) error {
 	return err
 }
 
+// ReplaceApp - updates all columns of application including empty ones
+func (tx Transaction) ReplaceApp(ctx context.Context, app *models.App) error {
+	if app == nil || app.Id == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewUpdate().
+		Model(app).
+		ExcludeColumn("id").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) DeleteApp(ctx context.Context, appId uint64) error {
 	if appId == 0 {
 		return nil
 	}
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.AppRollup)(nil)).
+		Where("app_id = ?", appId).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.AppBridge)(nil)).
+		Where("app_id = ?", appId).
+		Exec(ctx); err != nil {
+		return err
+	}
 	_, err := tx.Tx().NewDelete().
 		Model((*models.App)(nil)).
 		Where("id = ?", appId).
@@ -677,6 +836,165 @@ func (tx Transaction) DeleteApp(ctx context.Context, appId uint64) error {
 	return err
 }
 
+func (tx Transaction) SaveAppRollup(ctx context.Context, link *models.AppRollup) error {
+	if link == nil {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(link).
+		On("CONFLICT (app_id, rollup_id) DO UPDATE").
+		Set("role = EXCLUDED.role").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) DeleteAppRollup(ctx context.Context, appId, rollupId uint64) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.AppRollup)(nil)).
+		Where("app_id = ?", appId).
+		Where("rollup_id = ?", rollupId).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveAppBridge(ctx context.Context, link *models.AppBridge) error {
+	if link == nil {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(link).
+		On("CONFLICT (app_id, bridge_id) DO UPDATE").
+		Set("role = EXCLUDED.role").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) DeleteAppBridge(ctx context.Context, appId, bridgeId uint64) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.AppBridge)(nil)).
+		Where("app_id = ?", appId).
+		Where("bridge_id = ?", bridgeId).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveAuditLog(ctx context.Context, log *models.AuditLog) error {
+	if log == nil {
+		return nil
+	}
+	return tx.Add(ctx, log)
+}
+
+// DeleteApiTier - removes tier. Tier can not be removed while it's used by API keys.
+func (tx Transaction) DeleteApiTier(ctx context.Context, id uint64) error {
+	count, err := tx.Tx().NewSelect().
+		Model((*models.ApiKey)(nil)).
+		Where("tier_id = ?", id).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.Wrapf(models.ErrValidation, "tier %d is used by %d api keys", id, count)
+	}
+
+	_, err = tx.Tx().NewDelete().
+		Model((*models.ApiTier)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+// DeleteApiKey - removes API key and its usage
+func (tx Transaction) DeleteApiKey(ctx context.Context, id uint64) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.ApiKeyUsage)(nil)).
+		Where("api_key_id = ?", id).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Tx().NewDelete().
+		Model((*models.ApiKey)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+// DeleteWebhook - removes webhook and its deliveries
+func (tx Transaction) DeleteWebhook(ctx context.Context, id uint64) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.WebhookDelivery)(nil)).
+		Where("webhook_id = ?", id).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Webhook)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveWebhookDeliveries(ctx context.Context, deliveries ...*models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().Model(&deliveries).Returning("id").Exec(ctx)
+	return err
+}
+
+// LockWebhookState - takes transaction-level advisory lock of the producer cursor and returns the stored cursor. It returns nil if cursor is not saved yet. Lock is held until the transaction ends, so concurrent producers apply their changes of the cursor one by one.
+func (tx Transaction) LockWebhookState(ctx context.Context, name string) (*models.WebhookState, error) {
+	if _, err := tx.Tx().ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", name); err != nil {
+		return nil, errors.Wrap(err, "advisory lock")
+	}
+
+	var cursor models.WebhookState
+	err := tx.Tx().NewSelect().
+		Model(&cursor).
+		Where("name = ?", name).
+		Limit(1).
+		Scan(ctx)
+	switch {
+	case err == nil:
+		return &cursor, nil
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+func (tx Transaction) SaveWebhookState(ctx context.Context, cursor *models.WebhookState) error {
+	if cursor == nil {
+		return nil
+	}
+	_, err := tx.Tx().NewInsert().
+		Model(cursor).
+		On("CONFLICT (name) DO UPDATE").
+		Set("height = EXCLUDED.height").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) CancelWebhookDeliveries(ctx context.Context, fromHeight types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.WebhookDelivery)(nil)).
+		Where("status = ?", models.WebhookDeliveryPending).
+		Where("height >= ?", fromHeight).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) GetApp(ctx context.Context, id uint64) (app models.App, err error) {
+	err = tx.Tx().NewSelect().
+		Model(&app).
+		Where("id = ?", id).
+		Limit(1).
+		Scan(ctx)
+	return
+}
+
 func (tx Transaction) RefreshLeaderboard(ctx context.Context) error {
 	_, err := tx.Tx().ExecContext(ctx, "REFRESH MATERIALIZED VIEW leaderboard;")
 	return err
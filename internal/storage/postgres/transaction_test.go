@@ -40,6 +40,9 @@ type TransactionTestSuite struct {
 	Validator      storage.IValidator
 	Markets        storage.IMarket
 	Prices         storage.IPrice
+	State          storage.IState
+	Deliveries     storage.IWebhookDelivery
+	WebhookState   storage.IWebhookState
 }
 
 // SetupSuite -
@@ -75,6 +78,9 @@ func (s *TransactionTestSuite) SetupSuite() {
 	s.Blocks = NewBlocks(s.storage)
 	s.Markets = NewMarket(s.storage)
 	s.Prices = NewPrice(s.storage)
+	s.State = NewState(s.storage)
+	s.Deliveries = NewWebhookDelivery(s.storage)
+	s.WebhookState = NewWebhookState(s.storage)
 }
 
 // TearDownSuite -
@@ -902,6 +908,27 @@ func (s *TransactionTestSuite) TestUpdateAddresses() {
 	s.Require().EqualValues(3, address.SignedTxCount)
 }
 
+func (s *TransactionTestSuite) TestSetAddressesNonce() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SetAddressesNonce(ctx, &storage.Address{
+		Id:    1,
+		Nonce: 0,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	address, err := s.Address.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, address.Nonce)
+}
+
 func (s *TransactionTestSuite) TestUpdateRollup() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -925,6 +952,80 @@ func (s *TransactionTestSuite) TestUpdateRollup() {
 	s.Require().EqualValues(2, rollup.ActionsCount)
 }
 
+func (s *TransactionTestSuite) TestRecalculateAddresses() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RecalculateAddresses(ctx, 7965)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	address, err := s.Address.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, address.Nonce)
+	s.Require().EqualValues(2, address.ActionsCount)
+	s.Require().EqualValues(2, address.SignedTxCount)
+
+	address, err = s.Address.GetByID(ctx, 8)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, address.Nonce)
+	s.Require().EqualValues(1, address.ActionsCount)
+	s.Require().EqualValues(0, address.SignedTxCount)
+}
+
+func (s *TransactionTestSuite) TestRecalculateRollups() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RecalculateRollups(ctx, 7316)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	rollup, err := s.Rollup.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(34, rollup.Size)
+	s.Require().EqualValues(1, rollup.ActionsCount)
+	s.Require().EqualValues(1, rollup.BridgeCount)
+
+	rollup, err = s.Rollup.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1120, rollup.Size)
+	s.Require().EqualValues(10, rollup.ActionsCount)
+}
+
+func (s *TransactionTestSuite) TestRecalculateState() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.RecalculateState(ctx, "dipdup_astria_indexer")
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	state, err := s.State.ByName(ctx, "dipdup_astria_indexer")
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, state.TotalTx)
+	s.Require().EqualValues(6, state.TotalAccounts)
+	s.Require().EqualValues(2, state.TotalRollups)
+	s.Require().EqualValues(1, state.TotalBridges)
+	s.Require().EqualValues(1232, state.TotalBytes)
+	s.Require().EqualValues("0", state.TotalSupply.String())
+}
+
 func (s *TransactionTestSuite) TestRetentionBlockSignatures() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -991,6 +1092,97 @@ func (s *TransactionTestSuite) TestUpdateConstants() {
 	s.Require().EqualValues("100", c.Value)
 }
 
+func (s *TransactionTestSuite) TestUpdateConstantsInsertsNew() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.UpdateConstants(ctx, &storage.Constant{
+		Module: types.ModuleNameGeneric,
+		Name:   storage.AllowedFeeAssetConstant("nria"),
+		Value:  "true",
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	c, err := s.Constants.Get(ctx, types.ModuleNameGeneric, storage.AllowedFeeAssetConstant("nria"))
+	s.Require().NoError(err)
+	s.Require().EqualValues("true", c.Value)
+}
+
+func (s *TransactionTestSuite) TestGetAndDeleteConstants() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	keys := []*storage.Constant{
+		{Module: types.ModuleNameGeneric, Name: "native_asset_base_denomination"},
+		{Module: types.ModuleNameGeneric, Name: "unknown"},
+	}
+	consts, err := tx.GetConstants(ctx, keys...)
+	s.Require().NoError(err)
+	s.Require().Len(consts, 1)
+	s.Require().Equal("nria", consts[0].Value)
+
+	err = tx.DeleteConstants(ctx, keys...)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	_, err = s.Constants.Get(ctx, types.ModuleNameGeneric, "native_asset_base_denomination")
+	s.Require().Error(err)
+	s.Require().True(s.Constants.IsNoRows(err))
+}
+
+func (s *TransactionTestSuite) TestSaveConstantHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	prev := "12"
+	history := &storage.ConstantHistory{
+		Height:    8000,
+		Time:      time.Now(),
+		ActionId:  10,
+		Module:    types.ModuleNameGeneric,
+		Name:      "transfer_base",
+		Value:     "14",
+		PrevValue: &prev,
+	}
+	err = tx.SaveConstantHistory(ctx, history)
+	s.Require().NoError(err)
+	s.Require().Positive(history.Id)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestRollbackConstantHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	history, err := tx.RollbackConstantHistory(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().EqualValues(2, history[0].Id)
+	s.Require().NotNil(history[0].PrevValue)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
 func (s *TransactionTestSuite) TestGetBridgeIdByAddressId() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -1108,3 +1300,178 @@ func (s *TransactionTestSuite) TestSaveMarketProviders() {
 	s.Require().NoError(err)
 	s.Require().Len(markets, 4)
 }
+
+func (s *TransactionTestSuite) TestSaveAuditLog() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	app, err := tx.GetApp(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, app.Id)
+
+	log := &storage.AuditLog{
+		Time:         time.Now(),
+		OperatorId:   2,
+		OperatorName: "editor",
+		Action:       storage.AuditActionUpdate,
+		Entity:       storage.AuditEntityApp,
+		EntityId:     app.Id,
+		Before:       `{"name":"before"}`,
+		After:        `{"name":"after"}`,
+	}
+	err = tx.SaveAuditLog(ctx, log)
+	s.Require().NoError(err)
+	s.Require().Positive(log.Id)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestReplaceApp() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	app, err := tx.GetApp(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(app.Twitter)
+
+	app.Twitter = ""
+	app.Description = "new description"
+	s.Require().NoError(tx.ReplaceApp(ctx, &app))
+
+	updated, err := tx.GetApp(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Empty(updated.Twitter)
+	s.Require().Equal("new description", updated.Description)
+	s.Require().Equal(app.RollupId, updated.RollupId)
+
+	s.Require().NoError(tx.Rollback(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestAppLinks() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.SaveAppRollup(ctx, &storage.AppRollup{
+		AppId:    1,
+		RollupId: 2,
+		Role:     "devnet",
+	}))
+	s.Require().NoError(tx.SaveAppBridge(ctx, &storage.AppBridge{
+		AppId:    1,
+		BridgeId: 3,
+		Role:     "usdc",
+	}))
+
+	var role string
+	err = tx.Tx().NewSelect().
+		Model((*storage.AppRollup)(nil)).
+		Column("role").
+		Where("app_id = 1 and rollup_id = 2").
+		Scan(ctx, &role)
+	s.Require().NoError(err)
+	s.Require().Equal("devnet", role)
+
+	s.Require().NoError(tx.DeleteAppRollup(ctx, 1, 2))
+	count, err := tx.Tx().NewSelect().Model((*storage.AppRollup)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+
+	s.Require().NoError(tx.DeleteApp(ctx, 1))
+	count, err = tx.Tx().NewSelect().Model((*storage.AppBridge)(nil)).Count(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+
+	s.Require().NoError(tx.Rollback(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestSaveWebhookDeliveries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	now := time.Now().UTC()
+	delivery := &storage.WebhookDelivery{
+		WebhookId:     1,
+		Event:         storage.WebhookEventTransfer,
+		Height:        7968,
+		Payload:       `{"event":"transfer","height":7968}`,
+		Status:        storage.WebhookDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	s.Require().NoError(tx.SaveWebhookDeliveries(ctx, delivery))
+	s.Require().NoError(tx.SaveWebhookState(ctx, &storage.WebhookState{
+		Name:      "webhook_producer",
+		Height:    7968,
+		UpdatedAt: now,
+	}))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+	s.Require().Greater(delivery.Id, uint64(0))
+
+	state, err := s.WebhookState.ByName(ctx, "webhook_producer")
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, state.Id)
+	s.Require().EqualValues(7968, state.Height)
+}
+
+func (s *TransactionTestSuite) TestLockWebhookState() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	state, err := tx.LockWebhookState(ctx, "webhook_producer")
+	s.Require().NoError(err)
+	s.Require().NotNil(state)
+	s.Require().EqualValues(1, state.Id)
+	s.Require().EqualValues(7965, state.Height)
+
+	unknown, err := tx.LockWebhookState(ctx, "unknown")
+	s.Require().NoError(err)
+	s.Require().Nil(unknown)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *TransactionTestSuite) TestCancelWebhookDeliveries() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.CancelWebhookDeliveries(ctx, 7965))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	// delivered and failed deliveries are kept
+	_, err = s.Deliveries.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	_, err = s.Deliveries.GetByID(ctx, 4)
+	s.Require().NoError(err)
+
+	for _, id := range []uint64{2, 3} {
+		_, err = s.Deliveries.GetByID(ctx, id)
+		s.Require().Error(err)
+		s.Require().True(s.Deliveries.IsNoRows(err))
+	}
+}
@@ -4,7 +4,10 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 )
 
@@ -19,3 +22,19 @@ func NewTransfer(db *postgres.Storage) *Transfer {
 		Table: postgres.NewTable[*storage.Transfer](db.Connection()),
 	}
 }
+
+func (t *Transfer) ByHeight(ctx context.Context, height types.Level) (transfers []storage.Transfer, err error) {
+	query := t.DB().NewSelect().
+		Model((*storage.Transfer)(nil)).
+		Where("height = ?", height)
+
+	err = t.DB().NewSelect().
+		TableExpr("(?) as transfer", query).
+		ColumnExpr("transfer.*").
+		ColumnExpr("src.hash as source__hash, dest.hash as destination__hash").
+		Join("left join address as src on src.id = transfer.src_id").
+		Join("left join address as dest on dest.id = transfer.dest_id").
+		OrderExpr("transfer.id asc").
+		Scan(ctx, &transfers)
+	return
+}
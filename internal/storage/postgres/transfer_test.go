@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+func (s *StorageTestSuite) TestTransferByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.Transfers.ByHeight(ctx, 7965)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 2)
+
+	transfer := transfers[0]
+	s.Require().EqualValues(1, transfer.Id)
+	s.Require().Equal("nria", transfer.Asset)
+	s.Require().EqualValues("1", transfer.Amount.String())
+	s.Require().NotNil(transfer.Source)
+	s.Require().Equal("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", transfer.Source.Hash)
+	s.Require().NotNil(transfer.Destination)
+	s.Require().Equal("astria16rgmx2s86kk2r69rhjnvs9y44ujfhadc7yav9a", transfer.Destination.Hash)
+
+	transfers, err = s.Transfers.ByHeight(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 0)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/celenium-io/astria-indexer/internal/storage"
 	"github.com/celenium-io/astria-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
 )
 
 // Tx -
@@ -39,6 +40,22 @@ func (tx *Tx) ByHash(ctx context.Context, hash []byte) (transaction storage.Tx,
 	return
 }
 
+// ByHashes - returns transactions with the hashes. Order of transactions is not defined.
+func (tx *Tx) ByHashes(ctx context.Context, hashes [][]byte) (txs []storage.Tx, err error) {
+	query := tx.DB().NewSelect().Model((*storage.Tx)(nil)).
+		Where("hash IN (?)", bun.In(hashes))
+
+	q := tx.DB().NewSelect().
+		TableExpr("(?) as tx", query).
+		ColumnExpr("tx.*").
+		ColumnExpr("address.hash as signer__hash").
+		Join("left join address on address.id = tx.signer_id")
+
+	q = joinCelestials(q, "signer__", "tx.signer_id")
+	err = q.Scan(ctx, &txs)
+	return
+}
+
 func (tx *Tx) ByHeight(ctx context.Context, height types.Level, limit, offset int) (txs []storage.Tx, err error) {
 	query := tx.DB().NewSelect().Model((*storage.Tx)(nil)).
 		Where("tx.height = ?", height)
@@ -108,6 +108,20 @@ func (s *StorageTestSuite) TestTxFilter() {
 	s.Require().Len(tx.Actions, 1)
 }
 
+func (s *StorageTestSuite) TestTxFilterMaxHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	txs, err := s.Tx.Filter(ctx, storage.TxFilter{
+		Limit:     10,
+		Sort:      sdk.SortOrderAsc,
+		MaxHeight: 7964,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().EqualValues(7316, txs[0].Height)
+}
+
 func (s *StorageTestSuite) TestTxByAddress() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -144,3 +158,31 @@ func (s *StorageTestSuite) TestTxByAddress() {
 
 	s.Require().Len(tx.Actions, 1)
 }
+
+func (s *StorageTestSuite) TestTxByHashes() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	hash1, err := hex.DecodeString("20b0e6310801e7b2a16c69aace7b1a1d550e5c49c80f546941bb1ac747487fe5")
+	s.Require().NoError(err)
+	hash2, err := hex.DecodeString("a7bc8121a38725bd33e5d66b80817a2ba39e517fb6b9244a7081ad2fb210bfcc")
+	s.Require().NoError(err)
+	unknown := make([]byte, 32)
+
+	txs, err := s.Tx.ByHashes(ctx, [][]byte{hash2, unknown, hash1})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 2)
+
+	for i := range txs {
+		s.Require().NotNil(txs[i].Signer)
+		s.Require().Equal("astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p", txs[i].Signer.Hash)
+		switch txs[i].Id {
+		case 1:
+			s.Require().EqualValues(hash1, txs[i].Hash)
+		case 2:
+			s.Require().EqualValues(hash2, txs[i].Hash)
+		default:
+			s.T().Errorf("unexpected tx: %d", txs[i].Id)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Webhook -
+type Webhook struct {
+	*postgres.Table[*storage.Webhook]
+}
+
+// NewWebhook -
+func NewWebhook(db *postgres.Storage) *Webhook {
+	return &Webhook{
+		Table: postgres.NewTable[*storage.Webhook](db.Connection()),
+	}
+}
+
+func (w *Webhook) ByEvent(ctx context.Context, event string) (webhooks []storage.Webhook, err error) {
+	err = w.DB().NewSelect().
+		Model(&webhooks).
+		Where("event = ?", event).
+		Where("active = true").
+		Order("id asc").
+		Scan(ctx)
+	return
+}
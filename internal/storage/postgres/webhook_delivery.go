@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// WebhookDelivery -
+type WebhookDelivery struct {
+	*postgres.Table[*storage.WebhookDelivery]
+}
+
+// NewWebhookDelivery -
+func NewWebhookDelivery(db *postgres.Storage) *WebhookDelivery {
+	return &WebhookDelivery{
+		Table: postgres.NewTable[*storage.WebhookDelivery](db.Connection()),
+	}
+}
+
+// Pending - claims due deliveries by moving their next attempt to lockUntil. Rows locked by concurrent senders are skipped, so every delivery is claimed by one sender only. Unprocessed claims become due again after lockUntil.
+func (wd *WebhookDelivery) Pending(ctx context.Context, now, lockUntil time.Time, limit int) (deliveries []storage.WebhookDelivery, err error) {
+	due := wd.DB().NewSelect().
+		Model((*storage.WebhookDelivery)(nil)).
+		Column("id").
+		Where("status = ?", storage.WebhookDeliveryPending).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at asc").
+		For("UPDATE SKIP LOCKED")
+	due = limitScope(due, limit)
+
+	_, err = wd.DB().NewUpdate().
+		Model((*storage.WebhookDelivery)(nil)).
+		Set("next_attempt_at = ?", lockUntil).
+		Where("id IN (?)", due).
+		Returning("*").
+		Exec(ctx, &deliveries)
+	if err != nil {
+		return
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].Id < deliveries[j].Id
+	})
+	return
+}
+
+func (wd *WebhookDelivery) ByWebhookId(ctx context.Context, webhookId uint64, limit, offset int, sort sdk.SortOrder) (deliveries []storage.WebhookDelivery, err error) {
+	query := wd.DB().NewSelect().
+		Model(&deliveries).
+		Where("webhook_id = ?", webhookId)
+
+	query = limitScope(query, limit)
+	query = offsetScope(query, offset)
+	query = sortScope(query, "id", sort)
+
+	err = query.Scan(ctx)
+	return
+}
+
+func (wd *WebhookDelivery) DeadLetters(ctx context.Context, limit, offset int) (deliveries []storage.WebhookDelivery, err error) {
+	query := wd.DB().NewSelect().
+		Table(storage.ViewWebhookDeadLetter).
+		OrderExpr("id desc")
+
+	query = limitScope(query, limit)
+	query = offsetScope(query, offset)
+
+	err = query.Scan(ctx, &deliveries)
+	return
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// WebhookState -
+type WebhookState struct {
+	*postgres.Table[*storage.WebhookState]
+}
+
+// NewWebhookState -
+func NewWebhookState(db *postgres.Storage) *WebhookState {
+	return &WebhookState{
+		Table: postgres.NewTable[*storage.WebhookState](db.Connection()),
+	}
+}
+
+func (wc *WebhookState) ByName(ctx context.Context, name string) (cursor storage.WebhookState, err error) {
+	err = wc.DB().NewSelect().Model(&cursor).Where("name = ?", name).Scan(ctx)
+	return
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func (s *StorageTestSuite) TestWebhookByEvent() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	webhooks, err := s.Webhooks.ByEvent(ctx, storage.WebhookEventTransfer)
+	s.Require().NoError(err)
+	s.Require().Len(webhooks, 1)
+
+	webhook := webhooks[0]
+	s.Require().EqualValues(1, webhook.Id)
+	s.Require().Equal("https://example.com/hooks/transfer", webhook.Url)
+	s.Require().Equal("astria16rgmx2s86kk2r69rhjnvs9y44ujfhadc7yav9a", webhook.Filter)
+	s.Require().True(webhook.Active)
+
+	webhooks, err = s.Webhooks.ByEvent(ctx, storage.WebhookEventFeeChange)
+	s.Require().NoError(err)
+	s.Require().Len(webhooks, 0)
+}
+
+func (s *StorageTestSuite) TestWebhookDeliveryPending() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	now := time.Date(2023, 12, 1, 0, 30, 0, 0, time.UTC)
+	deliveries, err := s.WebhookDeliveries.Pending(ctx, now, now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 1)
+	s.Require().EqualValues(2, deliveries[0].Id)
+	s.Require().Equal(storage.WebhookDeliveryPending, deliveries[0].Status)
+	s.Require().JSONEq(`{"event":"transfer","height":7966}`, deliveries[0].Payload)
+
+	s.Require().True(now.Add(time.Minute).Equal(deliveries[0].NextAttemptAt))
+
+	// claimed delivery is not returned until the lock expires
+	deliveries, err = s.WebhookDeliveries.Pending(ctx, now, now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 0)
+
+	now = time.Date(2023, 12, 1, 2, 0, 0, 0, time.UTC)
+	deliveries, err = s.WebhookDeliveries.Pending(ctx, now, now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 2)
+	s.Require().EqualValues(2, deliveries[0].Id)
+	s.Require().EqualValues(3, deliveries[1].Id)
+}
+
+func (s *StorageTestSuite) TestWebhookDeliveryByWebhookId() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deliveries, err := s.WebhookDeliveries.ByWebhookId(ctx, 1, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 2)
+	s.Require().EqualValues(2, deliveries[0].Id)
+	s.Require().EqualValues(1, deliveries[1].Id)
+	s.Require().NotNil(deliveries[1].DeliveredAt)
+	s.Require().Equal(200, deliveries[1].ResponseCode)
+}
+
+func (s *StorageTestSuite) TestWebhookDeliveryDeadLetters() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deliveries, err := s.WebhookDeliveries.DeadLetters(ctx, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 1)
+
+	delivery := deliveries[0]
+	s.Require().EqualValues(4, delivery.Id)
+	s.Require().EqualValues(2, delivery.WebhookId)
+	s.Require().Equal(storage.WebhookDeliveryFailed, delivery.Status)
+	s.Require().Equal(10, delivery.Attempts)
+	s.Require().Equal("unexpected response status: 404", delivery.LastError)
+}
+
+func (s *StorageTestSuite) TestWebhookStateByName() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	state, err := s.WebhookState.ByName(ctx, "webhook_producer")
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, state.Id)
+	s.Require().EqualValues(7965, state.Height)
+
+	_, err = s.WebhookState.ByName(ctx, "unknown")
+	s.Require().Error(err)
+	s.Require().True(s.WebhookState.IsNoRows(err))
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRollbackEvent interface {
+	storage.Table[*RollbackEvent]
+}
+
+// RollbackEvent - information about blocks removed from the database by rollback
+type RollbackEvent struct {
+	bun.BaseModel `bun:"rollback_event" comment:"Table with rollback events"`
+
+	Id             uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"                    json:"id"`
+	Time           time.Time      `bun:"time,notnull"                comment:"Time of rollback"                            json:"time"`
+	FromHeight     pkgTypes.Level `bun:"from_height"                 comment:"The lowest removed height"                   json:"from_height"`
+	ToHeight       pkgTypes.Level `bun:"to_height"                   comment:"The highest removed height"                  json:"to_height"`
+	Depth          int64          `bun:"depth"                       comment:"Count of removed blocks"                     json:"depth"`
+	OldHash        []byte         `bun:"old_hash"                    comment:"Hash of the removed head block"              json:"old_hash"`
+	NewHash        []byte         `bun:"new_hash"                    comment:"Hash of the block on the node at old head"   json:"new_hash"`
+	TxCount        int64          `bun:"tx_count"                    comment:"Count of removed transactions"               json:"tx_count"`
+	ActionsCount   int64          `bun:"actions_count"               comment:"Count of removed actions"                    json:"actions_count"`
+	AddressesCount int64          `bun:"addresses_count"             comment:"Count of removed addresses"                  json:"addresses_count"`
+	RollupsCount   int64          `bun:"rollups_count"               comment:"Count of removed rollups"                    json:"rollups_count"`
+	BridgesCount   int64          `bun:"bridges_count"               comment:"Count of removed bridges"                    json:"bridges_count"`
+}
+
+// TableName -
+func (RollbackEvent) TableName() string {
+	return "rollback_event"
+}
@@ -24,6 +24,8 @@ type IRollup interface {
 	Addresses(ctx context.Context, rollupId uint64, limit, offset int, sort sdk.SortOrder) ([]RollupAddress, error)
 	ListRollupsByAddress(ctx context.Context, addressId uint64, limit, offset int, sort sdk.SortOrder) ([]RollupAddress, error)
 	ListExt(ctx context.Context, fltrs RollupListFilter) ([]Rollup, error)
+	ByFirstHeight(ctx context.Context, height types.Level) ([]Rollup, error)
+	ByHeight(ctx context.Context, height types.Level) ([]Rollup, error)
 }
 
 type Rollup struct {
@@ -51,4 +53,5 @@ type RollupListFilter struct {
 	Offset    int
 	SortField string
 	SortOrder sdk.SortOrder
+	MaxHeight types.Level
 }
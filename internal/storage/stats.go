@@ -5,8 +5,11 @@ package storage
 
 import (
 	"context"
+	"regexp"
+	"strconv"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 )
 
@@ -19,6 +22,90 @@ const (
 	TimeframeMonth Timeframe = "month"
 )
 
+// MaxSeriesPoints - maximum count of points returned in series
+const MaxSeriesPoints = 100
+
+const (
+	minSeriesBucket = time.Minute
+	maxSeriesBucket = 4 * 7 * 24 * time.Hour
+)
+
+var customBucketRe = regexp.MustCompile(`^([1-9][0-9]{0,3})(m|h|d|w)$`)
+
+// IsFixed - returns true if timeframe has precomputed aggregates
+func (tf Timeframe) IsFixed() bool {
+	switch tf {
+	case TimeframeHour, TimeframeDay, TimeframeMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// Bucket - returns width of series bucket. Besides hour, day and week it supports custom widths in minutes, hours, days and weeks like 5m, 15m, 4h or 1w. Month has no fixed width.
+func (tf Timeframe) Bucket() (time.Duration, error) {
+	switch tf {
+	case TimeframeHour:
+		return time.Hour, nil
+	case TimeframeDay:
+		return 24 * time.Hour, nil
+	case TimeframeWeek:
+		return 7 * 24 * time.Hour, nil
+	case TimeframeMonth:
+		return 0, errors.Errorf("timeframe %s has no fixed width", tf)
+	}
+
+	matches := customBucketRe.FindStringSubmatch(string(tf))
+	if len(matches) != 3 {
+		return 0, errors.Errorf("invalid timeframe: %s", tf)
+	}
+	value, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid timeframe: %s", tf)
+	}
+
+	var unit time.Duration
+	switch matches[2] {
+	case "m":
+		unit = time.Minute
+	case "h":
+		unit = time.Hour
+	case "d":
+		unit = 24 * time.Hour
+	case "w":
+		unit = 7 * 24 * time.Hour
+	}
+
+	bucket := time.Duration(value) * unit
+	if bucket < minSeriesBucket || bucket > maxSeriesBucket {
+		return 0, errors.Errorf("timeframe %s is out of range [%s, %s]", tf, minSeriesBucket, maxSeriesBucket)
+	}
+	return bucket, nil
+}
+
+// Window - returns request limited by time window which contains at most MaxSeriesPoints buckets of timeframe
+func (tf Timeframe) Window(req SeriesRequest, now time.Time) (SeriesRequest, error) {
+	if req.To.IsZero() {
+		req.To = now.UTC()
+	}
+
+	var from time.Time
+	if tf == TimeframeMonth {
+		from = req.To.AddDate(0, -MaxSeriesPoints, 0)
+	} else {
+		bucket, err := tf.Bucket()
+		if err != nil {
+			return req, err
+		}
+		from = req.To.Add(-bucket * MaxSeriesPoints)
+	}
+
+	if req.From.Before(from) {
+		req.From = from
+	}
+	return req, nil
+}
+
 type TPS struct {
 	Low               float64
 	High              float64
@@ -54,6 +141,12 @@ type SeriesItem struct {
 	Min   string    `bun:"min"`
 }
 
+// RollupSeriesPoint - series item of one of compared rollups
+type RollupSeriesPoint struct {
+	RollupId uint64 `bun:"rollup_id"`
+	SeriesItem
+}
+
 const (
 	SeriesDataSize     = "data_size"
 	SeriesTPS          = "tps"
@@ -69,8 +162,23 @@ const (
 	RollupSeriesAvgSize      = "avg_size"
 	RollupSeriesMinSize      = "min_size"
 	RollupSeriesMaxSize      = "max_size"
+
+	AddressSeriesActive    = "active_addresses"
+	AddressSeriesNew       = "new_addresses"
+	AddressSeriesReturning = "returning_addresses"
+	AddressSeriesChurned   = "churned_addresses"
 )
 
+// IsAddressSeries - returns true if series is built from address activity: active signers, new addresses, signers returned from previous period and signers churned since previous period
+func IsAddressSeries(name string) bool {
+	switch name {
+	case AddressSeriesActive, AddressSeriesNew, AddressSeriesReturning, AddressSeriesChurned:
+		return true
+	default:
+		return false
+	}
+}
+
 type NetworkSummary struct {
 	DataSize     int64           `bun:"data_size"`
 	TPS          float64         `bun:"tps"`
@@ -116,12 +224,109 @@ type FeeSummary struct {
 	FeeCount  int64  `bun:"fee_count"`
 }
 
+type FeeActionSummary struct {
+	ActionType string `bun:"action_type"`
+	Asset      string `bun:"asset"`
+	Amount     string `bun:"amount"`
+	FeeCount   int64  `bun:"fee_count"`
+}
+
+type FeeSeriesFilter struct {
+	ActionType string
+	Asset      string
+}
+
+// ActionTypeShare - count of actions of the type and its share in percents of all actions in the window
+type ActionTypeShare struct {
+	ActionType   string  `bun:"action_type"`
+	ActionsCount int64   `bun:"actions_count"`
+	Share        float64 `bun:"share"`
+}
+
+type FeePayer struct {
+	PayerId  uint64   `bun:"payer_id"`
+	Asset    string   `bun:"asset"`
+	Amount   string   `bun:"amount"`
+	FeeCount int64    `bun:"fee_count"`
+	Payer    *Address `bun:"rel:belongs-to"`
+}
+
+type TopFeePayersFilter struct {
+	Timeframe Timeframe
+	Asset     string
+	Limit     int
+}
+
+type RollupFee struct {
+	RollupId uint64  `bun:"rollup_id"`
+	Asset    string  `bun:"asset"`
+	Amount   string  `bun:"amount"`
+	FeeCount int64   `bun:"fee_count"`
+	Rollup   *Rollup `bun:"rel:belongs-to"`
+}
+
+type RollupFeeFilter struct {
+	RollupIds []uint64
+	Asset     string
+	Limit     int
+	Offset    int
+}
+
+// RollupCadence - count and time bounds of rollup actions since some time
+type RollupCadence struct {
+	RollupId     uint64    `bun:"rollup_id"`
+	ActionsCount int64     `bun:"actions_count"`
+	FirstTime    time.Time `bun:"first_time"`
+	LastTime     time.Time `bun:"last_time"`
+	Rollup       *Rollup   `bun:"rel:belongs-to"`
+}
+
 type TokenTransferDistributionItem struct {
 	Asset          string `bun:"asset"`
 	Amount         string `bun:"amount"`
 	TransfersCount int64  `bun:"transfers_count"`
 }
 
+const (
+	DistributionRollupSize = "rollup_size"
+	DistributionBlockTime  = "block_time"
+	DistributionTxSize     = "tx_size"
+	DistributionFee        = "fee"
+)
+
+// Bounds of histogram buckets count
+const (
+	DefaultHistogramBuckets = 20
+	MaxHistogramBuckets     = 100
+)
+
+type DistributionFilter struct {
+	Timeframe Timeframe
+	RollupId  uint64
+	Asset     string
+	Buckets   int
+}
+
+// Distribution - percentiles and histogram of values in time window
+type Distribution struct {
+	Count int64   `bun:"count"`
+	Min   float64 `bun:"min"`
+	Max   float64 `bun:"max"`
+	Avg   float64 `bun:"avg"`
+	P50   float64 `bun:"p50"`
+	P90   float64 `bun:"p90"`
+	P99   float64 `bun:"p99"`
+
+	Histogram []HistogramBucket `bun:"-"`
+}
+
+// HistogramBucket - count of values in range [From, To). The last bucket includes its upper bound.
+type HistogramBucket struct {
+	From  float64
+	To    float64
+	Count int64
+}
+
 type Candle struct {
 	Time         time.Time       `bun:"time"`
 	Open         decimal.Decimal `bun:"open"`
@@ -138,6 +343,16 @@ type IStats interface {
 	Series(ctx context.Context, timeframe Timeframe, name string, req SeriesRequest) ([]SeriesItem, error)
 	RollupSeries(ctx context.Context, rollupId uint64, timeframe Timeframe, name string, req SeriesRequest) ([]SeriesItem, error)
 	FeeSummary(ctx context.Context) ([]FeeSummary, error)
+	FeeByActionType(ctx context.Context) ([]FeeActionSummary, error)
+	FeeSeries(ctx context.Context, timeframe Timeframe, req SeriesRequest, fltrs FeeSeriesFilter) ([]SeriesItem, error)
+	TopFeePayers(ctx context.Context, fltrs TopFeePayersFilter) ([]FeePayer, error)
+	RollupFees(ctx context.Context, fltrs RollupFeeFilter) ([]RollupFee, error)
+	RollupCadence(ctx context.Context, since time.Time) ([]RollupCadence, error)
 	TokenTransferDistribution(ctx context.Context, limit int) ([]TokenTransferDistributionItem, error)
 	ActiveAddressesCount(ctx context.Context) (int64, error)
+	AddressSeries(ctx context.Context, timeframe Timeframe, name string, req SeriesRequest) ([]SeriesItem, error)
+	ActionSeries(ctx context.Context, timeframe Timeframe, actionType string, req SeriesRequest) ([]SeriesItem, error)
+	ActionBreakdown(ctx context.Context, timeframe Timeframe) ([]ActionTypeShare, error)
+	CompareRollupSeries(ctx context.Context, rollupIds []uint64, timeframe Timeframe, name string, req SeriesRequest) ([]RollupSeriesPoint, error)
+	Distribution(ctx context.Context, name string, fltrs DistributionFilter) (Distribution, error)
 }
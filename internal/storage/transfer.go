@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"context"
 	"time"
 
 	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
@@ -15,6 +16,8 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type ITransfer interface {
 	storage.Table[*Transfer]
+
+	ByHeight(ctx context.Context, height pkgTypes.Level) ([]Transfer, error)
 }
 
 type Transfer struct {
@@ -19,6 +19,7 @@ type ITx interface {
 	storage.Table[*Tx]
 
 	ByHash(ctx context.Context, hash []byte) (Tx, error)
+	ByHashes(ctx context.Context, hashes [][]byte) ([]Tx, error)
 	ByHeight(ctx context.Context, height pkgTypes.Level, limit, offset int) ([]Tx, error)
 	ByAddress(ctx context.Context, addressId uint64, fltrs TxFilter) ([]Tx, error)
 	Filter(ctx context.Context, fltrs TxFilter) ([]Tx, error)
@@ -31,6 +32,7 @@ type TxFilter struct {
 	Status      []string
 	ActionTypes types.ActionTypeMask
 	Height      uint64
+	MaxHeight   uint64
 	TimeFrom    time.Time
 	TimeTo      time.Time
 	WithActions bool
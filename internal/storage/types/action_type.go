@@ -28,3 +28,28 @@ package types
 */
 //go:generate go-enum --marshal --sql --values --names
 type ActionType string
+
+// Categories of action types
+const (
+	ActionCategoryRollupData = "rollup_data"
+	ActionCategoryTransfer   = "transfer"
+	ActionCategoryBridge     = "bridge"
+	ActionCategoryIbc        = "ibc"
+	ActionCategoryAdmin      = "admin"
+)
+
+// Category - returns kind of traffic which action type belongs to
+func (x ActionType) Category() string {
+	switch x {
+	case ActionTypeRollupDataSubmission:
+		return ActionCategoryRollupData
+	case ActionTypeTransfer:
+		return ActionCategoryTransfer
+	case ActionTypeInitBridgeAccount, ActionTypeBridgeLock, ActionTypeBridgeUnlock, ActionTypeBridgeSudoChangeAction, ActionTypeBridgeTransfer:
+		return ActionCategoryBridge
+	case ActionTypeIbcRelay, ActionTypeIcs20Withdrawal, ActionTypeIbcRelayerChange, ActionTypeIbcSudoChangeAction, ActionTypeRecoverIbcClient:
+		return ActionCategoryIbc
+	default:
+		return ActionCategoryAdmin
+	}
+}
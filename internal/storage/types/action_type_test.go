@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionTypeCategory(t *testing.T) {
+	tests := []struct {
+		typ  ActionType
+		want string
+	}{
+		{ActionTypeRollupDataSubmission, ActionCategoryRollupData},
+		{ActionTypeTransfer, ActionCategoryTransfer},
+		{ActionTypeBridgeLock, ActionCategoryBridge},
+		{ActionTypeBridgeTransfer, ActionCategoryBridge},
+		{ActionTypeIcs20Withdrawal, ActionCategoryIbc},
+		{ActionTypeRecoverIbcClient, ActionCategoryIbc},
+		{ActionTypeFeeChange, ActionCategoryAdmin},
+		{ActionTypeMarketsChange, ActionCategoryAdmin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			require.Equal(t, tt.want, tt.typ.Category())
+		})
+	}
+}
@@ -4,19 +4,35 @@
 package storage
 
 const (
-	ViewBlockStatsByHour     = "block_stats_by_hour"
-	ViewBlockStatsByDay      = "block_stats_by_day"
-	ViewBlockStatsByMonth    = "block_stats_by_month"
-	ViewRollupStatsByHour    = "rollup_stats_by_hour"
-	ViewRollupStatsByDay     = "rollup_stats_by_day"
-	ViewRollupStatsByMonth   = "rollup_stats_by_month"
-	ViewFeeStatsByHour       = "fee_stats_by_hour"
-	ViewFeeStatsByDay        = "fee_stats_by_day"
-	ViewFeeStatsByMonth      = "fee_stats_by_month"
-	ViewTransferStatsByHour  = "transfer_stats_by_hour"
-	ViewTransferStatsByDay   = "transfer_stats_by_day"
-	ViewTransferStatsByMonth = "transfer_stats_by_month"
-	ViewLeaderboard          = "leaderboard"
-	ViewPriceByHour          = "price_by_hour"
-	ViewPriceByDay           = "price_by_day"
+	ViewBlockStatsByHour      = "block_stats_by_hour"
+	ViewBlockStatsByDay       = "block_stats_by_day"
+	ViewBlockStatsByMonth     = "block_stats_by_month"
+	ViewRollupStatsByHour     = "rollup_stats_by_hour"
+	ViewRollupStatsByDay      = "rollup_stats_by_day"
+	ViewRollupStatsByMonth    = "rollup_stats_by_month"
+	ViewFeeStatsByHour        = "fee_stats_by_hour"
+	ViewFeeStatsByDay         = "fee_stats_by_day"
+	ViewFeeStatsByMonth       = "fee_stats_by_month"
+	ViewTransferStatsByHour   = "transfer_stats_by_hour"
+	ViewTransferStatsByDay    = "transfer_stats_by_day"
+	ViewTransferStatsByMonth  = "transfer_stats_by_month"
+	ViewLeaderboard           = "leaderboard"
+	ViewPriceByHour           = "price_by_hour"
+	ViewPriceByDay            = "price_by_day"
+	ViewFeeActionStatsByHour  = "fee_action_stats_by_hour"
+	ViewFeeActionStatsByDay   = "fee_action_stats_by_day"
+	ViewFeeActionStatsByMonth = "fee_action_stats_by_month"
+	ViewFeeRollupStatsByHour  = "fee_rollup_stats_by_hour"
+	ViewFeeRollupStatsByDay   = "fee_rollup_stats_by_day"
+	ViewFeeRollupStatsByMonth = "fee_rollup_stats_by_month"
+	ViewFeePayerStatsByDay    = "fee_payer_stats_by_day"
+	ViewWebhookDeadLetter     = "webhook_dead_letter"
+	ViewSignerStatsByDay      = "signer_stats_by_day"
+	ViewSignerStatsByWeek     = "signer_stats_by_week"
+	ViewSignerStatsByMonth    = "signer_stats_by_month"
+	ViewNewAddressesByDay     = "new_addresses_by_day"
+	ViewActionStatsByHour     = "action_stats_by_hour"
+	ViewActionStatsByDay      = "action_stats_by_day"
+	ViewActionStatsByMonth    = "action_stats_by_month"
+	ViewRollupSizeStatsByHour = "rollup_size_stats_by_hour"
 )
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+// types of chain events which can be delivered by webhooks
+const (
+	WebhookEventTransfer       = "transfer"
+	WebhookEventDeposit        = "deposit"
+	WebhookEventNewRollup      = "new_rollup"
+	WebhookEventValidatorPower = "validator_power"
+	WebhookEventFeeChange      = "fee_change"
+)
+
+// WebhookEvents - list of supported webhook events
+var WebhookEvents = []string{
+	WebhookEventTransfer,
+	WebhookEventDeposit,
+	WebhookEventNewRollup,
+	WebhookEventValidatorPower,
+	WebhookEventFeeChange,
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IWebhook interface {
+	storage.Table[*Webhook]
+
+	ByEvent(ctx context.Context, event string) ([]Webhook, error)
+}
+
+// Webhook - HTTP endpoint subscribed to chain events. Filter is the destination address for transfers and the bridge address for deposits.
+type Webhook struct {
+	bun.BaseModel `bun:"webhook" comment:"Table with registered webhooks"`
+
+	Id        uint64    `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	CreatedAt time.Time `bun:"created_at,notnull"          comment:"Creation time"`
+	UpdatedAt time.Time `bun:"updated_at,notnull"          comment:"Time of the last update"`
+	Url       string    `bun:"url,notnull,type:text"       comment:"Endpoint which receives events"`
+	Secret    string    `bun:"secret,notnull,type:text"    comment:"Secret for HMAC signature of payload"`
+	Event     string    `bun:"event,notnull,type:text"     comment:"Type of subscribed event"`
+	Filter    string    `bun:"filter,type:text"            comment:"Address filter of event"`
+	Active    bool      `bun:"active,notnull"              comment:"Is webhook active"`
+}
+
+// TableName -
+func (Webhook) TableName() string {
+	return "webhook"
+}
+
+// Match - checks whether the event with the address is matched by webhook filter
+func (w Webhook) Match(address string) bool {
+	return w.Filter == "" || w.Filter == address
+}
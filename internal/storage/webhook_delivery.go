@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+// statuses of webhook delivery
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IWebhookDelivery interface {
+	storage.Table[*WebhookDelivery]
+
+	Pending(ctx context.Context, now, lockUntil time.Time, limit int) ([]WebhookDelivery, error)
+	ByWebhookId(ctx context.Context, webhookId uint64, limit, offset int, sort storage.SortOrder) ([]WebhookDelivery, error)
+	DeadLetters(ctx context.Context, limit, offset int) ([]WebhookDelivery, error)
+}
+
+// WebhookDelivery - item of the persistent queue of webhook deliveries. Deliveries which exhausted all attempts are kept with failed status and form the dead-letter view.
+type WebhookDelivery struct {
+	bun.BaseModel `bun:"webhook_delivery" comment:"Table with queue of webhook deliveries"`
+
+	Id            uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	WebhookId     uint64         `bun:"webhook_id,notnull"          comment:"Webhook internal identity"`
+	Event         string         `bun:"event,notnull,type:text"     comment:"Type of event"`
+	Height        pkgTypes.Level `bun:"height"                      comment:"The number (height) of the block with event"`
+	Payload       string         `bun:"payload,type:jsonb"          comment:"Delivered payload"`
+	Status        string         `bun:"status,notnull,type:text"    comment:"Delivery status"`
+	Attempts      int            `bun:"attempts"                    comment:"Count of delivery attempts"`
+	NextAttemptAt time.Time      `bun:"next_attempt_at,notnull"     comment:"Time of the next delivery attempt"`
+	ResponseCode  int            `bun:"response_code"               comment:"HTTP status code of the last attempt"`
+	LastError     string         `bun:"last_error,type:text"        comment:"Error of the last attempt"`
+	CreatedAt     time.Time      `bun:"created_at,notnull"          comment:"Time when event was enqueued"`
+	DeliveredAt   *time.Time     `bun:"delivered_at"                comment:"Time of successful delivery"`
+}
+
+// TableName -
+func (WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IWebhookState interface {
+	storage.Table[*WebhookState]
+
+	ByName(ctx context.Context, name string) (WebhookState, error)
+}
+
+// WebhookState - cursor of the webhook producer: the last block which events were enqueued. Producer continues from the next block after restart.
+type WebhookState struct {
+	bun.BaseModel `bun:"webhook_state" comment:"Table with state of webhook producers"`
+
+	Id        uint64         `bun:"id,pk,notnull,autoincrement"    comment:"Unique internal identity"`
+	Name      string         `bun:"name,unique:webhook_state_name" comment:"Producer name"`
+	Height    pkgTypes.Level `bun:"height,notnull"                 comment:"The number (height) of the last processed block"`
+	UpdatedAt time.Time      `bun:"updated_at,notnull"             comment:"Time of the last update"`
+}
+
+// TableName -
+func (WebhookState) TableName() string {
+	return "webhook_state"
+}
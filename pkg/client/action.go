@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Action - returns action by internal id
+func (c *Client) Action(ctx context.Context, id uint64) (responses.Action, error) {
+	var result responses.Action
+	err := c.get(ctx, nil, &result, "action", formatUint(id))
+	return result, err
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// AddressListFilters - filters of address list
+type AddressListFilters struct {
+	Page
+
+	// Asset - sort addresses by balance of the asset
+	Asset string
+	// Finalized - hide addresses first seen in blocks which are not finalized yet
+	Finalized bool
+}
+
+// Addresses - returns list of addresses
+func (c *Client) Addresses(ctx context.Context, filters AddressListFilters) ([]responses.Address, error) {
+	var result []responses.Address
+	values := newArgs().
+		setPage(filters.Page).
+		setString("asset", filters.Asset).
+		setBool("finalized", filters.Finalized).
+		values()
+	err := c.get(ctx, values, &result, "address")
+	return result, err
+}
+
+// AddressesPager - returns pager over address list
+func (c *Client) AddressesPager(filters AddressListFilters) *Pager[responses.Address] {
+	return NewPager(filters.Page, func(ctx context.Context, page Page) ([]responses.Address, error) {
+		filters.Page = page
+		return c.Addresses(ctx, filters)
+	})
+}
+
+// AddressesCount - returns count of addresses
+func (c *Client) AddressesCount(ctx context.Context) (uint64, error) {
+	var result uint64
+	err := c.get(ctx, nil, &result, "address", "count")
+	return result, err
+}
+
+// AddressesBatch - returns addresses by hashes. Result items are in the order of requested hashes.
+func (c *Client) AddressesBatch(ctx context.Context, hashes []string) ([]responses.BatchItem[responses.Address], error) {
+	var result []responses.BatchItem[responses.Address]
+	err := c.post(ctx, hashesBatchRequest{Hashes: hashes}, &result, "address", "batch")
+	return result, err
+}
+
+// Address - returns address by hash
+func (c *Client) Address(ctx context.Context, hash string) (responses.Address, error) {
+	var result responses.Address
+	err := c.get(ctx, nil, &result, "address", hash)
+	return result, err
+}
+
+// AddressTxs - returns transactions signed by the address
+func (c *Client) AddressTxs(ctx context.Context, hash string, filters TxFilters) ([]responses.Tx, error) {
+	var result []responses.Tx
+	err := c.get(ctx, filters.args().values(), &result, "address", hash, "txs")
+	return result, err
+}
+
+// AddressTxsPager - returns pager over transactions signed by the address
+func (c *Client) AddressTxsPager(hash string, filters TxFilters) *Pager[responses.Tx] {
+	return NewPager(filters.Page, func(ctx context.Context, page Page) ([]responses.Tx, error) {
+		filters.Page = page
+		return c.AddressTxs(ctx, hash, filters)
+	})
+}
+
+// ActionFilters - filters of action list
+type ActionFilters struct {
+	Page
+
+	ActionTypes []string
+	// Finalized - hide actions which are not finalized yet
+	Finalized bool
+}
+
+// AddressActions - returns actions involving the address
+func (c *Client) AddressActions(ctx context.Context, hash string, filters ActionFilters) ([]responses.Action, error) {
+	var result []responses.Action
+	values := newArgs().
+		setPage(filters.Page).
+		setList("action_types", filters.ActionTypes).
+		setBool("finalized", filters.Finalized).
+		values()
+	err := c.get(ctx, values, &result, "address", hash, "actions")
+	return result, err
+}
+
+// AddressRollups - returns rollups which the address pushed data to
+func (c *Client) AddressRollups(ctx context.Context, hash string, page Page) ([]responses.Rollup, error) {
+	var result []responses.Rollup
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "address", hash, "rollups")
+	return result, err
+}
+
+// AddressRoles - returns bridges in which the address has any role
+func (c *Client) AddressRoles(ctx context.Context, hash string, page Page) ([]responses.Bridge, error) {
+	var result []responses.Bridge
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "address", hash, "roles")
+	return result, err
+}
+
+// AddressFees - returns fees paid by the address
+func (c *Client) AddressFees(ctx context.Context, hash string, page Page) ([]responses.FullFee, error) {
+	var result []responses.FullFee
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "address", hash, "fees")
+	return result, err
+}
+
+// AddressDeposits - returns deposits of the bridge address
+func (c *Client) AddressDeposits(ctx context.Context, hash string, page Page) ([]responses.Deposit, error) {
+	var result []responses.Deposit
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "address", hash, "deposits")
+	return result, err
+}
+
+// AddressCelestials - returns celestial ids linked with the address
+func (c *Client) AddressCelestials(ctx context.Context, hash string, page Page) ([]responses.Celestial, error) {
+	var result []responses.Celestial
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "address", hash, "celestials")
+	return result, err
+}
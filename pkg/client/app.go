@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// AppListFilters - filters of application leaderboard
+type AppListFilters struct {
+	Page
+
+	SortBy   string
+	Category []string
+}
+
+// Apps - returns application leaderboard
+func (c *Client) Apps(ctx context.Context, filters AppListFilters) ([]responses.AppWithStats, error) {
+	var result []responses.AppWithStats
+	values := newArgs().
+		setPage(filters.Page).
+		setString("sort_by", filters.SortBy).
+		setList("category", filters.Category).
+		values()
+	err := c.get(ctx, values, &result, "app")
+	return result, err
+}
+
+// App - returns application by slug
+func (c *Client) App(ctx context.Context, slug string) (responses.AppWithStats, error) {
+	var result responses.AppWithStats
+	err := c.get(ctx, nil, &result, "app", slug)
+	return result, err
+}
+
+// AppReport - returns report of the application. Gap is a size of report gaps in seconds, zero means API default.
+func (c *Client) AppReport(ctx context.Context, slug string, timeRange TimeRange, gap uint64) (responses.AppReport, error) {
+	var result responses.AppReport
+	values := newArgs().
+		setTimeRange(timeRange).
+		setUint("gap", gap).
+		values()
+	err := c.get(ctx, values, &result, "app", slug, "report")
+	return result, err
+}
+
+// AppReportSeries - returns report series of the application
+func (c *Client) AppReportSeries(ctx context.Context, slug string, timeRange TimeRange) ([]responses.AppReportItem, error) {
+	var result []responses.AppReportItem
+	err := c.get(ctx, newArgs().setTimeRange(timeRange).values(), &result, "app", slug, "report", "series")
+	return result, err
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// AssetListFilters - filters of asset list
+type AssetListFilters struct {
+	Page
+
+	// SortBy - sort field: `fee`, `fee_count`, `transferred`, `transfer_count` or `supply`
+	SortBy string
+}
+
+// Assets - returns list of assets
+func (c *Client) Assets(ctx context.Context, filters AssetListFilters) ([]responses.Asset, error) {
+	var result []responses.Asset
+	values := newArgs().
+		setPage(filters.Page).
+		setString("sort_by", filters.SortBy).
+		values()
+	err := c.get(ctx, values, &result, "asset")
+	return result, err
+}
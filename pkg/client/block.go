@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+// BlockListFilters - filters of block list
+type BlockListFilters struct {
+	Page
+
+	// Stats - join stats of blocks
+	Stats bool
+	// Finalized - hide blocks which are not finalized yet
+	Finalized bool
+}
+
+// Blocks - returns list of blocks
+func (c *Client) Blocks(ctx context.Context, filters BlockListFilters) ([]responses.Block, error) {
+	var result []responses.Block
+	values := newArgs().
+		setPage(filters.Page).
+		setBool("stats", filters.Stats).
+		setBool("finalized", filters.Finalized).
+		values()
+	err := c.get(ctx, values, &result, "block")
+	return result, err
+}
+
+// BlocksPager - returns pager over block list
+func (c *Client) BlocksPager(filters BlockListFilters) *Pager[responses.Block] {
+	return NewPager(filters.Page, func(ctx context.Context, page Page) ([]responses.Block, error) {
+		filters.Page = page
+		return c.Blocks(ctx, filters)
+	})
+}
+
+// BlocksCount - returns count of blocks
+func (c *Client) BlocksCount(ctx context.Context) (uint64, error) {
+	var result uint64
+	err := c.get(ctx, nil, &result, "block", "count")
+	return result, err
+}
+
+type blockBatchRequest struct {
+	Heights []types.Level `json:"heights"`
+	Stats   bool          `json:"stats"`
+}
+
+// BlocksBatch - returns blocks by heights. Result items are in the order of requested heights.
+func (c *Client) BlocksBatch(ctx context.Context, heights []uint64, stats bool) ([]responses.BatchItem[responses.Block], error) {
+	req := blockBatchRequest{
+		Heights: make([]types.Level, len(heights)),
+		Stats:   stats,
+	}
+	for i := range heights {
+		req.Heights[i] = types.Level(heights[i])
+	}
+
+	var result []responses.BatchItem[responses.Block]
+	err := c.post(ctx, req, &result, "block", "batch")
+	return result, err
+}
+
+// Block - returns block by height
+func (c *Client) Block(ctx context.Context, height uint64, stats bool) (responses.Block, error) {
+	var result responses.Block
+	values := newArgs().setBool("stats", stats).values()
+	err := c.get(ctx, values, &result, "block", formatUint(height))
+	return result, err
+}
+
+// BlockActions - returns actions of the block
+func (c *Client) BlockActions(ctx context.Context, height uint64, page Page) ([]responses.Action, error) {
+	var result []responses.Action
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "block", formatUint(height), "actions")
+	return result, err
+}
+
+// BlockTxs - returns transactions of the block
+func (c *Client) BlockTxs(ctx context.Context, height uint64, page Page) ([]responses.Tx, error) {
+	var result []responses.Tx
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "block", formatUint(height), "txs")
+	return result, err
+}
+
+// BlockStats - returns stats of the block
+func (c *Client) BlockStats(ctx context.Context, height uint64) (responses.BlockStats, error) {
+	var result responses.BlockStats
+	err := c.get(ctx, nil, &result, "block", formatUint(height), "stats")
+	return result, err
+}
+
+// BlockRollupActions - returns rollup actions of the block
+func (c *Client) BlockRollupActions(ctx context.Context, height uint64, page Page) ([]responses.RollupAction, error) {
+	var result []responses.RollupAction
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "block", formatUint(height), "rollup_actions")
+	return result, err
+}
+
+// BlockRollupActionsCount - returns count of rollup actions of the block
+func (c *Client) BlockRollupActionsCount(ctx context.Context, height uint64) (int64, error) {
+	var result int64
+	err := c.get(ctx, nil, &result, "block", formatUint(height), "rollup_actions", "count")
+	return result, err
+}
+
+// BlockPrices - returns prices of the block
+func (c *Client) BlockPrices(ctx context.Context, height uint64) ([]responses.Price, error) {
+	var result []responses.Price
+	err := c.get(ctx, nil, &result, "block", formatUint(height), "prices")
+	return result, err
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+const (
+	userAgent  = "Astria Indexer Client"
+	apiVersion = "v1"
+
+	headerApiKey = "X-API-Key"
+)
+
+// ErrNoContent - returned when the API has nothing to respond with (HTTP 204), e.g. requested entity is not found
+var ErrNoContent = errors.New("no content")
+
+// Error - error returned by the API
+type Error struct {
+	Status  int    `json:"-"`
+	Message string `json:"message"`
+}
+
+func (e Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("api error: status %d", e.Status)
+	}
+	return fmt.Sprintf("api error: status %d: %s", e.Status, e.Message)
+}
+
+// Client - typed client of the public API
+type Client struct {
+	baseURL   *url.URL
+	client    *http.Client
+	apiKey    string
+	userAgent string
+}
+
+// Option - option of the client
+type Option func(*Client)
+
+// WithHttpClient - sets HTTP client which is used for requests
+func WithHttpClient(client *http.Client) Option {
+	return func(c *Client) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithApiKey - sets API key which is sent in the X-API-Key header
+func WithApiKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithUserAgent - sets value of the User-Agent header
+func WithUserAgent(agent string) Option {
+	return func(c *Client) {
+		c.userAgent = agent
+	}
+}
+
+// New - creates client of the API hosted at baseURL, e.g. https://api-mainnet.astria.celenium.io
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("invalid base url scheme: %s", u.Scheme)
+	}
+	u = u.JoinPath(apiVersion)
+
+	c := &Client{
+		baseURL:   u,
+		client:    http.DefaultClient,
+		userAgent: userAgent,
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c, nil
+}
+
+func (c *Client) url(args url.Values, elems ...string) string {
+	escaped := make([]string, len(elems))
+	for i := range elems {
+		escaped[i] = url.PathEscape(elems[i])
+	}
+	u := c.baseURL.JoinPath(escaped...)
+	if len(args) > 0 {
+		u.RawQuery = args.Encode()
+	}
+	return u.String()
+}
+
+func (c *Client) get(ctx context.Context, args url.Values, output any, elems ...string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(args, elems...), nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, output)
+}
+
+func (c *Client) post(ctx context.Context, input, output any, elems ...string) error {
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(input); err != nil {
+		return errors.Wrap(err, "encode request body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url(nil, elems...), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, output)
+}
+
+func (c *Client) do(req *http.Request, output any) error {
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if c.apiKey != "" {
+		req.Header.Set(headerApiKey, c.apiKey)
+	}
+
+	response, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer closeBody(response.Body)
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		if err := json.NewDecoder(response.Body).Decode(output); err != nil {
+			return errors.Wrapf(err, "decode response of %s", req.URL.Path)
+		}
+		return nil
+	case http.StatusNoContent:
+		return ErrNoContent
+	default:
+		apiErr := Error{Status: response.StatusCode}
+		data, err := io.ReadAll(response.Body)
+		if err != nil {
+			return apiErr
+		}
+		if err := json.Unmarshal(data, &apiErr); err != nil {
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+		return apiErr
+	}
+}
+
+func closeBody(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, body)
+	_ = body.Close()
+}
@@ -0,0 +1,524 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	"github.com/celenium-io/astria-indexer/cmd/api/cache"
+	"github.com/celenium-io/astria-indexer/cmd/api/handler"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testTime     = time.Date(2023, 8, 1, 1, 1, 0, 0, time.UTC)
+	testTxHash   = "652452a670018d629cc116e510ba88c1cabe061336661b1f3d206d248bd558af"
+	testAddress  = "astria1phym4uktjn6gjle226009ge7u82w0dgtszs8x2"
+	testRollupId = []byte("rollup-id")
+	testBlock    = storage.Block{
+		Id:     1,
+		Height: 100,
+		Time:   testTime,
+		Hash:   pkgTypes.Hex{0x01, 0x02},
+	}
+	testTx = storage.Tx{
+		Id:     1,
+		Height: 100,
+		Time:   testTime,
+		Status: types.StatusSuccess,
+		Hash:   mustDecodeHex(testTxHash),
+	}
+)
+
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// noopCache - cache which never stores responses
+type noopCache struct{}
+
+func (noopCache) Get(_ context.Context, _ string) (string, bool) {
+	return "", false
+}
+
+func (noopCache) Set(_ context.Context, _, _ string, _ cache.ExpirationFunc) error {
+	return nil
+}
+
+func (noopCache) Clear(_ context.Context) error {
+	return nil
+}
+
+func (noopCache) Close() error {
+	return nil
+}
+
+// testDecoder - decoder which returns the same transaction for any bytes
+type testDecoder struct{}
+
+func (testDecoder) Decode(_ []byte) (handler.DecodedTx, error) {
+	return handler.DecodedTx{
+		Signer:      testAddress,
+		Nonce:       1,
+		ChainId:     "astria",
+		ActionTypes: types.ActionTypeTransferBits,
+		Actions: []storage.Action{
+			{
+				Type: types.ActionTypeTransfer,
+				Data: map[string]any{
+					"fee_asset": "nria",
+				},
+			},
+		},
+		SignatureValid: true,
+	}, nil
+}
+
+// ClientTestSuite -
+type ClientTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	blocks   *mock.MockIBlock
+	txs      *mock.MockITx
+	address  *mock.MockIAddress
+	rollups  *mock.MockIRollup
+	bridge   *mock.MockIBridge
+	app      *mock.MockIApp
+	state    *mock.MockIState
+	server   *httptest.Server
+	client   *Client
+	finality *cache.Finality
+	cancel   context.CancelFunc
+}
+
+// SetupTest -
+func (s *ClientTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.blocks = mock.NewMockIBlock(s.ctrl)
+	s.txs = mock.NewMockITx(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.rollups = mock.NewMockIRollup(s.ctrl)
+	s.bridge = mock.NewMockIBridge(s.ctrl)
+	s.app = mock.NewMockIApp(s.ctrl)
+	s.state = mock.NewMockIState(s.ctrl)
+	actions := mock.NewMockIAction(s.ctrl)
+	fees := mock.NewMockIFee(s.ctrl)
+	deposits := mock.NewMockIDeposit(s.ctrl)
+
+	s.finality = cache.NewFinality(nil, 10)
+	s.finality.SetHead(110)
+	ttlCache := noopCache{}
+
+	constantsRepo := mock.NewMockIConstant(s.ctrl)
+	constantsRepo.EXPECT().
+		All(gomock.Any()).
+		Return([]storage.Constant{
+			{Module: types.ModuleNameGeneric, Name: "transfer_base", Value: "12"},
+			{Module: types.ModuleNameGeneric, Name: "transfer_multiplier", Value: "0"},
+			{Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("nria"), Value: "true"},
+		}, nil).
+		Times(1)
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	constants := cache.NewConstantsCache(bus.NewObserver(storage.ChannelConstant))
+	s.Require().NoError(constants.Start(ctx, constantsRepo))
+
+	// POST endpoints are checked by the same CSRF middleware as in the API server
+	e := echo.New()
+	e.Validator = handler.NewApiValidator()
+	e.Use(middleware.CSRFWithConfig(handler.CSRFConfig(nil)))
+	v1 := e.Group("v1")
+
+	handlers := []handler.Handler{
+		handler.NewBlockHandler(s.blocks, mock.NewMockIBlockStats(s.ctrl), s.txs, actions, s.rollups, mock.NewMockIPrice(s.ctrl), s.state, ttlCache, s.finality, "test"),
+		handler.NewTxHandler(s.txs, actions, s.rollups, fees, s.state, ttlCache, s.finality, testDecoder{}, "test"),
+		handler.NewAddressHandler(ttlCache, constants, s.finality, s.address, s.txs, actions, s.rollups, fees, s.bridge, deposits, nil, s.state, "test"),
+		handler.NewRollupHandler(ttlCache, constants, s.finality, s.rollups, actions, s.bridge, deposits, s.app, s.state, "test"),
+		handler.NewStateHandler(s.state),
+		handler.NewFeeHandler(constants, testDecoder{}),
+	}
+	for i := range handlers {
+		handlers[i].InitRoutes(v1)
+	}
+
+	s.server = httptest.NewServer(e)
+
+	client, err := New(s.server.URL, WithApiKey("test-key"))
+	s.Require().NoError(err)
+	s.client = client
+}
+
+// TearDownTest -
+func (s *ClientTestSuite) TearDownTest() {
+	s.cancel()
+	s.server.Close()
+	s.ctrl.Finish()
+}
+
+func TestSuiteClient_Run(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+func (s *ClientTestSuite) TestNewInvalidURL() {
+	_, err := New("ftp://localhost")
+	s.Require().Error(err)
+}
+
+func (s *ClientTestSuite) TestHead() {
+	s.state.EXPECT().
+		List(gomock.Any(), uint64(1), uint64(0), sdk.SortOrderAsc).
+		Return([]*storage.State{
+			{
+				Id:         1,
+				Name:       "test",
+				ChainId:    "chain-id",
+				LastHeight: 100,
+				LastTime:   testTime,
+				TotalTx:    1234,
+			},
+		}, nil).
+		Times(1)
+
+	head, err := s.client.Head(s.T().Context())
+	s.Require().NoError(err)
+	s.Require().Equal("chain-id", head.ChainID)
+	s.Require().EqualValues(100, head.LastHeight)
+	s.Require().EqualValues(1234, head.TotalTx)
+	s.Require().True(head.LastTime.Equal(testTime))
+}
+
+func (s *ClientTestSuite) TestBlock() {
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100), true).
+		Return(testBlock, nil).
+		Times(1)
+
+	block, err := s.client.Block(s.T().Context(), 100, true)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, block.Id)
+	s.Require().EqualValues(100, block.Height)
+	s.Require().Equal(testBlock.Hash, block.Hash)
+	s.Require().True(block.Finalized)
+}
+
+func (s *ClientTestSuite) TestBlockNoContent() {
+	s.blocks.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(1000), false).
+		Return(storage.Block{}, sql.ErrNoRows).
+		Times(1)
+	s.blocks.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	_, err := s.client.Block(s.T().Context(), 1000, false)
+	s.Require().ErrorIs(err, ErrNoContent)
+}
+
+func (s *ClientTestSuite) TestBlocksPager() {
+	block := func(height pkgTypes.Level) *storage.Block {
+		return &storage.Block{Id: uint64(height), Height: height, Time: testTime}
+	}
+
+	gomock.InOrder(
+		s.blocks.EXPECT().
+			List(gomock.Any(), uint64(2), uint64(0), sdk.SortOrderDesc).
+			Return([]*storage.Block{block(5), block(4)}, nil),
+		s.blocks.EXPECT().
+			List(gomock.Any(), uint64(2), uint64(2), sdk.SortOrderDesc).
+			Return([]*storage.Block{block(3), block(2)}, nil),
+		s.blocks.EXPECT().
+			List(gomock.Any(), uint64(2), uint64(4), sdk.SortOrderDesc).
+			Return([]*storage.Block{block(1)}, nil),
+	)
+
+	pager := s.client.BlocksPager(BlockListFilters{
+		Page: Page{Limit: 2, Sort: SortDesc},
+	})
+	blocks, err := pager.All(s.T().Context())
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 5)
+	s.Require().True(pager.Done())
+	s.Require().Equal(5, pager.Cursor())
+
+	for i := range blocks {
+		s.Require().EqualValues(5-i, blocks[i].Height)
+	}
+
+	next, err := pager.Next(s.T().Context())
+	s.Require().NoError(err)
+	s.Require().Empty(next)
+}
+
+func (s *ClientTestSuite) TestPagerResumeFromCursor() {
+	s.blocks.EXPECT().
+		List(gomock.Any(), uint64(10), uint64(20), sdk.SortOrderAsc).
+		Return([]*storage.Block{}, nil).
+		Times(1)
+
+	pager := s.client.BlocksPager(BlockListFilters{
+		Page: Page{Offset: 20},
+	})
+	blocks, err := pager.Next(s.T().Context())
+	s.Require().NoError(err)
+	s.Require().Empty(blocks)
+	s.Require().True(pager.Done())
+	s.Require().Equal(20, pager.Cursor())
+}
+
+func (s *ClientTestSuite) TestBlocksBatch() {
+	s.blocks.EXPECT().
+		ByHeights(gomock.Any(), []pkgTypes.Level{100, 101}, false).
+		Return([]storage.Block{testBlock}, nil).
+		Times(1)
+
+	items, err := s.client.BlocksBatch(s.T().Context(), []uint64{100, 101}, false)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().Equal("100", items[0].Id)
+	s.Require().NotNil(items[0].Data)
+	s.Require().EqualValues(100, items[0].Data.Height)
+	s.Require().Equal("101", items[1].Id)
+	s.Require().Nil(items[1].Data)
+	s.Require().NotEmpty(items[1].Error)
+}
+
+func (s *ClientTestSuite) TestTxs() {
+	from := time.Unix(1692892095, 0).UTC()
+
+	s.txs.EXPECT().
+		Filter(gomock.Any(), storage.TxFilter{
+			Limit:       5,
+			Offset:      10,
+			Sort:        sdk.SortOrderDesc,
+			Status:      []string{"success"},
+			Height:      100,
+			ActionTypes: types.NewActionTypeMask(types.ActionTypeRollupDataSubmission.String(), types.ActionTypeTransfer.String()),
+			WithActions: true,
+			TimeFrom:    from,
+		}).
+		Return([]storage.Tx{testTx}, nil).
+		Times(1)
+
+	txs, err := s.client.Txs(s.T().Context(), TxListFilters{
+		TxFilters: TxFilters{
+			Page:        Page{Limit: 5, Offset: 10, Sort: SortDesc},
+			TimeRange:   TimeRange{From: from},
+			Height:      100,
+			Status:      []string{"success"},
+			ActionTypes: []string{"rollup_data_submission", "transfer"},
+		},
+		WithActions: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().Equal(testTxHash, txs[0].Hash)
+	s.Require().Equal(types.StatusSuccess, txs[0].Status)
+}
+
+func (s *ClientTestSuite) TestTx() {
+	s.txs.EXPECT().
+		ByHash(gomock.Any(), mustDecodeHex(testTxHash)).
+		Return(testTx, nil).
+		Times(1)
+
+	tx, err := s.client.Tx(s.T().Context(), testTxHash, false)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, tx.Id)
+	s.Require().EqualValues(100, tx.Height)
+	s.Require().Equal(testTxHash, tx.Hash)
+}
+
+func (s *ClientTestSuite) TestTxBadRequest() {
+	_, err := s.client.Tx(s.T().Context(), "invalid", false)
+	s.Require().Error(err)
+
+	var apiErr Error
+	s.Require().ErrorAs(err, &apiErr)
+	s.Require().Equal(http.StatusBadRequest, apiErr.Status)
+	s.Require().NotEmpty(apiErr.Message)
+}
+
+func (s *ClientTestSuite) TestTxsBatch() {
+	s.txs.EXPECT().
+		ByHashes(gomock.Any(), [][]byte{mustDecodeHex(testTxHash)}).
+		Return([]storage.Tx{testTx}, nil).
+		Times(1)
+
+	items, err := s.client.TxsBatch(s.T().Context(), []string{testTxHash, "invalid"})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().NotNil(items[0].Data)
+	s.Require().Equal(testTxHash, items[0].Data.Hash)
+	s.Require().Equal("invalid", items[1].Id)
+	s.Require().NotEmpty(items[1].Error)
+}
+
+func (s *ClientTestSuite) TestDecodeTx() {
+	tx, err := s.client.DecodeTx(s.T().Context(), "AQID", "base64")
+	s.Require().NoError(err)
+	s.Require().Equal(testAddress, tx.Signer)
+	s.Require().EqualValues(1, tx.Nonce)
+	s.Require().True(tx.SignatureValid)
+	s.Require().Len(tx.Actions, 1)
+}
+
+func (s *ClientTestSuite) TestEstimateFee() {
+	estimation, err := s.client.EstimateFee(s.T().Context(), FeeEstimationRequest{
+		Actions: []FeeEstimationAction{
+			{Type: "transfer"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(estimation.Actions, 1)
+	s.Require().Equal("12", estimation.Total)
+
+	estimation, err = s.client.EstimateFee(s.T().Context(), FeeEstimationRequest{
+		Tx: "AQID",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("12", estimation.Total)
+}
+
+func (s *ClientTestSuite) TestTxsCount() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), "test").
+		Return(storage.State{TotalTx: 1234}, nil).
+		Times(1)
+
+	count, err := s.client.TxsCount(s.T().Context())
+	s.Require().NoError(err)
+	s.Require().EqualValues(1234, count)
+}
+
+func (s *ClientTestSuite) TestAddress() {
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testAddress).
+		Return(storage.Address{
+			Id:            1,
+			Height:        100,
+			Hash:          testAddress,
+			SignedTxCount: 10,
+		}, nil).
+		Times(1)
+	s.bridge.EXPECT().
+		ByAddress(gomock.Any(), uint64(1)).
+		Return(storage.Bridge{}, sql.ErrNoRows).
+		Times(1)
+	s.bridge.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	address, err := s.client.Address(s.T().Context(), testAddress)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, address.Id)
+	s.Require().Equal(testAddress, address.Hash)
+	s.Require().EqualValues(10, address.SignedTxCount)
+	s.Require().Nil(address.Bridge)
+}
+
+func (s *ClientTestSuite) TestAddressesBatch() {
+	s.address.EXPECT().
+		ByHashes(gomock.Any(), []string{testAddress}).
+		Return([]storage.Address{
+			{Id: 1, Hash: testAddress, Height: 100},
+		}, nil).
+		Times(1)
+
+	items, err := s.client.AddressesBatch(s.T().Context(), []string{testAddress, "invalid"})
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().NotNil(items[0].Data)
+	s.Require().Equal(testAddress, items[0].Data.Hash)
+	s.Require().Equal("invalid", items[1].Id)
+	s.Require().NotEmpty(items[1].Error)
+}
+
+func (s *ClientTestSuite) TestPostWithoutCSRFToken() {
+	var result any
+	err := s.client.post(s.T().Context(), struct{}{}, &result, "rollup")
+	s.Require().Error(err)
+
+	var apiErr Error
+	s.Require().ErrorAs(err, &apiErr)
+	s.Require().Equal(http.StatusBadRequest, apiErr.Status)
+}
+
+func (s *ClientTestSuite) TestRollup() {
+	hash := base64.URLEncoding.EncodeToString(testRollupId)
+
+	s.rollups.EXPECT().
+		ByHash(gomock.Any(), testRollupId).
+		Return(storage.Rollup{
+			Id:          1,
+			AstriaId:    testRollupId,
+			FirstHeight: 100,
+			Size:        1000,
+		}, nil).
+		Times(1)
+	s.app.EXPECT().
+		ByRollupId(gomock.Any(), uint64(1)).
+		Return(storage.AppWithStats{}, sql.ErrNoRows).
+		Times(1)
+	s.app.EXPECT().
+		IsNoRows(sql.ErrNoRows).
+		Return(true).
+		Times(1)
+
+	rollup, err := s.client.Rollup(s.T().Context(), hash)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, rollup.Id)
+	s.Require().Equal(testRollupId, rollup.AstriaId)
+	s.Require().EqualValues(1000, rollup.Size)
+	s.Require().Nil(rollup.App)
+}
+
+func (s *ClientTestSuite) TestRollupsCount() {
+	s.state.EXPECT().
+		ByName(gomock.Any(), "test").
+		Return(storage.State{TotalRollups: 30}, nil).
+		Times(1)
+
+	count, err := s.client.RollupsCount(s.T().Context())
+	s.Require().NoError(err)
+	s.Require().EqualValues(30, count)
+}
+
+func (s *ClientTestSuite) TestApiKeyHeader() {
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(headerApiKey)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, WithApiKey("secret"))
+	s.Require().NoError(err)
+
+	_, err = client.Head(s.T().Context())
+	s.Require().ErrorIs(err, ErrNoContent)
+	s.Require().Equal("secret", header)
+}
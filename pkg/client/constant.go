@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Constants - returns network constants at the height. Zero height means current constants.
+func (c *Client) Constants(ctx context.Context, height uint64) (responses.Constants, error) {
+	var result responses.Constants
+	err := c.get(ctx, newArgs().setUint("height", height).values(), &result, "constants")
+	return result, err
+}
+
+// ConstantHistory - returns changes of the constant
+func (c *Client) ConstantHistory(ctx context.Context, module, name string, page Page) ([]responses.ConstantHistory, error) {
+	var result []responses.ConstantHistory
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "constants", module, name, "history")
+	return result, err
+}
+
+// Enums - returns enumerators used by the API
+func (c *Client) Enums(ctx context.Context) (responses.Enums, error) {
+	var result responses.Enums
+	err := c.get(ctx, nil, &result, "enums")
+	return result, err
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// FeeEstimationAction - action which fee is estimated
+type FeeEstimationAction struct {
+	Type string `json:"type"`
+	Size uint64 `json:"size,omitempty"`
+}
+
+// FeeEstimationRequest - request of fee estimation. Either Actions or raw Tx should be set.
+type FeeEstimationRequest struct {
+	Actions  []FeeEstimationAction `json:"actions,omitempty"`
+	Tx       string                `json:"tx,omitempty"`
+	Encoding string                `json:"encoding,omitempty"`
+}
+
+// EstimateFee - estimates fee of the actions or the raw transaction
+func (c *Client) EstimateFee(ctx context.Context, req FeeEstimationRequest) (responses.FeeEstimation, error) {
+	var result responses.FeeEstimation
+	err := c.post(ctx, req, &result, "fee", "estimate")
+	return result, err
+}
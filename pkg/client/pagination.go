@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// sort orders
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+// Page - pagination parameters of list endpoints. Zero values are replaced by the API defaults.
+type Page struct {
+	Limit  int
+	Offset int
+	Sort   string
+}
+
+// TimeRange - time bounds of the requested entities. Zero values are omitted.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+type args url.Values
+
+func newArgs() args {
+	return args(url.Values{})
+}
+
+func (a args) values() url.Values {
+	return url.Values(a)
+}
+
+func (a args) setString(key, value string) args {
+	if value != "" {
+		url.Values(a).Set(key, value)
+	}
+	return a
+}
+
+func (a args) setUint(key string, value uint64) args {
+	if value > 0 {
+		url.Values(a).Set(key, strconv.FormatUint(value, 10))
+	}
+	return a
+}
+
+func (a args) setInt(key string, value int) args {
+	if value > 0 {
+		url.Values(a).Set(key, strconv.Itoa(value))
+	}
+	return a
+}
+
+func (a args) setBool(key string, value bool) args {
+	if value {
+		url.Values(a).Set(key, "true")
+	}
+	return a
+}
+
+func (a args) setOptionalBool(key string, value *bool) args {
+	if value != nil {
+		url.Values(a).Set(key, strconv.FormatBool(*value))
+	}
+	return a
+}
+
+func (a args) setList(key string, values []string) args {
+	if len(values) > 0 {
+		url.Values(a).Set(key, strings.Join(values, ","))
+	}
+	return a
+}
+
+func (a args) setTime(key string, value time.Time) args {
+	if !value.IsZero() {
+		url.Values(a).Set(key, strconv.FormatInt(value.Unix(), 10))
+	}
+	return a
+}
+
+func (a args) setPage(p Page) args {
+	return a.setInt("limit", p.Limit).setInt("offset", p.Offset).setString("sort", p.Sort)
+}
+
+func (a args) setTimeRange(r TimeRange) args {
+	return a.setTime("from", r.From).setTime("to", r.To)
+}
+
+// PageFunc - requests one page of entities
+type PageFunc[T any] func(ctx context.Context, page Page) ([]T, error)
+
+// Pager - iterates over pages of a list endpoint. The offset of the next page is used as a cursor:
+// it can be saved and passed as Page.Offset to a new pager to resume iteration.
+type Pager[T any] struct {
+	fetch PageFunc[T]
+	page  Page
+	done  bool
+}
+
+// NewPager - creates pager which starts from the passed page
+func NewPager[T any](page Page, fetch PageFunc[T]) *Pager[T] {
+	if page.Limit <= 0 {
+		page.Limit = defaultLimit
+	}
+	if page.Limit > maxLimit {
+		page.Limit = maxLimit
+	}
+	return &Pager[T]{
+		fetch: fetch,
+		page:  page,
+	}
+}
+
+// Next - requests next page. It returns empty result when all pages are received.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+	items, err := p.fetch(ctx, p.page)
+	if err != nil {
+		if errors.Is(err, ErrNoContent) {
+			p.done = true
+			return nil, nil
+		}
+		return nil, err
+	}
+	p.page.Offset += len(items)
+	p.done = len(items) < p.page.Limit
+	return items, nil
+}
+
+// Done - returns true if all pages are received
+func (p *Pager[T]) Done() bool {
+	return p.done
+}
+
+// Cursor - returns offset of the next page
+func (p *Pager[T]) Cursor() int {
+	return p.page.Offset
+}
+
+// All - requests all remaining pages
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var result []T
+	for !p.done {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, items...)
+	}
+	return result, nil
+}
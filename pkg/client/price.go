@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Markets - returns list of markets with the latest prices
+func (c *Client) Markets(ctx context.Context, page Page) ([]responses.Market, error) {
+	var result []responses.Market
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "price")
+	return result, err
+}
+
+// Market - returns market with the latest price by pair, e.g. BTC-USDT
+func (c *Client) Market(ctx context.Context, pair string) (responses.Market, error) {
+	var result responses.Market
+	err := c.get(ctx, nil, &result, "price", pair)
+	return result, err
+}
+
+// MarketHistory - returns history of the market
+func (c *Client) MarketHistory(ctx context.Context, pair string, page Page) ([]responses.Market, error) {
+	var result []responses.Market
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "price", pair, "history")
+	return result, err
+}
+
+// PriceSeries - returns price candles of the pair. Timeframe is one of `hour` or `day`.
+func (c *Client) PriceSeries(ctx context.Context, pair, timeframe string, timeRange TimeRange) ([]responses.Candle, error) {
+	var result []responses.Candle
+	err := c.get(ctx, newArgs().setTimeRange(timeRange).values(), &result, "price", pair, "series", timeframe)
+	return result, err
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Rollbacks - returns list of chain reorganizations handled by the indexer
+func (c *Client) Rollbacks(ctx context.Context, page Page) ([]responses.RollbackEvent, error) {
+	var result []responses.RollbackEvent
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "rollbacks")
+	return result, err
+}
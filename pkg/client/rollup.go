@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// RollupListFilters - filters of rollup list
+type RollupListFilters struct {
+	Page
+
+	// SortBy - sort field: `size` or `id`
+	SortBy string
+	// Finalized - hide rollups first seen in blocks which are not finalized yet
+	Finalized bool
+}
+
+// Rollups - returns list of rollups
+func (c *Client) Rollups(ctx context.Context, filters RollupListFilters) ([]responses.Rollup, error) {
+	var result []responses.Rollup
+	values := newArgs().
+		setPage(filters.Page).
+		setString("sort_by", filters.SortBy).
+		setBool("finalized", filters.Finalized).
+		values()
+	err := c.get(ctx, values, &result, "rollup")
+	return result, err
+}
+
+// RollupsPager - returns pager over rollup list
+func (c *Client) RollupsPager(filters RollupListFilters) *Pager[responses.Rollup] {
+	return NewPager(filters.Page, func(ctx context.Context, page Page) ([]responses.Rollup, error) {
+		filters.Page = page
+		return c.Rollups(ctx, filters)
+	})
+}
+
+// RollupsCount - returns count of rollups
+func (c *Client) RollupsCount(ctx context.Context) (uint64, error) {
+	var result uint64
+	err := c.get(ctx, nil, &result, "rollup", "count")
+	return result, err
+}
+
+// Rollup - returns rollup by base64url encoded hash
+func (c *Client) Rollup(ctx context.Context, hash string) (responses.Rollup, error) {
+	var result responses.Rollup
+	err := c.get(ctx, nil, &result, "rollup", hash)
+	return result, err
+}
+
+// RollupActionsFilters - filters of rollup data submissions
+type RollupActionsFilters struct {
+	Page
+
+	// Finalized - hide actions which are not finalized yet
+	Finalized bool
+}
+
+// RollupActions - returns data submissions of the rollup
+func (c *Client) RollupActions(ctx context.Context, hash string, filters RollupActionsFilters) ([]responses.RollupAction, error) {
+	var result []responses.RollupAction
+	values := newArgs().
+		setPage(filters.Page).
+		setBool("finalized", filters.Finalized).
+		values()
+	err := c.get(ctx, values, &result, "rollup", hash, "actions")
+	return result, err
+}
+
+// RollupAllActionsFilters - filters of all rollup actions
+type RollupAllActionsFilters struct {
+	Page
+	TimeRange
+
+	// RollupActions - include data submissions. Nil means API default.
+	RollupActions *bool
+	// BridgeActions - include actions of the rollup bridges. Nil means API default.
+	BridgeActions *bool
+	ActionTypes   []string
+	// Finalized - hide actions which are not finalized yet
+	Finalized bool
+}
+
+// RollupAllActions - returns actions of the rollup and its bridges
+func (c *Client) RollupAllActions(ctx context.Context, hash string, filters RollupAllActionsFilters) ([]responses.Action, error) {
+	var result []responses.Action
+	values := newArgs().
+		setPage(filters.Page).
+		setTimeRange(filters.TimeRange).
+		setOptionalBool("rollup_actions", filters.RollupActions).
+		setOptionalBool("bridge_actions", filters.BridgeActions).
+		setList("action_types", filters.ActionTypes).
+		setBool("finalized", filters.Finalized).
+		values()
+	err := c.get(ctx, values, &result, "rollup", hash, "all_actions")
+	return result, err
+}
+
+// RollupAddresses - returns addresses which pushed data to the rollup
+func (c *Client) RollupAddresses(ctx context.Context, hash string, page Page) ([]responses.Address, error) {
+	var result []responses.Address
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "rollup", hash, "addresses")
+	return result, err
+}
+
+// RollupBridges - returns bridges of the rollup
+func (c *Client) RollupBridges(ctx context.Context, hash string, page Page) ([]responses.Bridge, error) {
+	var result []responses.Bridge
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "rollup", hash, "bridges")
+	return result, err
+}
+
+// RollupDeposits - returns deposits to the rollup
+func (c *Client) RollupDeposits(ctx context.Context, hash string, page Page) ([]responses.Deposit, error) {
+	var result []responses.Deposit
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "rollup", hash, "deposits")
+	return result, err
+}
+
+// RollupAccount - returns deposits and withdrawals of the rollup account by its destination address
+func (c *Client) RollupAccount(ctx context.Context, hash, destination string, page Page) (responses.RollupAccount, error) {
+	var result responses.RollupAccount
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "rollup", hash, "account", destination)
+	return result, err
+}
+
+// RollupLiveness - returns liveness of the rollup
+func (c *Client) RollupLiveness(ctx context.Context, hash string) (responses.RollupLiveness, error) {
+	var result responses.RollupLiveness
+	err := c.get(ctx, nil, &result, "rollup", hash, "liveness")
+	return result, err
+}
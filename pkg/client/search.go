@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Search - searches entities by hash, height, name or prefix
+func (c *Client) Search(ctx context.Context, query string) ([]responses.SearchResult, error) {
+	var result []responses.SearchResult
+	err := c.get(ctx, newArgs().setString("query", query).values(), &result, "search")
+	return result, err
+}
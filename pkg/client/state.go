@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Head - returns current state of the indexer
+func (c *Client) Head(ctx context.Context) (responses.State, error) {
+	var result responses.State
+	err := c.get(ctx, nil, &result, "head")
+	return result, err
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Summary - returns network summary
+func (c *Client) Summary(ctx context.Context) (responses.NetworkSummary, error) {
+	var result responses.NetworkSummary
+	err := c.get(ctx, nil, &result, "stats", "summary")
+	return result, err
+}
+
+// SummaryTimeframe - returns network summary of the timeframe with changes relative to the previous one
+func (c *Client) SummaryTimeframe(ctx context.Context, timeframe string) (responses.NetworkSummaryWithChange, error) {
+	var result responses.NetworkSummaryWithChange
+	err := c.get(ctx, nil, &result, "stats", "summary", timeframe)
+	return result, err
+}
+
+// ActiveAddressesCount - returns count of active addresses
+func (c *Client) ActiveAddressesCount(ctx context.Context) (int64, error) {
+	var result int64
+	err := c.get(ctx, nil, &result, "stats", "summary", "active_addresses_count")
+	return result, err
+}
+
+// Series - returns histogram of the network series
+func (c *Client) Series(ctx context.Context, name, timeframe string, timeRange TimeRange) ([]responses.SeriesItem, error) {
+	var result []responses.SeriesItem
+	err := c.get(ctx, newArgs().setTimeRange(timeRange).values(), &result, "stats", "series", name, timeframe)
+	return result, err
+}
+
+// DistributionFilters - filters of distribution
+type DistributionFilters struct {
+	Timeframe string
+	Rollup    string
+	Asset     string
+	Buckets   int
+}
+
+// Distribution - returns histogram of the value distribution
+func (c *Client) Distribution(ctx context.Context, name string, filters DistributionFilters) (responses.Distribution, error) {
+	var result responses.Distribution
+	values := newArgs().
+		setString("timeframe", filters.Timeframe).
+		setString("rollup", filters.Rollup).
+		setString("asset", filters.Asset).
+		setInt("buckets", filters.Buckets).
+		values()
+	err := c.get(ctx, values, &result, "stats", "distribution", name)
+	return result, err
+}
+
+// ActionSeries - returns histogram of the action type
+func (c *Client) ActionSeries(ctx context.Context, actionType, timeframe string, timeRange TimeRange) ([]responses.SeriesItem, error) {
+	var result []responses.SeriesItem
+	err := c.get(ctx, newArgs().setTimeRange(timeRange).values(), &result, "stats", "actions", "series", actionType, timeframe)
+	return result, err
+}
+
+// ActionsBreakdown - returns share of each action type
+func (c *Client) ActionsBreakdown(ctx context.Context, timeframe string) ([]responses.ActionTypeShare, error) {
+	var result []responses.ActionTypeShare
+	err := c.get(ctx, newArgs().setString("timeframe", timeframe).values(), &result, "stats", "actions", "breakdown")
+	return result, err
+}
+
+// RollupSeries - returns histogram of the rollup series
+func (c *Client) RollupSeries(ctx context.Context, hash, name, timeframe string, timeRange TimeRange) ([]responses.RollupSeriesItem, error) {
+	var result []responses.RollupSeriesItem
+	err := c.get(ctx, newArgs().setTimeRange(timeRange).values(), &result, "stats", "rollup", "series", hash, name, timeframe)
+	return result, err
+}
+
+// CompareRollupsFilters - parameters of rollups comparison
+type CompareRollupsFilters struct {
+	TimeRange
+
+	// Rollups - base64url encoded hashes of compared rollups
+	Rollups   []string
+	Name      string
+	Timeframe string
+}
+
+// CompareRollups - returns series of several rollups aligned by time
+func (c *Client) CompareRollups(ctx context.Context, filters CompareRollupsFilters) (responses.RollupComparison, error) {
+	var result responses.RollupComparison
+	values := newArgs().
+		setTimeRange(filters.TimeRange).
+		setList("rollups", filters.Rollups).
+		setString("name", filters.Name).
+		setString("timeframe", filters.Timeframe).
+		values()
+	err := c.get(ctx, values, &result, "stats", "rollup", "compare")
+	return result, err
+}
+
+// FeeSummary - returns summary of paid fees by asset
+func (c *Client) FeeSummary(ctx context.Context) ([]responses.FeeSummary, error) {
+	var result []responses.FeeSummary
+	err := c.get(ctx, nil, &result, "stats", "fee", "summary")
+	return result, err
+}
+
+// FeeActions - returns summary of paid fees by action type
+func (c *Client) FeeActions(ctx context.Context) ([]responses.FeeActionSummary, error) {
+	var result []responses.FeeActionSummary
+	err := c.get(ctx, nil, &result, "stats", "fee", "actions")
+	return result, err
+}
+
+// FeeSeriesFilters - filters of fee series
+type FeeSeriesFilters struct {
+	TimeRange
+
+	ActionType string
+	Asset      string
+}
+
+// FeeSeries - returns histogram of paid fees
+func (c *Client) FeeSeries(ctx context.Context, timeframe string, filters FeeSeriesFilters) ([]responses.SeriesItem, error) {
+	var result []responses.SeriesItem
+	values := newArgs().
+		setTimeRange(filters.TimeRange).
+		setString("action_type", filters.ActionType).
+		setString("asset", filters.Asset).
+		values()
+	err := c.get(ctx, values, &result, "stats", "fee", "series", timeframe)
+	return result, err
+}
+
+// FeePayersFilters - filters of top fee payers
+type FeePayersFilters struct {
+	Timeframe string
+	Asset     string
+	Limit     int
+}
+
+// FeePayers - returns top fee payers
+func (c *Client) FeePayers(ctx context.Context, filters FeePayersFilters) ([]responses.FeePayer, error) {
+	var result []responses.FeePayer
+	values := newArgs().
+		setString("timeframe", filters.Timeframe).
+		setString("asset", filters.Asset).
+		setInt("limit", filters.Limit).
+		values()
+	err := c.get(ctx, values, &result, "stats", "fee", "payers")
+	return result, err
+}
+
+// RollupFees - returns fees paid for data submissions of rollups
+func (c *Client) RollupFees(ctx context.Context, asset string, page Page) ([]responses.RollupFee, error) {
+	var result []responses.RollupFee
+	values := newArgs().
+		setPage(page).
+		setString("asset", asset).
+		values()
+	err := c.get(ctx, values, &result, "stats", "fee", "rollups")
+	return result, err
+}
+
+// RollupFee - returns fees paid for data submissions of the rollup
+func (c *Client) RollupFee(ctx context.Context, hash string) ([]responses.RollupFee, error) {
+	var result []responses.RollupFee
+	err := c.get(ctx, nil, &result, "stats", "fee", "rollup", hash)
+	return result, err
+}
+
+// AppFee - returns fees paid for data submissions of the application rollups
+func (c *Client) AppFee(ctx context.Context, slug string) ([]responses.RollupFee, error) {
+	var result []responses.RollupFee
+	err := c.get(ctx, nil, &result, "stats", "fee", "app", slug)
+	return result, err
+}
+
+// TokenTransferDistribution - returns distribution of transferred tokens. Zero limit means API default.
+func (c *Client) TokenTransferDistribution(ctx context.Context, limit uint64) ([]responses.TokenTransferDistributionItem, error) {
+	var result []responses.TokenTransferDistributionItem
+	err := c.get(ctx, newArgs().setUint("limit", limit).values(), &result, "stats", "token", "transfer_distribution")
+	return result, err
+}
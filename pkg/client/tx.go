@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// TxFilters - filters of transaction list
+type TxFilters struct {
+	Page
+	TimeRange
+
+	Height      uint64
+	Status      []string
+	ActionTypes []string
+	// Finalized - hide transactions which are not finalized yet
+	Finalized bool
+}
+
+func (f TxFilters) args() args {
+	return newArgs().
+		setPage(f.Page).
+		setTimeRange(f.TimeRange).
+		setUint("height", f.Height).
+		setList("status", f.Status).
+		setList("action_types", f.ActionTypes).
+		setBool("finalized", f.Finalized)
+}
+
+// TxListFilters - filters of network transaction list
+type TxListFilters struct {
+	TxFilters
+
+	// WithActions - join actions of transactions
+	WithActions bool
+}
+
+// Txs - returns list of transactions
+func (c *Client) Txs(ctx context.Context, filters TxListFilters) ([]responses.Tx, error) {
+	var result []responses.Tx
+	values := filters.args().
+		setBool("with_actions", filters.WithActions).
+		values()
+	err := c.get(ctx, values, &result, "tx")
+	return result, err
+}
+
+// TxsPager - returns pager over transaction list
+func (c *Client) TxsPager(filters TxListFilters) *Pager[responses.Tx] {
+	return NewPager(filters.Page, func(ctx context.Context, page Page) ([]responses.Tx, error) {
+		filters.Page = page
+		return c.Txs(ctx, filters)
+	})
+}
+
+// TxsCount - returns count of transactions
+func (c *Client) TxsCount(ctx context.Context) (uint64, error) {
+	var result uint64
+	err := c.get(ctx, nil, &result, "tx", "count")
+	return result, err
+}
+
+type decodeTxRequest struct {
+	Tx       string `json:"tx"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// DecodeTx - decodes raw transaction. Encoding is one of `base64` or `hex`, empty value means API default.
+func (c *Client) DecodeTx(ctx context.Context, tx, encoding string) (responses.DecodedTx, error) {
+	var result responses.DecodedTx
+	err := c.post(ctx, decodeTxRequest{
+		Tx:       tx,
+		Encoding: encoding,
+	}, &result, "tx", "decode")
+	return result, err
+}
+
+type hashesBatchRequest struct {
+	Hashes []string `json:"hashes"`
+}
+
+// TxsBatch - returns transactions by hex encoded hashes. Result items are in the order of requested hashes.
+func (c *Client) TxsBatch(ctx context.Context, hashes []string) ([]responses.BatchItem[responses.Tx], error) {
+	var result []responses.BatchItem[responses.Tx]
+	err := c.post(ctx, hashesBatchRequest{Hashes: hashes}, &result, "tx", "batch")
+	return result, err
+}
+
+// Tx - returns transaction by hex encoded hash
+func (c *Client) Tx(ctx context.Context, hash string, withFee bool) (responses.Tx, error) {
+	var result responses.Tx
+	err := c.get(ctx, newArgs().setBool("fee", withFee).values(), &result, "tx", hash)
+	return result, err
+}
+
+// TxActions - returns actions of the transaction
+func (c *Client) TxActions(ctx context.Context, hash string, page Page) ([]responses.Action, error) {
+	var result []responses.Action
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "tx", hash, "actions")
+	return result, err
+}
+
+// TxFees - returns fees of the transaction
+func (c *Client) TxFees(ctx context.Context, hash string, page Page) ([]responses.FullFee, error) {
+	var result []responses.FullFee
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "tx", hash, "fees")
+	return result, err
+}
+
+// TxRollupActions - returns rollup actions of the transaction
+func (c *Client) TxRollupActions(ctx context.Context, hash string, page Page) ([]responses.RollupAction, error) {
+	var result []responses.RollupAction
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "tx", hash, "rollup_actions")
+	return result, err
+}
+
+// TxRollupActionsCount - returns count of rollup actions of the transaction
+func (c *Client) TxRollupActionsCount(ctx context.Context, hash string) (uint64, error) {
+	var result uint64
+	err := c.get(ctx, nil, &result, "tx", hash, "rollup_actions", "count")
+	return result, err
+}
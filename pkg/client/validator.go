@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+)
+
+// Validators - returns list of validators
+func (c *Client) Validators(ctx context.Context, page Page) ([]responses.Validator, error) {
+	var result []responses.Validator
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "validators")
+	return result, err
+}
+
+// Validator - returns validator by internal id
+func (c *Client) Validator(ctx context.Context, id uint64) (responses.Validator, error) {
+	var result responses.Validator
+	err := c.get(ctx, nil, &result, "validators", formatUint(id))
+	return result, err
+}
+
+// ValidatorBlocks - returns blocks proposed by the validator
+func (c *Client) ValidatorBlocks(ctx context.Context, id uint64, page Page) ([]responses.Block, error) {
+	var result []responses.Block
+	err := c.get(ctx, newArgs().setPage(page).values(), &result, "validators", formatUint(id), "blocks")
+	return result, err
+}
+
+// ValidatorUptime - returns uptime of the validator over the last limit blocks. Zero limit means API default.
+func (c *Client) ValidatorUptime(ctx context.Context, id, limit uint64) (responses.ValidatorUptime, error) {
+	var result responses.ValidatorUptime
+	err := c.get(ctx, newArgs().setUint("limit", limit).values(), &result, "validators", formatUint(id), "uptime")
+	return result, err
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/handler/responses"
+	ws "github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// channels
+const (
+	ChannelHead     = ws.ChannelHead
+	ChannelBlocks   = ws.ChannelBlocks
+	ChannelRollback = ws.ChannelRollback
+	ChannelLiveness = ws.ChannelLiveness
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = time.Second * 30
+	messagesCapacity  = 1024
+)
+
+// Message - notification received from the websocket channel
+type Message struct {
+	Channel string          `json:"channel"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// Head - decodes notification of the head channel
+func (m Message) Head() (responses.State, error) {
+	var state responses.State
+	err := m.decode(ChannelHead, &state)
+	return state, err
+}
+
+// Block - decodes notification of the blocks channel
+func (m Message) Block() (responses.Block, error) {
+	var block responses.Block
+	err := m.decode(ChannelBlocks, &block)
+	return block, err
+}
+
+// Rollback - decodes notification of the rollback channel
+func (m Message) Rollback() (responses.RollbackEvent, error) {
+	var event responses.RollbackEvent
+	err := m.decode(ChannelRollback, &event)
+	return event, err
+}
+
+// Liveness - decodes notification of the rollup liveness channel
+func (m Message) Liveness() (responses.RollupLivenessEvent, error) {
+	var event responses.RollupLivenessEvent
+	err := m.decode(ChannelLiveness, &event)
+	return event, err
+}
+
+func (m Message) decode(channel string, output any) error {
+	if m.Channel != channel {
+		return errors.Errorf("unexpected channel of message: %s", m.Channel)
+	}
+	return json.Unmarshal(m.Body, output)
+}
+
+// SubscriberOption - option of the websocket subscriber
+type SubscriberOption func(*Subscriber)
+
+// WithReconnectBackoff - sets bounds of the exponential delay between reconnection attempts
+func WithReconnectBackoff(minDelay, maxDelay time.Duration) SubscriberOption {
+	return func(s *Subscriber) {
+		if minDelay > 0 {
+			s.minBackoff = minDelay
+		}
+		if maxDelay >= s.minBackoff {
+			s.maxBackoff = maxDelay
+		}
+	}
+}
+
+// Subscriber - websocket subscriber which reconnects on connection loss and restores active subscriptions
+type Subscriber struct {
+	url        string
+	header     http.Header
+	dialer     *websocket.Dialer
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mx       *sync.Mutex
+	conn     *websocket.Conn
+	channels map[string]struct{}
+
+	messages chan Message
+	cancel   context.CancelFunc
+	wg       *sync.WaitGroup
+}
+
+// Subscriber - creates websocket subscriber of the API. Call Start to connect.
+func (c *Client) Subscriber(opts ...SubscriberOption) *Subscriber {
+	u := c.baseURL.JoinPath("ws")
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	header := make(http.Header)
+	header.Set("User-Agent", c.userAgent)
+	if c.apiKey != "" {
+		header.Set(headerApiKey, c.apiKey)
+	}
+
+	s := &Subscriber{
+		url:        u.String(),
+		header:     header,
+		dialer:     websocket.DefaultDialer,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+		mx:         new(sync.Mutex),
+		channels:   make(map[string]struct{}),
+		messages:   make(chan Message, messagesCapacity),
+		wg:         new(sync.WaitGroup),
+	}
+	for i := range opts {
+		opts[i](s)
+	}
+	return s
+}
+
+// Start - connects to the API and starts receiving of notifications
+func (s *Subscriber) Start(ctx context.Context) error {
+	if err := s.connect(ctx); err != nil {
+		return errors.Wrap(err, "connect to websocket")
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.wg.Add(1)
+	go s.listen(ctx)
+	return nil
+}
+
+// Messages - returns channel of received notifications. It's closed after the subscriber is closed.
+func (s *Subscriber) Messages() <-chan Message {
+	return s.messages
+}
+
+// Subscribe - subscribes to the channel. Subscription is restored after reconnection.
+func (s *Subscriber) Subscribe(channel string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.channels[channel] = struct{}{}
+	if s.conn == nil {
+		return nil
+	}
+	return s.send(ws.MethodSubscribe, ws.Subscribe{Channel: channel})
+}
+
+// Unsubscribe - unsubscribes from the channel
+func (s *Subscriber) Unsubscribe(channel string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	delete(s.channels, channel)
+	if s.conn == nil {
+		return nil
+	}
+	return s.send(ws.MethodUnsubscribe, ws.Unsubscribe{Channel: channel})
+}
+
+// Close - closes connection and stops receiving of notifications
+func (s *Subscriber) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	err := s.disconnect()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Subscriber) listen(ctx context.Context) {
+	defer s.wg.Done()
+	defer close(s.messages)
+
+	for {
+		s.mx.Lock()
+		conn := s.conn
+		s.mx.Unlock()
+
+		if conn == nil {
+			if !s.reconnect(ctx) {
+				return
+			}
+			continue
+		}
+
+		var msg Message
+		if err := conn.ReadJSON(&msg); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warn().Err(err).Str("url", s.url).Msg("websocket connection lost")
+			if err := s.disconnect(); err != nil {
+				log.Err(err).Msg("close websocket connection")
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case s.messages <- msg:
+		}
+	}
+}
+
+func (s *Subscriber) reconnect(ctx context.Context) bool {
+	delay := s.minBackoff
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+
+		err := s.connect(ctx)
+		if err == nil {
+			return true
+		}
+		log.Warn().Err(err).Str("url", s.url).Dur("delay", delay).Msg("websocket reconnection failed")
+
+		delay *= 2
+		if delay > s.maxBackoff {
+			delay = s.maxBackoff
+		}
+	}
+}
+
+func (s *Subscriber) connect(ctx context.Context) error {
+	conn, response, err := s.dialer.DialContext(ctx, s.url, s.header)
+	if response != nil && response.Body != nil {
+		closeBody(response.Body)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	// subscriber may be closed while dialing
+	if err := ctx.Err(); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	s.conn = conn
+	for channel := range s.channels {
+		if err := s.send(ws.MethodSubscribe, ws.Subscribe{Channel: channel}); err != nil {
+			s.conn = nil
+			_ = conn.Close()
+			return errors.Wrapf(err, "resubscribe to %s", channel)
+		}
+	}
+	return nil
+}
+
+func (s *Subscriber) disconnect() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// send - writes message to the connection. It must be called under the lock.
+func (s *Subscriber) send(method string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteJSON(ws.Message{
+		Method: method,
+		Body:   data,
+	})
+}
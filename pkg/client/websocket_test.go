@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celenium-io/astria-indexer/cmd/api/bus"
+	ws "github.com/celenium-io/astria-indexer/cmd/api/handler/websocket"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// trackingListener - listener which remembers accepted connections to break them in tests
+type trackingListener struct {
+	net.Listener
+
+	mx    sync.Mutex
+	conns []net.Conn
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.mx.Lock()
+	l.conns = append(l.conns, conn)
+	l.mx.Unlock()
+	return conn, nil
+}
+
+func (l *trackingListener) count() int {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	return len(l.conns)
+}
+
+// breakAll - emulates connection loss: server stops receiving data and closes connection
+func (l *trackingListener) breakAll(t *testing.T) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	for i := range l.conns {
+		tcp, ok := l.conns[i].(*net.TCPConn)
+		require.True(t, ok)
+		_ = tcp.CloseRead()
+	}
+}
+
+func receiveBlock(t *testing.T, messages <-chan Message) {
+	select {
+	case msg, ok := <-messages:
+		require.True(t, ok, "messages channel is closed")
+		block, err := msg.Block()
+		require.NoError(t, err)
+		require.Greater(t, block.Height, uint64(0))
+		require.Len(t, block.Hash, 32)
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "block notification is not received")
+	}
+}
+
+func TestSubscriberReconnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listenerFactory := mock.NewMockListenerFactory(ctrl)
+	listener := mock.NewMockListener(ctrl)
+	blocks := mock.NewMockIBlock(ctrl)
+
+	notifications := make(chan *pq.Notification, 10)
+	listenerFactory.EXPECT().CreateListener().Return(listener).Times(1)
+	listener.EXPECT().Listen().Return(notifications).AnyTimes()
+	listener.EXPECT().
+		Subscribe(gomock.Any(), storage.ChannelHead, storage.ChannelBlock, storage.ChannelConstant, storage.ChannelRollback).
+		Return(nil).
+		Times(1)
+	listener.EXPECT().Close().Return(nil).MaxTimes(1)
+
+	blocks.EXPECT().
+		ByIdWithRelations(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id uint64) (storage.Block, error) {
+			return storage.Block{
+				Id:     id,
+				Height: pkgTypes.Level(id),
+				Time:   time.Now(),
+				Hash:   make([]byte, 32),
+			}, nil
+		}).
+		AnyTimes()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	dispatcher, err := bus.NewDispatcher(listenerFactory, blocks)
+	require.NoError(t, err)
+	dispatcher.Start(ctx)
+
+	manager := ws.NewManager(dispatcher.Observe(storage.ChannelHead, storage.ChannelBlock), nil)
+	manager.Start(ctx)
+
+	go func() {
+		ticker := time.NewTicker(time.Millisecond * 50)
+		defer ticker.Stop()
+
+		var id uint64
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				id++
+				notifications <- &pq.Notification{
+					Channel: storage.ChannelBlock,
+					Extra:   strconv.FormatUint(id, 10),
+				}
+			}
+		}
+	}()
+
+	e := echo.New()
+	manager.InitRoutes(e.Group("v1"))
+
+	server := httptest.NewUnstartedServer(e)
+	tracker := &trackingListener{Listener: server.Listener}
+	server.Listener = tracker
+	server.Start()
+	defer server.Close()
+
+	client, err := New(server.URL)
+	require.NoError(t, err)
+
+	subscriber := client.Subscriber(WithReconnectBackoff(time.Millisecond*10, time.Millisecond*100))
+	require.NoError(t, subscriber.Start(ctx))
+	require.NoError(t, subscriber.Subscribe(ChannelBlocks))
+
+	receiveBlock(t, subscriber.Messages())
+
+	tracker.breakAll(t)
+	require.Eventually(t, func() bool {
+		return tracker.count() > 1
+	}, time.Second*5, time.Millisecond*10, "subscriber is not reconnected")
+
+	// drop notifications received before reconnection
+	for len(subscriber.Messages()) > 0 {
+		<-subscriber.Messages()
+	}
+
+	receiveBlock(t, subscriber.Messages())
+
+	require.NoError(t, subscriber.Close())
+	_, ok := <-subscriber.Messages()
+	require.False(t, ok)
+}
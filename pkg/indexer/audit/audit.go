@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/pkg/node"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	SourceStorage = "storage"
+	SourceNode    = "node"
+
+	pageSize = 100
+)
+
+// Config - auditor settings
+type Config struct {
+	// SampleRate - share of addresses and bridges which are compared with the node. 1 means full scan, 0 disables node checks.
+	SampleRate float64
+	// Limit - maximum count of mismatches of each kind received from the database. 0 means no limit.
+	Limit int
+	// Repair - fix found mismatches
+	Repair bool
+}
+
+// Mismatch - difference between indexed value and the value received from the node or recalculated from the database
+type Mismatch struct {
+	Entity   string
+	Key      string
+	Field    string
+	Source   string
+	Indexed  string
+	Expected string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("[%s] %s[%s].%s: indexed=%s expected=%s", m.Source, m.Entity, m.Key, m.Field, m.Indexed, m.Expected)
+}
+
+// Report - result of the audit
+type Report struct {
+	Height     types.Level
+	Mismatches []Mismatch
+	Repaired   bool
+}
+
+// Auditor - compares indexed balances and denormalized counters with the node state and with values recalculated from raw tables
+type Auditor struct {
+	tx          sdk.Transactable
+	api         node.Api
+	audit       storage.IAudit
+	state       storage.IState
+	bridges     storage.IBridge
+	indexerName string
+	cfg         Config
+	sample      func() bool
+	assets      map[string]string
+	log         zerolog.Logger
+}
+
+func New(
+	tx sdk.Transactable,
+	api node.Api,
+	audit storage.IAudit,
+	state storage.IState,
+	bridges storage.IBridge,
+	indexerName string,
+	cfg Config,
+) *Auditor {
+	return &Auditor{
+		tx:          tx,
+		api:         api,
+		audit:       audit,
+		state:       state,
+		bridges:     bridges,
+		indexerName: indexerName,
+		cfg:         cfg,
+		sample: func() bool {
+			return cfg.SampleRate >= 1 || rand.Float64() < cfg.SampleRate
+		},
+		assets: make(map[string]string),
+		log:    log.With().Str("module", "audit").Logger(),
+	}
+}
+
+// Run - executes all checks and repairs found mismatches if it's enabled
+func (a *Auditor) Run(ctx context.Context) (Report, error) {
+	state, err := a.state.ByName(ctx, a.indexerName)
+	if err != nil {
+		return Report{}, errors.Wrap(err, "receive state")
+	}
+
+	var (
+		report = Report{
+			Height:     state.LastHeight,
+			Mismatches: make([]Mismatch, 0),
+		}
+		plan = newRepairPlan()
+	)
+
+	checks := []struct {
+		name  string
+		check func(ctx context.Context, state storage.State, report *Report, plan *repairPlan) error
+	}{
+		{"state", a.checkState},
+		{"rollups", a.checkRollups},
+		{"addresses", a.checkAddresses},
+		{"balances", a.checkBalances},
+		{"node accounts", a.checkNodeAccounts},
+		{"node bridges", a.checkNodeBridges},
+	}
+
+	for _, c := range checks {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		before := len(report.Mismatches)
+		if err := c.check(ctx, state, &report, plan); err != nil {
+			return report, errors.Wrap(err, c.name)
+		}
+		a.log.Info().
+			Str("check", c.name).
+			Int("mismatches", len(report.Mismatches)-before).
+			Msg("check is finished")
+	}
+
+	if !a.cfg.Repair || plan.isEmpty() {
+		return report, nil
+	}
+
+	if err := a.repairInTransaction(ctx, plan); err != nil {
+		return report, errors.Wrap(err, "repair")
+	}
+	report.Repaired = true
+
+	return report, nil
+}
+
+func (a *Auditor) repairInTransaction(ctx context.Context, plan *repairPlan) error {
+	tx, err := postgres.BeginTransaction(ctx, a.tx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	if err := a.repair(ctx, tx, plan); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	return tx.Flush(ctx)
+}
@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	primitive "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	accounts "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/accounts/v1"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/mock"
+	nodeMock "github.com/celenium-io/astria-indexer/pkg/node/mock"
+	nodeTypes "github.com/celenium-io/astria-indexer/pkg/node/types"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+const testIndexerName = "test"
+
+type auditMocks struct {
+	api     *nodeMock.MockApi
+	audit   *mock.MockIAudit
+	state   *mock.MockIState
+	bridges *mock.MockIBridge
+}
+
+func newTestAuditor(t *testing.T, cfg Config) (*Auditor, auditMocks) {
+	ctrl := gomock.NewController(t)
+	m := auditMocks{
+		api:     nodeMock.NewMockApi(ctrl),
+		audit:   mock.NewMockIAudit(ctrl),
+		state:   mock.NewMockIState(ctrl),
+		bridges: mock.NewMockIBridge(ctrl),
+	}
+	a := New(nil, m.api, m.audit, m.state, m.bridges, testIndexerName, cfg)
+	return a, m
+}
+
+func testState() storage.State {
+	return storage.State{
+		Name:          testIndexerName,
+		LastHeight:    100,
+		TotalTx:       10,
+		TotalAccounts: 5,
+		TotalRollups:  2,
+		TotalBridges:  1,
+		TotalBytes:    1000,
+		TotalSupply:   decimal.RequireFromString("1000"),
+	}
+}
+
+func marshal(t *testing.T, msg proto.Message) nodeTypes.AbciQueryResponse {
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return nodeTypes.AbciQueryResponse{
+		Response: nodeTypes.DenomMetadata{
+			Value: data,
+		},
+	}
+}
+
+func TestAuditor_Run_Storage(t *testing.T) {
+	a, m := newTestAuditor(t, Config{})
+
+	state := testState()
+	expectedState := state
+	expectedState.TotalTx = 11
+
+	m.state.EXPECT().
+		ByName(gomock.Any(), testIndexerName).
+		Return(state, nil).
+		Times(1)
+
+	m.audit.EXPECT().
+		ExpectedState(gomock.Any(), testIndexerName).
+		Return(expectedState, nil).
+		Times(1)
+
+	m.audit.EXPECT().
+		RollupMismatches(gomock.Any(), 0).
+		Return([]storage.RollupMismatch{
+			{
+				Id:                   1,
+				AstriaId:             []byte{0x01, 0x02},
+				ActionsCount:         3,
+				ExpectedActionsCount: 4,
+				Size:                 100,
+				ExpectedSize:         100,
+				BridgeCount:          1,
+				ExpectedBridgeCount:  1,
+			},
+		}, nil).
+		Times(1)
+
+	m.audit.EXPECT().
+		AddressMismatches(gomock.Any(), 0).
+		Return([]storage.AddressMismatch{
+			{
+				Id:                    2,
+				Hash:                  "astria1address",
+				ActionsCount:          1,
+				ExpectedActionsCount:  1,
+				SignedTxCount:         2,
+				ExpectedSignedTxCount: 3,
+				Nonce:                 1,
+				ExpectedNonce:         2,
+			},
+		}, nil).
+		Times(1)
+
+	m.audit.EXPECT().
+		BalanceMismatches(gomock.Any(), 0).
+		Return([]storage.BalanceMismatch{
+			{
+				AddressId: 2,
+				Hash:      "astria1address",
+				Currency:  "nria",
+				Total:     decimal.RequireFromString("100"),
+				Expected:  decimal.RequireFromString("90"),
+			},
+		}, nil).
+		Times(1)
+
+	report, err := a.Run(t.Context())
+	require.NoError(t, err)
+	require.EqualValues(t, 100, report.Height)
+	require.False(t, report.Repaired)
+	require.Len(t, report.Mismatches, 5)
+
+	require.Equal(t, Mismatch{
+		Entity:   "state",
+		Key:      testIndexerName,
+		Field:    "total_tx",
+		Source:   SourceStorage,
+		Indexed:  "10",
+		Expected: "11",
+	}, report.Mismatches[0])
+
+	require.Equal(t, "rollup", report.Mismatches[1].Entity)
+	require.Equal(t, "0102", report.Mismatches[1].Key)
+	require.Equal(t, "actions_count", report.Mismatches[1].Field)
+
+	require.Equal(t, "address", report.Mismatches[2].Entity)
+	require.Equal(t, "signed_tx_count", report.Mismatches[2].Field)
+	require.Equal(t, "address", report.Mismatches[3].Entity)
+	require.Equal(t, "nonce", report.Mismatches[3].Field)
+
+	require.Equal(t, "balance", report.Mismatches[4].Entity)
+	require.Equal(t, "astria1address:nria", report.Mismatches[4].Key)
+	require.Equal(t, "100", report.Mismatches[4].Indexed)
+	require.Equal(t, "90", report.Mismatches[4].Expected)
+}
+
+func TestAuditor_checkNodeAccounts(t *testing.T) {
+	a, m := newTestAuditor(t, Config{SampleRate: 1})
+
+	state := testState()
+	address := storage.Address{
+		Id:            1,
+		Hash:          "astria1address",
+		Nonce:         4,
+		SignedTxCount: 5,
+		Balance: []*storage.Balance{
+			{
+				Id:       1,
+				Currency: "nria",
+				Total:    decimal.RequireFromString("100"),
+			}, {
+				Id:       1,
+				Currency: "asset",
+				Total:    decimal.RequireFromString("10"),
+			},
+		},
+	}
+
+	m.audit.EXPECT().
+		Addresses(gomock.Any(), uint64(0), pageSize).
+		Return([]storage.Address{address}, nil).
+		Times(1)
+
+	m.api.EXPECT().
+		Balances(gomock.Any(), address.Hash, types.Level(100)).
+		Return(marshal(t, &accounts.BalanceResponse{
+			Height: 100,
+			Balances: []*accounts.AssetBalance{
+				{
+					Denom:   "nria",
+					Balance: &primitive.Uint128{Lo: 150},
+				},
+			},
+		}), nil).
+		Times(1)
+
+	m.api.EXPECT().
+		Nonce(gomock.Any(), address.Hash, types.Level(100)).
+		Return(marshal(t, &accounts.NonceResponse{
+			Height: 100,
+			Nonce:  7,
+		}), nil).
+		Times(1)
+
+	var (
+		report = Report{Mismatches: make([]Mismatch, 0)}
+		plan   = newRepairPlan()
+	)
+	err := a.checkNodeAccounts(t.Context(), state, &report, plan)
+	require.NoError(t, err)
+	require.Len(t, report.Mismatches, 3)
+	require.Len(t, plan.balances, 2)
+	require.Len(t, plan.nonces, 1)
+	require.EqualValues(t, 6, plan.nonces[1])
+
+	fix, ok := plan.balances[balanceKey{1, "nria"}]
+	require.True(t, ok)
+	require.Equal(t, "100", fix.total.String())
+	require.Equal(t, "150", fix.expected.String())
+
+	fix, ok = plan.balances[balanceKey{1, "asset"}]
+	require.True(t, ok)
+	require.Equal(t, "10", fix.total.String())
+	require.True(t, fix.expected.IsZero())
+}
+
+func TestAuditor_repair(t *testing.T) {
+	a, _ := newTestAuditor(t, Config{Repair: true})
+
+	ctrl := gomock.NewController(t)
+	tx := mock.NewMockTransaction(ctrl)
+
+	plan := newRepairPlan()
+	plan.setBalance(2, "nria", decimal.RequireFromString("100"), decimal.RequireFromString("90"))
+	plan.setBalance(1, "nria", decimal.RequireFromString("100"), decimal.RequireFromString("150"))
+	plan.setNonce(2, 7)
+	plan.addresses = append(plan.addresses, 2)
+	plan.rollups = append(plan.rollups, 1)
+	plan.bridges = append(plan.bridges, storage.Bridge{
+		Id:        1,
+		AddressId: 3,
+		SudoId:    4,
+		Sudo:      &storage.Address{Hash: "astria1sudo"},
+		Rollup:    &storage.Rollup{AstriaId: []byte{0x01}},
+		Asset:     "nria",
+	})
+	plan.state = true
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	tx.EXPECT().
+		SaveBalances(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, balances ...storage.Balance) error {
+			require.Len(t, balances, 2)
+			require.EqualValues(t, 1, balances[0].Id)
+			require.Equal(t, "50", balances[0].Total.String())
+			require.EqualValues(t, 2, balances[1].Id)
+			require.Equal(t, "-10", balances[1].Total.String())
+			return nil
+		}).
+		Times(1)
+
+	tx.EXPECT().
+		RecalculateAddressesById(ctx, uint64(2)).
+		Return(nil).
+		Times(1)
+
+	tx.EXPECT().
+		SetAddressesNonce(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, addresses ...*storage.Address) error {
+			require.Len(t, addresses, 1)
+			require.EqualValues(t, 2, addresses[0].Id)
+			require.EqualValues(t, 7, addresses[0].Nonce)
+			return nil
+		}).
+		Times(1)
+
+	tx.EXPECT().
+		RecalculateRollupsById(ctx, uint64(1)).
+		Return(nil).
+		Times(1)
+
+	tx.EXPECT().
+		GetRollup(ctx, []byte{0x01}).
+		Return(storage.Rollup{Id: 6}, nil).
+		Times(1)
+
+	tx.EXPECT().
+		GetAddressId(ctx, "astria1sudo").
+		Return(uint64(5), nil).
+		Times(1)
+
+	tx.EXPECT().
+		SaveBridges(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, bridges ...*storage.Bridge) (int64, error) {
+			require.Len(t, bridges, 1)
+			require.EqualValues(t, 5, bridges[0].SudoId)
+			require.EqualValues(t, 3, bridges[0].AddressId)
+			require.EqualValues(t, 6, bridges[0].RollupId)
+			require.Equal(t, "nria", bridges[0].Asset)
+			return 0, nil
+		}).
+		Times(1)
+
+	tx.EXPECT().
+		RecalculateState(ctx, testIndexerName).
+		Return(nil).
+		Times(1)
+
+	err := a.repair(ctx, tx, plan)
+	require.NoError(t, err)
+}
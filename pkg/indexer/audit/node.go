@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package audit
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	primitive "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	accounts "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/accounts/v1"
+	asset "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/asset/v1alpha1"
+	bridge "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/bridge/v1"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+)
+
+const requestTimeout = 10 * time.Second
+
+func (a *Auditor) checkNodeAccounts(ctx context.Context, state storage.State, report *Report, plan *repairPlan) error {
+	if a.cfg.SampleRate <= 0 {
+		return nil
+	}
+
+	var fromId uint64
+	for end := false; !end; {
+		addresses, err := a.audit.Addresses(ctx, fromId, pageSize)
+		if err != nil {
+			return errors.Wrap(err, "receive addresses")
+		}
+
+		for i := range addresses {
+			if !a.sample() && !plan.hasBalances(addresses[i].Id) {
+				continue
+			}
+			if err := a.checkNodeAccount(ctx, state, addresses[i], report, plan); err != nil {
+				return errors.Wrap(err, addresses[i].Hash)
+			}
+		}
+
+		end = len(addresses) < pageSize
+		if len(addresses) > 0 {
+			fromId = addresses[len(addresses)-1].Id
+		}
+	}
+	return nil
+}
+
+func (a *Auditor) checkNodeAccount(ctx context.Context, state storage.State, address storage.Address, report *Report, plan *repairPlan) error {
+	nodeBalances, err := a.nodeBalances(ctx, state, address.Hash)
+	if err != nil {
+		return err
+	}
+
+	indexed := make(map[string]decimal.Decimal, len(address.Balance))
+	for _, balance := range address.Balance {
+		indexed[balance.Currency] = balance.Total
+	}
+	for currency, total := range indexed {
+		if _, ok := nodeBalances[currency]; !ok && !total.IsZero() {
+			nodeBalances[currency] = decimal.Zero
+		}
+	}
+
+	for currency, expected := range nodeBalances {
+		total, ok := indexed[currency]
+		if !ok {
+			total = decimal.Zero
+		}
+		if total.Equal(expected) {
+			continue
+		}
+		report.Mismatches = append(report.Mismatches, Mismatch{
+			Entity:   "balance",
+			Key:      balanceKeyString(address.Hash, currency),
+			Field:    "total",
+			Source:   SourceNode,
+			Indexed:  total.String(),
+			Expected: expected.String(),
+		})
+		plan.setBalance(address.Id, currency, total, expected)
+	}
+
+	nodeNonce, err := a.nodeNonce(ctx, state, address.Hash)
+	if err != nil {
+		return err
+	}
+
+	// node returns the nonce of the next transaction while indexer stores the nonce of the last signed one
+	var indexedNonce uint32
+	if address.SignedTxCount > 0 {
+		indexedNonce = address.Nonce + 1
+	}
+	if indexedNonce != nodeNonce {
+		report.Mismatches = append(report.Mismatches, Mismatch{
+			Entity:   "address",
+			Key:      address.Hash,
+			Field:    "next_nonce",
+			Source:   SourceNode,
+			Indexed:  strconv.FormatUint(uint64(indexedNonce), 10),
+			Expected: strconv.FormatUint(uint64(nodeNonce), 10),
+		})
+		if nodeNonce > 0 {
+			plan.setNonce(address.Id, nodeNonce-1)
+		} else {
+			plan.setNonce(address.Id, 0)
+		}
+	}
+	return nil
+}
+
+func (a *Auditor) nodeBalances(ctx context.Context, state storage.State, address string) (map[string]decimal.Decimal, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	data, err := a.api.Balances(requestCtx, address, state.LastHeight)
+	if err != nil {
+		return nil, errors.Wrap(err, "receive balances")
+	}
+
+	var response accounts.BalanceResponse
+	if err := proto.Unmarshal(data.Response.Value, &response); err != nil {
+		return nil, errors.Wrap(err, "unmarshal balances")
+	}
+
+	result := make(map[string]decimal.Decimal, len(response.GetBalances()))
+	for _, balance := range response.GetBalances() {
+		currency, err := a.getAsset(ctx, balance.GetDenom())
+		if err != nil {
+			return nil, err
+		}
+		result[currency] = uint128ToDecimal(balance.GetBalance())
+	}
+	return result, nil
+}
+
+func (a *Auditor) nodeNonce(ctx context.Context, state storage.State, address string) (uint32, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	data, err := a.api.Nonce(requestCtx, address, state.LastHeight)
+	if err != nil {
+		return 0, errors.Wrap(err, "receive nonce")
+	}
+
+	var response accounts.NonceResponse
+	if err := proto.Unmarshal(data.Response.Value, &response); err != nil {
+		return 0, errors.Wrap(err, "unmarshal nonce")
+	}
+	return response.GetNonce(), nil
+}
+
+func (a *Auditor) checkNodeBridges(ctx context.Context, state storage.State, report *Report, plan *repairPlan) error {
+	if a.cfg.SampleRate <= 0 {
+		return nil
+	}
+
+	for offset, end := 0, false; !end; {
+		bridges, err := a.bridges.ListWithAddress(ctx, pageSize, offset)
+		if err != nil {
+			return errors.Wrap(err, "receive bridges")
+		}
+
+		for i := range bridges {
+			if !a.sample() {
+				continue
+			}
+			if err := a.checkNodeBridge(ctx, state, bridges[i].AddressId, report, plan); err != nil {
+				return errors.Wrapf(err, "bridge %d", bridges[i].Id)
+			}
+		}
+
+		offset += len(bridges)
+		end = len(bridges) < pageSize
+	}
+	return nil
+}
+
+func (a *Auditor) checkNodeBridge(ctx context.Context, state storage.State, addressId uint64, report *Report, plan *repairPlan) error {
+	indexed, err := a.bridges.ByAddress(ctx, addressId)
+	if err != nil {
+		return errors.Wrap(err, "receive bridge")
+	}
+	if indexed.Address == nil {
+		return errors.Errorf("unknown bridge address: %d", addressId)
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	data, err := a.api.BridgeAccountInfo(requestCtx, indexed.Address.Hash, state.LastHeight)
+	if err != nil {
+		return errors.Wrap(err, "receive bridge account info")
+	}
+
+	var response bridge.BridgeAccountInfoResponse
+	if err := proto.Unmarshal(data.Response.Value, &response); err != nil {
+		return errors.Wrap(err, "unmarshal bridge account info")
+	}
+
+	var (
+		key      = indexed.Address.Hash
+		repaired = indexed
+		changed  bool
+	)
+
+	var rollup string
+	if indexed.Rollup != nil {
+		rollup = hex.EncodeToString(indexed.Rollup.AstriaId)
+	}
+	if expected := response.GetRollupId().GetInner(); rollup != hex.EncodeToString(expected) {
+		report.Mismatches = appendNode(report.Mismatches, "bridge", key, "rollup", rollup, hex.EncodeToString(expected))
+		if len(expected) > 0 {
+			repaired.Rollup = &storage.Rollup{AstriaId: expected}
+			repaired.RollupId = 0
+			changed = true
+		}
+	}
+
+	expectedAsset, err := a.getAsset(ctx, response.GetAsset())
+	if err != nil {
+		return err
+	}
+	if indexed.Asset != expectedAsset {
+		report.Mismatches = appendNode(report.Mismatches, "bridge", key, "asset", indexed.Asset, expectedAsset)
+		if expectedAsset != "" {
+			repaired.Asset = expectedAsset
+			changed = true
+		}
+	}
+
+	if sudo, expected := addressHash(indexed.Sudo), response.GetSudoAddress().GetBech32M(); sudo != expected {
+		report.Mismatches = appendNode(report.Mismatches, "bridge", key, "sudo", sudo, expected)
+		if expected != "" {
+			repaired.Sudo = &storage.Address{Hash: expected}
+			changed = true
+		}
+	}
+
+	if withdrawer, expected := addressHash(indexed.Withdrawer), response.GetWithdrawerAddress().GetBech32M(); withdrawer != expected {
+		report.Mismatches = appendNode(report.Mismatches, "bridge", key, "withdrawer", withdrawer, expected)
+		if expected != "" {
+			repaired.Withdrawer = &storage.Address{Hash: expected}
+			changed = true
+		}
+	}
+
+	if changed {
+		plan.bridges = append(plan.bridges, repaired)
+	}
+	return nil
+}
+
+func (a *Auditor) getAsset(ctx context.Context, denom string) (string, error) {
+	if !strings.HasPrefix(denom, "ibc") {
+		return denom, nil
+	}
+	parts := strings.Split(denom, "/")
+	hash := parts[len(parts)-1]
+	if value, ok := a.assets[hash]; ok {
+		return value, nil
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	metadata, err := a.api.GetAssetInfo(requestCtx, hash)
+	if err != nil {
+		return "", errors.Wrap(err, denom)
+	}
+
+	var response asset.DenomResponse
+	if err := proto.Unmarshal(metadata.Response.Value, &response); err != nil {
+		return "", errors.Wrap(err, denom)
+	}
+	a.assets[hash] = response.GetDenom()
+	return response.GetDenom(), nil
+}
+
+func appendNode(mismatches []Mismatch, entity, key, field, indexed, expected string) []Mismatch {
+	return append(mismatches, Mismatch{
+		Entity:   entity,
+		Key:      key,
+		Field:    field,
+		Source:   SourceNode,
+		Indexed:  indexed,
+		Expected: expected,
+	})
+}
+
+func addressHash(address *storage.Address) string {
+	if address == nil {
+		return ""
+	}
+	return address.Hash
+}
+
+func uint128ToDecimal(u *primitive.Uint128) decimal.Decimal {
+	val := new(big.Int).SetUint64(u.GetHi())
+	val = val.Lsh(val, 64)
+	val = val.Add(val, new(big.Int).SetUint64(u.GetLo()))
+	return decimal.NewFromBigInt(val, 0)
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package audit
+
+import (
+	"context"
+	"sort"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+type balanceKey struct {
+	addressId uint64
+	currency  string
+}
+
+type balanceFix struct {
+	total    decimal.Decimal
+	expected decimal.Decimal
+}
+
+type repairPlan struct {
+	balances  map[balanceKey]balanceFix
+	nonces    map[uint64]uint32
+	addresses []uint64
+	rollups   []uint64
+	bridges   []storage.Bridge
+	state     bool
+}
+
+func newRepairPlan() *repairPlan {
+	return &repairPlan{
+		balances:  make(map[balanceKey]balanceFix),
+		nonces:    make(map[uint64]uint32),
+		addresses: make([]uint64, 0),
+		rollups:   make([]uint64, 0),
+		bridges:   make([]storage.Bridge, 0),
+	}
+}
+
+func (p *repairPlan) isEmpty() bool {
+	return len(p.balances) == 0 && len(p.nonces) == 0 && len(p.addresses) == 0 && len(p.rollups) == 0 && len(p.bridges) == 0 && !p.state
+}
+
+// setBalance - registers expected balance. Later calls override earlier ones, so values received from the node take priority over recalculated ones.
+func (p *repairPlan) setBalance(addressId uint64, currency string, total, expected decimal.Decimal) {
+	p.balances[balanceKey{addressId, currency}] = balanceFix{total, expected}
+}
+
+// setNonce - registers nonce of the last signed transaction of the address received from the node
+func (p *repairPlan) setNonce(addressId uint64, nonce uint32) {
+	p.nonces[addressId] = nonce
+}
+
+func (p *repairPlan) hasBalances(addressId uint64) bool {
+	for key := range p.balances {
+		if key.addressId == addressId {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Auditor) repair(ctx context.Context, tx storage.Transaction, plan *repairPlan) error {
+	balances := make([]storage.Balance, 0, len(plan.balances))
+	for key, fix := range plan.balances {
+		delta := fix.expected.Sub(fix.total)
+		if delta.IsZero() {
+			continue
+		}
+		balances = append(balances, storage.Balance{
+			Id:       key.addressId,
+			Currency: key.currency,
+			Total:    delta,
+		})
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].Id == balances[j].Id {
+			return balances[i].Currency < balances[j].Currency
+		}
+		return balances[i].Id < balances[j].Id
+	})
+	if err := tx.SaveBalances(ctx, balances...); err != nil {
+		return errors.Wrap(err, "save balances")
+	}
+
+	if err := tx.RecalculateAddressesById(ctx, plan.addresses...); err != nil {
+		return errors.Wrap(err, "recalculate addresses")
+	}
+
+	// nonces are set after recalculation because values received from the node take priority
+	if len(plan.nonces) > 0 {
+		addresses := make([]*storage.Address, 0, len(plan.nonces))
+		for id, nonce := range plan.nonces {
+			addresses = append(addresses, &storage.Address{
+				Id:    id,
+				Nonce: nonce,
+			})
+		}
+		sort.Slice(addresses, func(i, j int) bool {
+			return addresses[i].Id < addresses[j].Id
+		})
+		if err := tx.SetAddressesNonce(ctx, addresses...); err != nil {
+			return errors.Wrap(err, "set nonces")
+		}
+	}
+
+	if err := tx.RecalculateRollupsById(ctx, plan.rollups...); err != nil {
+		return errors.Wrap(err, "recalculate rollups")
+	}
+
+	if len(plan.bridges) > 0 {
+		bridges := make([]*storage.Bridge, 0, len(plan.bridges))
+		for i := range plan.bridges {
+			bridge := plan.bridges[i]
+			if bridge.Rollup != nil && bridge.Rollup.Id == 0 {
+				rollup, err := tx.GetRollup(ctx, bridge.Rollup.AstriaId)
+				if err != nil {
+					return errors.Wrap(err, "rollup")
+				}
+				bridge.RollupId = rollup.Id
+			}
+			if bridge.Sudo != nil {
+				id, err := a.addressId(ctx, tx, bridge.Sudo.Hash)
+				if err != nil {
+					return errors.Wrap(err, "sudo")
+				}
+				bridge.SudoId = id
+			}
+			if bridge.Withdrawer != nil {
+				id, err := a.addressId(ctx, tx, bridge.Withdrawer.Hash)
+				if err != nil {
+					return errors.Wrap(err, "withdrawer")
+				}
+				bridge.WithdrawerId = id
+			}
+			bridges = append(bridges, &bridge)
+		}
+		if _, err := tx.SaveBridges(ctx, bridges...); err != nil {
+			return errors.Wrap(err, "save bridges")
+		}
+	}
+
+	if plan.state {
+		if err := tx.RecalculateState(ctx, a.indexerName); err != nil {
+			return errors.Wrap(err, "recalculate state")
+		}
+	}
+
+	a.log.Info().
+		Int("balances", len(balances)).
+		Int("nonces", len(plan.nonces)).
+		Int("addresses", len(plan.addresses)).
+		Int("rollups", len(plan.rollups)).
+		Int("bridges", len(plan.bridges)).
+		Bool("state", plan.state).
+		Msg("mismatches are repaired")
+	return nil
+}
+
+func (a *Auditor) addressId(ctx context.Context, tx storage.Transaction, hash string) (uint64, error) {
+	id, err := tx.GetAddressId(ctx, hash)
+	if err != nil {
+		return 0, errors.Wrap(err, hash)
+	}
+	return id, nil
+}
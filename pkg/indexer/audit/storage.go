@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package audit
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+func (a *Auditor) checkState(ctx context.Context, state storage.State, report *Report, plan *repairPlan) error {
+	expected, err := a.audit.ExpectedState(ctx, a.indexerName)
+	if err != nil {
+		return errors.Wrap(err, "expected state")
+	}
+
+	fields := []struct {
+		name     string
+		indexed  string
+		expected string
+	}{
+		{"total_tx", strconv.FormatInt(state.TotalTx, 10), strconv.FormatInt(expected.TotalTx, 10)},
+		{"total_supply", state.TotalSupply.String(), expected.TotalSupply.String()},
+		{"total_accounts", strconv.FormatInt(state.TotalAccounts, 10), strconv.FormatInt(expected.TotalAccounts, 10)},
+		{"total_rollups", strconv.FormatInt(state.TotalRollups, 10), strconv.FormatInt(expected.TotalRollups, 10)},
+		{"total_bridges", strconv.FormatInt(state.TotalBridges, 10), strconv.FormatInt(expected.TotalBridges, 10)},
+		{"total_bytes", strconv.FormatInt(state.TotalBytes, 10), strconv.FormatInt(expected.TotalBytes, 10)},
+	}
+
+	for _, f := range fields {
+		if f.indexed == f.expected {
+			continue
+		}
+		report.Mismatches = append(report.Mismatches, Mismatch{
+			Entity:   "state",
+			Key:      state.Name,
+			Field:    f.name,
+			Source:   SourceStorage,
+			Indexed:  f.indexed,
+			Expected: f.expected,
+		})
+		plan.state = true
+	}
+	return nil
+}
+
+func (a *Auditor) checkRollups(ctx context.Context, _ storage.State, report *Report, plan *repairPlan) error {
+	rollups, err := a.audit.RollupMismatches(ctx, a.cfg.Limit)
+	if err != nil {
+		return errors.Wrap(err, "rollup mismatches")
+	}
+
+	for i := range rollups {
+		key := hex.EncodeToString(rollups[i].AstriaId)
+		report.Mismatches = appendInt(report.Mismatches, "rollup", key, "actions_count", rollups[i].ActionsCount, rollups[i].ExpectedActionsCount)
+		report.Mismatches = appendInt(report.Mismatches, "rollup", key, "size", rollups[i].Size, rollups[i].ExpectedSize)
+		report.Mismatches = appendInt(report.Mismatches, "rollup", key, "bridge_count", rollups[i].BridgeCount, rollups[i].ExpectedBridgeCount)
+		plan.rollups = append(plan.rollups, rollups[i].Id)
+	}
+	return nil
+}
+
+func (a *Auditor) checkAddresses(ctx context.Context, _ storage.State, report *Report, plan *repairPlan) error {
+	addresses, err := a.audit.AddressMismatches(ctx, a.cfg.Limit)
+	if err != nil {
+		return errors.Wrap(err, "address mismatches")
+	}
+
+	for i := range addresses {
+		key := addresses[i].Hash
+		report.Mismatches = appendInt(report.Mismatches, "address", key, "actions_count", addresses[i].ActionsCount, addresses[i].ExpectedActionsCount)
+		report.Mismatches = appendInt(report.Mismatches, "address", key, "signed_tx_count", addresses[i].SignedTxCount, addresses[i].ExpectedSignedTxCount)
+		report.Mismatches = appendInt(report.Mismatches, "address", key, "nonce", int64(addresses[i].Nonce), int64(addresses[i].ExpectedNonce))
+		plan.addresses = append(plan.addresses, addresses[i].Id)
+	}
+	return nil
+}
+
+func (a *Auditor) checkBalances(ctx context.Context, _ storage.State, report *Report, plan *repairPlan) error {
+	balances, err := a.audit.BalanceMismatches(ctx, a.cfg.Limit)
+	if err != nil {
+		return errors.Wrap(err, "balance mismatches")
+	}
+
+	for i := range balances {
+		report.Mismatches = append(report.Mismatches, Mismatch{
+			Entity:   "balance",
+			Key:      balanceKeyString(balances[i].Hash, balances[i].Currency),
+			Field:    "total",
+			Source:   SourceStorage,
+			Indexed:  balances[i].Total.String(),
+			Expected: balances[i].Expected.String(),
+		})
+		plan.setBalance(balances[i].AddressId, balances[i].Currency, balances[i].Total, balances[i].Expected)
+	}
+	return nil
+}
+
+func appendInt(mismatches []Mismatch, entity, key, field string, indexed, expected int64) []Mismatch {
+	if indexed == expected {
+		return mismatches
+	}
+	return append(mismatches, Mismatch{
+		Entity:   entity,
+		Key:      key,
+		Field:    field,
+		Source:   SourceStorage,
+		Indexed:  strconv.FormatInt(indexed, 10),
+		Expected: strconv.FormatInt(expected, 10),
+	})
+}
+
+func balanceKeyString(address, currency string) string {
+	return address + ":" + currency
+}
@@ -71,7 +71,7 @@ func parseActions(height types.Level, blockTime time.Time, from string, tx *Deco
 
 		case *astria.Action_FeeAssetChange:
 			tx.ActionTypes.Set(storageTypes.ActionTypeFeeAssetChangeBits)
-			err = parseFeeAssetChange(val, &actions[i])
+			err = parseFeeAssetChange(val, ctx, &actions[i])
 
 		case *astria.Action_IbcRelayerChange:
 			tx.ActionTypes.Set(storageTypes.ActionTypeIbcRelayerChangeBits)
@@ -126,6 +126,7 @@ func parseActions(height types.Level, blockTime time.Time, from string, tx *Deco
 		if actionFee, ok := ctx.Fees[int64(i)]; ok {
 			actionFee.Height = height
 			actionFee.Time = blockTime
+			actionFee.ActionType = actions[i].Type
 			actionFee.Payer = &storage.Address{
 				Hash: from,
 			}
@@ -180,6 +181,20 @@ func parseIbcAction(body *astria.Action_Ibc, ctx *Context, action *storage.Actio
 	return nil
 }
 
+// withdrawalMemo - memo of ICS20 withdrawal from rollup
+type withdrawalMemo struct {
+	RollupReturnAddress string `json:"rollupReturnAddress"`
+}
+
+// rollupReturnAddress - returns rollup-side sender of ICS20 withdrawal from rollup. It returns empty string if memo is not a withdrawal from rollup.
+func rollupReturnAddress(memo string) string {
+	var m withdrawalMemo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return ""
+	}
+	return m.RollupReturnAddress
+}
+
 func parseIcs20Withdrawal(body *astria.Action_Ics20Withdrawal, from string, height types.Level, ctx *Context, action *storage.Action) error {
 	action.Type = storageTypes.ActionTypeIcs20Withdrawal
 	action.Data = make(map[string]any)
@@ -198,6 +213,7 @@ func parseIcs20Withdrawal(body *astria.Action_Ics20Withdrawal, from string, heig
 
 		if memo := body.Ics20Withdrawal.GetMemo(); memo != "" {
 			action.Data["memo"] = memo
+			action.RollupReturnAddress = rollupReturnAddress(memo)
 		}
 
 		if th := body.Ics20Withdrawal.GetTimeoutHeight(); th != nil {
@@ -344,7 +360,7 @@ func parseSudoAddressChangeAction(body *astria.Action_SudoAddressChange, ctx *Co
 			Height:     action.Height,
 			ActionType: action.Type,
 		})
-		ctx.AddGenericConstant("authority_sudo_address", address)
+		ctx.AddGenericConstant("authority_sudo_address", address, action)
 	}
 	return nil
 }
@@ -780,16 +796,18 @@ func parseBridgeUnlock(body *astria.Action_BridgeUnlock, from string, height typ
 	return nil
 }
 
-func parseFeeAssetChange(body *astria.Action_FeeAssetChange, action *storage.Action) error {
+func parseFeeAssetChange(body *astria.Action_FeeAssetChange, ctx *Context, action *storage.Action) error {
 	action.Type = storageTypes.ActionTypeFeeAssetChange
 	action.Data = make(map[string]any)
 	if body.FeeAssetChange != nil {
 		if addition := body.FeeAssetChange.GetAddition(); len(addition) > 0 {
 			action.Data["addition"] = addition
+			ctx.AddGenericConstant(storage.AllowedFeeAssetConstant(addition), "true", action)
 		}
 
 		if removal := body.FeeAssetChange.GetRemoval(); len(removal) > 0 {
 			action.Data["removal"] = removal
+			ctx.AddGenericConstant(storage.AllowedFeeAssetConstant(removal), "false", action)
 		}
 	}
 	return nil
@@ -802,58 +820,58 @@ func parseFeeChange(body *astria.Action_FeeChange, ctx *Context, action *storage
 		switch t := body.FeeChange.GetFeeComponents().(type) {
 
 		case *astria.FeeChange_BridgeLock:
-			processFeeComponent(storageTypes.ActionTypeBridgeLock.String(), t.BridgeLock.GetMultiplier(), t.BridgeLock.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeBridgeLock.String(), t.BridgeLock.GetMultiplier(), t.BridgeLock.GetBase(), action, ctx)
 
 		case *astria.FeeChange_BridgeSudoChange:
-			processFeeComponent("bridge_sudo_change", t.BridgeSudoChange.GetMultiplier(), t.BridgeSudoChange.GetBase(), action.Data, ctx)
+			processFeeComponent("bridge_sudo_change", t.BridgeSudoChange.GetMultiplier(), t.BridgeSudoChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_BridgeUnlock:
-			processFeeComponent(storageTypes.ActionTypeBridgeUnlock.String(), t.BridgeUnlock.GetMultiplier(), t.BridgeUnlock.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeBridgeUnlock.String(), t.BridgeUnlock.GetMultiplier(), t.BridgeUnlock.GetBase(), action, ctx)
 
 		case *astria.FeeChange_FeeAssetChange:
-			processFeeComponent(storageTypes.ActionTypeFeeAssetChange.String(), t.FeeAssetChange.GetMultiplier(), t.FeeAssetChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeFeeAssetChange.String(), t.FeeAssetChange.GetMultiplier(), t.FeeAssetChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_FeeChange:
-			processFeeComponent(storageTypes.ActionTypeFeeChange.String(), t.FeeChange.GetMultiplier(), t.FeeChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeFeeChange.String(), t.FeeChange.GetMultiplier(), t.FeeChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_IbcRelay:
-			processFeeComponent(storageTypes.ActionTypeIbcRelay.String(), t.IbcRelay.GetMultiplier(), t.IbcRelay.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeIbcRelay.String(), t.IbcRelay.GetMultiplier(), t.IbcRelay.GetBase(), action, ctx)
 
 		case *astria.FeeChange_IbcRelayerChange:
-			processFeeComponent(storageTypes.ActionTypeIbcRelayerChange.String(), t.IbcRelayerChange.GetMultiplier(), t.IbcRelayerChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeIbcRelayerChange.String(), t.IbcRelayerChange.GetMultiplier(), t.IbcRelayerChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_IbcSudoChange:
-			processFeeComponent(storageTypes.ActionTypeIbcSudoChangeAction.String(), t.IbcSudoChange.GetMultiplier(), t.IbcSudoChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeIbcSudoChangeAction.String(), t.IbcSudoChange.GetMultiplier(), t.IbcSudoChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_Ics20Withdrawal:
-			processFeeComponent(storageTypes.ActionTypeIcs20Withdrawal.String(), t.Ics20Withdrawal.GetMultiplier(), t.Ics20Withdrawal.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeIcs20Withdrawal.String(), t.Ics20Withdrawal.GetMultiplier(), t.Ics20Withdrawal.GetBase(), action, ctx)
 
 		case *astria.FeeChange_InitBridgeAccount:
-			processFeeComponent(storageTypes.ActionTypeInitBridgeAccount.String(), t.InitBridgeAccount.GetMultiplier(), t.InitBridgeAccount.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeInitBridgeAccount.String(), t.InitBridgeAccount.GetMultiplier(), t.InitBridgeAccount.GetBase(), action, ctx)
 
 		case *astria.FeeChange_RollupDataSubmission:
-			processFeeComponent(storageTypes.ActionTypeRollupDataSubmission.String(), t.RollupDataSubmission.GetMultiplier(), t.RollupDataSubmission.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeRollupDataSubmission.String(), t.RollupDataSubmission.GetMultiplier(), t.RollupDataSubmission.GetBase(), action, ctx)
 
 		case *astria.FeeChange_SudoAddressChange:
-			processFeeComponent(storageTypes.ActionTypeSudoAddressChange.String(), t.SudoAddressChange.GetMultiplier(), t.SudoAddressChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeSudoAddressChange.String(), t.SudoAddressChange.GetMultiplier(), t.SudoAddressChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_Transfer:
-			processFeeComponent(storageTypes.ActionTypeTransfer.String(), t.Transfer.GetMultiplier(), t.Transfer.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeTransfer.String(), t.Transfer.GetMultiplier(), t.Transfer.GetBase(), action, ctx)
 
 		case *astria.FeeChange_ValidatorUpdate:
-			processFeeComponent(storageTypes.ActionTypeValidatorUpdate.String(), t.ValidatorUpdate.GetMultiplier(), t.ValidatorUpdate.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeValidatorUpdate.String(), t.ValidatorUpdate.GetMultiplier(), t.ValidatorUpdate.GetBase(), action, ctx)
 
 		case *astria.FeeChange_BridgeTransfer:
-			processFeeComponent(storageTypes.ActionTypeBridgeTransfer.String(), t.BridgeTransfer.GetMultiplier(), t.BridgeTransfer.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeBridgeTransfer.String(), t.BridgeTransfer.GetMultiplier(), t.BridgeTransfer.GetBase(), action, ctx)
 
 		case *astria.FeeChange_RecoverIbcClient:
-			processFeeComponent(storageTypes.ActionTypeRecoverIbcClient.String(), t.RecoverIbcClient.GetMultiplier(), t.RecoverIbcClient.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeRecoverIbcClient.String(), t.RecoverIbcClient.GetMultiplier(), t.RecoverIbcClient.GetBase(), action, ctx)
 
 		case *astria.FeeChange_CurrencyPairsChange:
-			processFeeComponent(storageTypes.ActionTypeCurrencyPairsChange.String(), t.CurrencyPairsChange.GetMultiplier(), t.CurrencyPairsChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeCurrencyPairsChange.String(), t.CurrencyPairsChange.GetMultiplier(), t.CurrencyPairsChange.GetBase(), action, ctx)
 
 		case *astria.FeeChange_MarketsChange:
-			processFeeComponent(storageTypes.ActionTypeMarketsChange.String(), t.MarketsChange.GetMultiplier(), t.MarketsChange.GetBase(), action.Data, ctx)
+			processFeeComponent(storageTypes.ActionTypeMarketsChange.String(), t.MarketsChange.GetMultiplier(), t.MarketsChange.GetBase(), action, ctx)
 		}
 	}
 	return nil
@@ -875,22 +893,22 @@ func parseIbcSudoChangeAction(body *astria.Action_IbcSudoChange, ctx *Context, a
 			ActionType: action.Type,
 		})
 
-		ctx.AddGenericConstant("ibc_sudo_address", address)
+		ctx.AddGenericConstant("ibc_sudo_address", address, action)
 	}
 
 	return nil
 }
 
-func processFeeComponent(name string, multiplier, base *primitive.Uint128, data map[string]any, ctx *Context) {
+func processFeeComponent(name string, multiplier, base *primitive.Uint128, action *storage.Action, ctx *Context) {
 	m := uint128ToString(multiplier)
 	mKey := fmt.Sprintf("%s_multiplier", name)
-	data[mKey] = m
-	ctx.AddGenericConstant(mKey, m)
+	action.Data[mKey] = m
+	ctx.AddGenericConstant(mKey, m, action)
 
 	b := uint128ToString(base)
 	bKey := fmt.Sprintf("%s_base", name)
-	data[bKey] = b
-	ctx.AddGenericConstant(bKey, b)
+	action.Data[bKey] = b
+	ctx.AddGenericConstant(bKey, b, action)
 }
 
 func parseBridgeTransfer(body *astria.Action_BridgeTransfer, height types.Level, ctx *Context, action *storage.Action) error {
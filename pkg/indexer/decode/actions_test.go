@@ -657,12 +657,23 @@ func TestDecodeActions(t *testing.T) {
 			},
 		}
 
+		decodeContext := NewContext(map[string]string{}, time.Now())
 		action := storage.Action{
 			Height: 1000,
 		}
-		err := parseFeeAssetChange(message, &action)
+		err := parseFeeAssetChange(message, &decodeContext, &action)
 		require.NoError(t, err)
 		require.Equal(t, wantAction, action)
+
+		constant, ok := decodeContext.Constants[storage.AllowedFeeAssetConstant(assetId)+"-"+types.ModuleNameGeneric.String()]
+		require.True(t, ok)
+		require.Equal(t, "true", constant.Value)
+
+		require.Len(t, decodeContext.ConstantHistory, 1)
+		require.Equal(t, storage.AllowedFeeAssetConstant(assetId), decodeContext.ConstantHistory[0].Name)
+		require.Equal(t, "true", decodeContext.ConstantHistory[0].Value)
+		require.EqualValues(t, 1000, decodeContext.ConstantHistory[0].Height)
+		require.Equal(t, &action, decodeContext.ConstantHistory[0].Action)
 	})
 
 	t.Run("fee asset change: removal", func(t *testing.T) {
@@ -682,12 +693,17 @@ func TestDecodeActions(t *testing.T) {
 			},
 		}
 
+		decodeContext := NewContext(map[string]string{}, time.Now())
 		action := storage.Action{
 			Height: 1000,
 		}
-		err := parseFeeAssetChange(message, &action)
+		err := parseFeeAssetChange(message, &decodeContext, &action)
 		require.NoError(t, err)
 		require.Equal(t, wantAction, action)
+
+		constant, ok := decodeContext.Constants[storage.AllowedFeeAssetConstant(assetId)+"-"+types.ModuleNameGeneric.String()]
+		require.True(t, ok)
+		require.Equal(t, "false", constant.Value)
 	})
 
 	t.Run("bridge lock", func(t *testing.T) {
@@ -1192,6 +1208,11 @@ func TestDecodeActions(t *testing.T) {
 		err := parseFeeChange(message, &decodeContext, &action)
 		require.NoError(t, err)
 		require.Equal(t, wantAction, action)
+
+		require.Len(t, decodeContext.ConstantHistory, 2)
+		require.Equal(t, "rollup_data_submission_multiplier", decodeContext.ConstantHistory[0].Name)
+		require.Equal(t, "rollup_data_submission_base", decodeContext.ConstantHistory[1].Name)
+		require.Equal(t, "10", decodeContext.ConstantHistory[1].Value)
 	})
 
 	t.Run("fee change: bridge_lock", func(t *testing.T) {
@@ -2125,3 +2146,14 @@ func TestDecodeActions(t *testing.T) {
 		require.Equal(t, wantAction, action)
 	})
 }
+
+func TestRollupReturnAddress(t *testing.T) {
+	for memo, want := range map[string]string{
+		`{"rollupBlockNumber":"10","rollupWithdrawalEventId":"0x01","rollupReturnAddress":"0xAbCd","memo":""}`: "0xAbCd",
+		`{"memo":"0xabcd"}`: "",
+		"memo":              "",
+		"":                  "",
+	} {
+		require.Equal(t, want, rollupReturnAddress(memo), memo)
+	}
+}
@@ -25,6 +25,7 @@ type Context struct {
 	DataSize         int64
 	ActionTypes      storageTypes.Bits
 	Constants        map[string]*storage.Constant
+	ConstantHistory  []*storage.ConstantHistory
 	Bridges          map[string]*storage.Bridge
 	Fees             map[int64]*storage.Fee
 	Transfers        []*storage.Transfer
@@ -47,6 +48,7 @@ func NewContext(bridgeAssets map[string]string, blockTime time.Time) Context {
 		SupplyChange:    decimal.Zero,
 		Validators:      NewValidators(),
 		Constants:       make(map[string]*storage.Constant),
+		ConstantHistory: make([]*storage.ConstantHistory, 0),
 		Bridges:         make(map[string]*storage.Bridge),
 		Fees:            make(map[int64]*storage.Fee),
 		Transfers:       make([]*storage.Transfer, 0),
@@ -60,13 +62,21 @@ func NewContext(bridgeAssets map[string]string, blockTime time.Time) Context {
 	}
 }
 
-func (ctx *Context) AddGenericConstant(key, value string) {
+func (ctx *Context) AddGenericConstant(key, value string, action *storage.Action) {
 	k := fmt.Sprintf("%s-%s", key, storageTypes.ModuleNameGeneric)
 	ctx.Constants[k] = &storage.Constant{
 		Module: storageTypes.ModuleNameGeneric,
 		Name:   key,
 		Value:  value,
 	}
+	ctx.ConstantHistory = append(ctx.ConstantHistory, &storage.ConstantHistory{
+		Height: action.Height,
+		Time:   action.Time,
+		Module: storageTypes.ModuleNameGeneric,
+		Name:   key,
+		Value:  value,
+		Action: action,
+	})
 }
 
 func (ctx *Context) ConstantsArray() []*storage.Constant {
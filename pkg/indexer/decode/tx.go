@@ -4,6 +4,8 @@
 package decode
 
 import (
+	"crypto/ed25519"
+
 	astria "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
 	sequencerblockv1 "buf.build/gen/go/astria/sequencerblock-apis/protocolbuffers/go/astria/sequencerblock/v1"
 	"github.com/celenium-io/astria-indexer/internal/storage"
@@ -69,6 +71,45 @@ func Tx(b types.BlockData, index int, ctx *Context) (d DecodedTx, err error) {
 	return
 }
 
+// RawTx - decodes signed transaction which is not included to a block. Fields depending on block and execution result (height, fees, deposits) are not filled.
+func RawTx(raw []byte, ctx *Context) (d DecodedTx, err error) {
+	d.Tx = new(astria.Transaction)
+	if err := proto.Unmarshal(raw, d.Tx); err != nil {
+		return d, errors.Wrap(err, "tx decoding")
+	}
+
+	body := d.Tx.GetBody()
+	if body == nil {
+		return d, errors.New("empty transaction body")
+	}
+
+	d.UnsignedTx = new(astria.TransactionBody)
+	if err := proto.Unmarshal(body.GetValue(), d.UnsignedTx); err != nil {
+		return d, errors.Wrap(err, "tx body decoding")
+	}
+
+	address, err := AddressFromPubKey(d.Tx.GetPublicKey())
+	if err != nil {
+		return d, errors.Wrapf(err, "decode publick key: %x", d.Tx.GetPublicKey())
+	}
+	d.Signer = ctx.Addresses.Set(address, 0, decimal.Zero, "", 0, 1)
+
+	d.Actions, err = parseActions(0, ctx.blockTime, address, &d, ctx)
+	if err != nil {
+		return d, errors.Wrap(err, "parsing actions")
+	}
+	return
+}
+
+// VerifySignature - checks ed25519 signature of the transaction body by the transaction public key
+func VerifySignature(tx *astria.Transaction) bool {
+	publicKey := tx.GetPublicKey()
+	if len(publicKey) != ed25519.PublicKeySize || tx.GetBody() == nil {
+		return false
+	}
+	return ed25519.Verify(publicKey, tx.GetBody().GetValue(), tx.GetSignature())
+}
+
 func isDataItem(b types.BlockData, index int, raw []byte, ctx *Context) (bool, error) {
 	if b.Block.Version.App < 3 {
 		if len(raw) == 32 && index < 2 {
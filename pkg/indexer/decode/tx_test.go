@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package decode
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	primitivev1 "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	astria "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	testsuite "github.com/celenium-io/astria-indexer/internal/test_suite"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestSignedTx(t *testing.T) (*astria.Transaction, ed25519.PublicKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	body, err := proto.Marshal(&astria.TransactionBody{
+		Params: &astria.TransactionParams{
+			Nonce:   10,
+			ChainId: "astria",
+		},
+		Actions: []*astria.Action{
+			{
+				Value: &astria.Action_RollupDataSubmission{
+					RollupDataSubmission: &astria.RollupDataSubmission{
+						RollupId: &primitivev1.RollupId{Inner: testsuite.RandomHash(32)},
+						Data:     testsuite.RandomHash(10),
+						FeeAsset: feeAssetId,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return &astria.Transaction{
+		Body: &anypb.Any{
+			TypeUrl: "/astria.protocol.transaction.v1.TransactionBody",
+			Value:   body,
+		},
+		Signature: ed25519.Sign(privateKey, body),
+		PublicKey: publicKey,
+	}, publicKey
+}
+
+func TestRawTx(t *testing.T) {
+	tx, publicKey := newTestSignedTx(t)
+	raw, err := proto.Marshal(tx)
+	require.NoError(t, err)
+
+	ctx := NewContext(map[string]string{}, time.Now())
+	decoded, err := RawTx(raw, &ctx)
+	require.NoError(t, err)
+
+	signer, err := AddressFromPubKey(publicKey)
+	require.NoError(t, err)
+	require.NotNil(t, decoded.Signer)
+	require.Equal(t, signer, decoded.Signer.Hash)
+	require.EqualValues(t, 10, decoded.UnsignedTx.GetParams().GetNonce())
+	require.Equal(t, "astria", decoded.UnsignedTx.GetParams().GetChainId())
+	require.Len(t, decoded.Actions, 1)
+	require.Equal(t, types.ActionTypeRollupDataSubmission, decoded.Actions[0].Type)
+	require.Equal(t, feeAssetId, decoded.Actions[0].Data["fee_asset"])
+	require.True(t, VerifySignature(decoded.Tx))
+}
+
+func TestRawTxInvalid(t *testing.T) {
+	ctx := NewContext(map[string]string{}, time.Now())
+	_, err := RawTx([]byte{0x01, 0x02, 0x03}, &ctx)
+	require.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	tx, _ := newTestSignedTx(t)
+	require.True(t, VerifySignature(tx))
+
+	tx.Signature[0] ^= 0xff
+	require.False(t, VerifySignature(tx))
+
+	tx.PublicKey = tx.PublicKey[:10]
+	require.False(t, VerifySignature(tx))
+}
+
+func TestParseActionsFeeActionType(t *testing.T) {
+	tx, _ := newTestSignedTx(t)
+	body := new(astria.TransactionBody)
+	require.NoError(t, proto.Unmarshal(tx.GetBody().GetValue(), body))
+
+	ctx := NewContext(map[string]string{}, time.Now())
+	ctx.AddFee(0, &storage.Fee{
+		Asset:  feeAssetId,
+		Amount: decimal.RequireFromString("100"),
+	})
+	decoded := DecodedTx{UnsignedTx: body}
+	actions, err := parseActions(100, time.Now(), testsuite.RandomAddress(), &decoded, &ctx)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.NotNil(t, actions[0].Fee)
+	require.Equal(t, types.ActionTypeRollupDataSubmission, actions[0].Fee.ActionType)
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package indexer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+)
+
+// Change - difference between indexed data and data received by parsing the same block again
+type Change struct {
+	Height types.Level
+	Entity string
+	Key    string
+	Field  string
+	Old    string
+	New    string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("height=%d %s[%s].%s: %s -> %s", c.Height, c.Entity, c.Key, c.Field, c.Old, c.New)
+}
+
+type blockDiff struct {
+	height  types.Level
+	changes []Change
+}
+
+func (d *blockDiff) add(entity, key, field, oldValue, newValue string) {
+	if oldValue == newValue {
+		return
+	}
+	d.changes = append(d.changes, Change{
+		Height: d.height,
+		Entity: entity,
+		Key:    key,
+		Field:  field,
+		Old:    oldValue,
+		New:    newValue,
+	})
+}
+
+func diffBlock(stored storage.Block, txs []storage.Tx, actions []storage.ActionWithTx, parsed *storage.Block) []Change {
+	diff := blockDiff{
+		height:  parsed.Height,
+		changes: make([]Change, 0),
+	}
+	key := parsed.Height.String()
+
+	diff.add("block", key, "hash", stored.Hash.String(), parsed.Hash.String())
+	diff.add("block", key, "action_types", strconv.FormatUint(uint64(stored.ActionTypes), 10), strconv.FormatUint(uint64(parsed.ActionTypes), 10))
+
+	if stored.Stats != nil && parsed.Stats != nil {
+		diff.add("block_stats", key, "tx_count", strconv.FormatInt(stored.Stats.TxCount, 10), strconv.FormatInt(parsed.Stats.TxCount, 10))
+		diff.add("block_stats", key, "supply_change", stored.Stats.SupplyChange.String(), parsed.Stats.SupplyChange.String())
+		diff.add("block_stats", key, "bytes_in_block", strconv.FormatInt(stored.Stats.BytesInBlock, 10), strconv.FormatInt(parsed.Stats.BytesInBlock, 10))
+		diff.add("block_stats", key, "data_size", strconv.FormatInt(stored.Stats.DataSize, 10), strconv.FormatInt(parsed.Stats.DataSize, 10))
+	}
+
+	storedTxs := make(map[string]storage.Tx, len(txs))
+	for i := range txs {
+		storedTxs[hex.EncodeToString(txs[i].Hash)] = txs[i]
+	}
+
+	storedActions := make(map[string]storage.ActionWithTx, len(actions))
+	for i := range actions {
+		if actions[i].Tx == nil {
+			continue
+		}
+		storedActions[actionKey(actions[i].Tx.Hash, actions[i].Position)] = actions[i]
+	}
+
+	for _, tx := range parsed.Txs {
+		txHash := hex.EncodeToString(tx.Hash)
+		old, ok := storedTxs[txHash]
+		if !ok {
+			diff.add("tx", txHash, "exists", "false", "true")
+			continue
+		}
+		delete(storedTxs, txHash)
+
+		diff.add("tx", txHash, "position", strconv.FormatInt(old.Position, 10), strconv.FormatInt(tx.Position, 10))
+		diff.add("tx", txHash, "status", old.Status.String(), tx.Status.String())
+		diff.add("tx", txHash, "actions_count", strconv.FormatInt(old.ActionsCount, 10), strconv.FormatInt(tx.ActionsCount, 10))
+		diff.add("tx", txHash, "action_types", strconv.FormatUint(uint64(old.ActionTypes), 10), strconv.FormatUint(uint64(tx.ActionTypes), 10))
+
+		for j := range tx.Actions {
+			key := actionKey(tx.Hash, tx.Actions[j].Position)
+			oldAction, ok := storedActions[key]
+			if !ok {
+				diff.add("action", key, "exists", "false", "true")
+				continue
+			}
+			delete(storedActions, key)
+
+			diff.add("action", key, "type", oldAction.Type.String(), tx.Actions[j].Type.String())
+			diff.add("action", key, "data", canonicalJson(oldAction.Data), canonicalJson(tx.Actions[j].Data))
+		}
+	}
+
+	for _, hash := range slices.Sorted(maps.Keys(storedTxs)) {
+		diff.add("tx", hash, "exists", "true", "false")
+	}
+	for _, key := range slices.Sorted(maps.Keys(storedActions)) {
+		diff.add("action", key, "exists", "true", "false")
+	}
+
+	return diff.changes
+}
+
+func actionKey(txHash []byte, position int64) string {
+	return fmt.Sprintf("%x:%d", txHash, position)
+}
+
+func canonicalJson(data map[string]any) string {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err.Error()
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var normalized any
+	if err := decoder.Decode(&normalized); err != nil {
+		return err.Error()
+	}
+
+	result, err := json.Marshal(normalized)
+	if err != nil {
+		return err.Error()
+	}
+	return string(result)
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	storageTypes "github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_diffBlock(t *testing.T) {
+	txHash := []byte{0x01, 0x02}
+
+	stored := storage.Block{
+		Height: 100,
+		Hash:   []byte{0xaa},
+		Stats: &storage.BlockStats{
+			TxCount:      1,
+			SupplyChange: decimal.Zero,
+			DataSize:     4,
+		},
+	}
+	txs := []storage.Tx{
+		{
+			Hash:         txHash,
+			Status:       storageTypes.StatusSuccess,
+			ActionsCount: 2,
+		},
+	}
+	actions := []storage.ActionWithTx{
+		{
+			Action: storage.Action{
+				Position: 0,
+				Type:     storageTypes.ActionTypeTransfer,
+				Data: map[string]any{
+					"amount": "100",
+					"to":     "astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p",
+				},
+			},
+			Tx: &storage.Tx{Hash: txHash},
+		}, {
+			Action: storage.Action{
+				Position: 1,
+				Type:     storageTypes.ActionTypeRollupDataSubmission,
+				Data: map[string]any{
+					"data": "AAAAAA==",
+				},
+			},
+			Tx: &storage.Tx{Hash: txHash},
+		},
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		parsed := &storage.Block{
+			Height: 100,
+			Hash:   []byte{0xaa},
+			Stats: &storage.BlockStats{
+				TxCount:      1,
+				SupplyChange: decimal.Zero,
+				DataSize:     4,
+			},
+			Txs: []*storage.Tx{
+				{
+					Hash:         txHash,
+					Status:       storageTypes.StatusSuccess,
+					ActionsCount: 2,
+					Actions: []storage.Action{
+						actions[0].Action,
+						actions[1].Action,
+					},
+				},
+			},
+		}
+
+		changes := diffBlock(stored, txs, actions, parsed)
+		require.Len(t, changes, 0)
+	})
+
+	t.Run("changed action data and lost action", func(t *testing.T) {
+		parsed := &storage.Block{
+			Height: 100,
+			Hash:   []byte{0xaa},
+			Stats: &storage.BlockStats{
+				TxCount:      1,
+				SupplyChange: decimal.Zero,
+				DataSize:     0,
+			},
+			Txs: []*storage.Tx{
+				{
+					Hash:         txHash,
+					Status:       storageTypes.StatusSuccess,
+					ActionsCount: 1,
+					Actions: []storage.Action{
+						{
+							Position: 0,
+							Type:     storageTypes.ActionTypeTransfer,
+							Data: map[string]any{
+								"amount": "200",
+								"to":     "astria1lm45urgugesyhaymn68xww0m6g49zreqa32w7p",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		changes := diffBlock(stored, txs, actions, parsed)
+		require.Len(t, changes, 4)
+
+		require.Equal(t, "block_stats", changes[0].Entity)
+		require.Equal(t, "data_size", changes[0].Field)
+		require.Equal(t, "4", changes[0].Old)
+		require.Equal(t, "0", changes[0].New)
+
+		require.Equal(t, "tx", changes[1].Entity)
+		require.Equal(t, "actions_count", changes[1].Field)
+
+		require.Equal(t, "action", changes[2].Entity)
+		require.Equal(t, "0102:0", changes[2].Key)
+		require.Equal(t, "data", changes[2].Field)
+
+		require.Equal(t, "action", changes[3].Entity)
+		require.Equal(t, "0102:1", changes[3].Key)
+		require.Equal(t, "exists", changes[3].Field)
+		require.Equal(t, "true", changes[3].Old)
+		require.Equal(t, "false", changes[3].New)
+	})
+}
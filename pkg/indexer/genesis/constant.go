@@ -74,6 +74,14 @@ func (module *Module) parseConstants(appState nodeTypes.AppState, consensus pkgT
 		Value:  appState.IbcSudoAddress.Value,
 	})
 
+	for i := range appState.AllowedFeeAssets {
+		data.constants = append(data.constants, storage.Constant{
+			Module: storageTypes.ModuleNameGeneric,
+			Name:   storage.AllowedFeeAssetConstant(appState.AllowedFeeAssets[i]),
+			Value:  "true",
+		})
+	}
+
 	data.constants = append(data.constants, storage.Constant{
 		Module: storageTypes.ModuleNameGeneric,
 		Name:   "bridge_lock_base",
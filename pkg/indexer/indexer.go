@@ -39,7 +39,11 @@ type Indexer struct {
 	stopper  modules.Module
 	log      zerolog.Logger
 
+	tx      sdk.Transactable
 	states  internalStorage.IState
+	blocks  internalStorage.IBlock
+	txs     internalStorage.ITx
+	actions internalStorage.IAction
 	bridges internalStorage.IBridge
 }
 
@@ -55,7 +59,7 @@ func New(cfg *config.Config, pg *sdkPg.Storage, stopperModule modules.Module) (I
 		return Indexer{}, errors.Wrap(err, "while creating receiver module")
 	}
 
-	rb, err := createRollback(r, pg.Transactable, states, blocks, &api, cfg.Indexer)
+	rb, err := createRollback(r, pg.Transactable, states, blocks, &api, notificator, cfg.Indexer)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating rollback module")
 	}
@@ -90,7 +94,11 @@ func New(cfg *config.Config, pg *sdkPg.Storage, stopperModule modules.Module) (I
 		genesis:  genesisModule,
 		stopper:  stopperModule,
 		log:      log.With().Str("module", "indexer").Logger(),
+		tx:       pg.Transactable,
 		states:   states,
+		blocks:   blocks,
+		txs:      postgres.NewTx(pg),
+		actions:  postgres.NewAction(pg),
 		bridges:  bridges,
 	}, nil
 }
@@ -144,8 +152,8 @@ func createReceiver(cfg *config.Config) (rpc.API, *receiver.Module, error) {
 	return api, &receiverModule, nil
 }
 
-func createRollback(receiverModule modules.Module, tx sdk.Transactable, states internalStorage.IState, blocks internalStorage.IBlock, api node.Api, cfg config.Indexer) (*rollback.Module, error) {
-	rollbackModule := rollback.NewModule(tx, states, blocks, api, cfg)
+func createRollback(receiverModule modules.Module, tx sdk.Transactable, states internalStorage.IState, blocks internalStorage.IBlock, api node.Api, notificator internalStorage.Notificator, cfg config.Indexer) (*rollback.Module, error) {
+	rollbackModule := rollback.NewModule(tx, states, blocks, api, notificator, cfg)
 
 	// rollback <- listen signal -- receiver
 	if err := rollbackModule.AttachTo(receiverModule, receiver.RollbackOutput, rollback.InputName); err != nil {
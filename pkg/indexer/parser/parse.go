@@ -18,6 +18,18 @@ import (
 )
 
 func (p *Module) parse(ctx context.Context, b types.BlockData) error {
+	block, err := p.Parse(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	output := p.MustOutput(OutputName)
+	output.Push(block)
+	return nil
+}
+
+// Parse - decodes block data received from the node to the storage model without pushing it to the output
+func (p *Module) Parse(ctx context.Context, b types.BlockData) (*storage.Block, error) {
 	start := time.Now()
 	p.Log.Info().
 		Int64("height", b.Block.Height).
@@ -25,24 +37,24 @@ func (p *Module) parse(ctx context.Context, b types.BlockData) error {
 
 	sudoAddress, err := p.constants.Get(ctx, storageTypes.ModuleNameGeneric, "authority_sudo_address")
 	if err != nil {
-		return errors.Wrap(err, "getting sudo address")
+		return nil, errors.Wrap(err, "getting sudo address")
 	}
 
 	proposer, err := astria.EncodeFromHex(b.Block.ProposerAddress.String())
 	if err != nil {
-		return errors.Wrap(err, "decoding block proposer address")
+		return nil, errors.Wrap(err, "decoding block proposer address")
 	}
 
 	decodeCtx := decode.NewContext(p.bridgeAssets, b.Block.Time)
 	decodeCtx.SudoAddress = sudoAddress.Value
 
 	if err := parseEvents(ctx, b.FinalizeBlockEvents, b.Height, &decodeCtx, p.api); err != nil {
-		return errors.Wrap(err, "parse finalize events")
+		return nil, errors.Wrap(err, "parse finalize events")
 	}
 
 	txs, err := parseTxs(ctx, b, &decodeCtx, p.api)
 	if err != nil {
-		return errors.Wrapf(err, "while parsing block on level=%d", b.Height)
+		return nil, errors.Wrapf(err, "while parsing block on level=%d", b.Height)
 	}
 
 	block := &storage.Block{
@@ -63,15 +75,16 @@ func (p *Module) parse(ctx context.Context, b types.BlockData) error {
 		EvidenceHash:       b.Block.EvidenceHash,
 		ProposerAddress:    proposer,
 
-		ChainId:       b.Block.ChainID,
-		Addresses:     decodeCtx.Addresses,
-		Rollups:       decodeCtx.Rollups,
-		RollupAddress: decodeCtx.RollupAddress,
-		Validators:    decodeCtx.Validators,
-		ActionTypes:   decodeCtx.ActionTypes,
-		Constants:     decodeCtx.ConstantsArray(),
-		Bridges:       decodeCtx.BridgesArray(),
-		Transfers:     decodeCtx.Transfers,
+		ChainId:         b.Block.ChainID,
+		Addresses:       decodeCtx.Addresses,
+		Rollups:         decodeCtx.Rollups,
+		RollupAddress:   decodeCtx.RollupAddress,
+		Validators:      decodeCtx.Validators,
+		ActionTypes:     decodeCtx.ActionTypes,
+		Constants:       decodeCtx.ConstantsArray(),
+		ConstantHistory: decodeCtx.ConstantHistory,
+		Bridges:         decodeCtx.BridgesArray(),
+		Transfers:       decodeCtx.Transfers,
 
 		Txs: txs,
 		Stats: &storage.BlockStats{
@@ -94,9 +107,7 @@ func (p *Module) parse(ctx context.Context, b types.BlockData) error {
 		Int64("ms", time.Since(start).Milliseconds()).
 		Msg("block parsed")
 
-	output := p.MustOutput(OutputName)
-	output.Push(block)
-	return nil
+	return block, nil
 }
 
 func (p *Module) parseBlockSignatures(commit *types.Commit) []storage.BlockSignature {
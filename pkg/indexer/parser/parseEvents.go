@@ -95,8 +95,6 @@ func parseTxFees(ctx context.Context, attrs []types.EventAttribute, decodeCtx *d
 			if err != nil {
 				return err
 			}
-		case "actionName":
-			fee.ActionType = attrs[i].Value
 		case "positionInTransaction":
 			actionIndex, err := strconv.ParseInt(attrs[i].Value, 10, 64)
 			if err != nil {
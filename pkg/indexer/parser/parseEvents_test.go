@@ -90,7 +90,6 @@ func Test_parseTxFee(t *testing.T) {
 		require.True(t, ok)
 		require.EqualValues(t, "321", fee.Amount.String())
 		require.EqualValues(t, "nria", fee.Asset)
-		require.EqualValues(t, "astria.protocol.transaction.v1.RollupDataSubmission", fee.ActionType)
 	})
 }
 
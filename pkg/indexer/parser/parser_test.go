@@ -78,6 +78,7 @@ func getExpectedBlock() storage.Block {
 		Validators:      make(map[string]*storage.Validator),
 		BlockSignatures: []storage.BlockSignature{},
 		Constants:       make([]*storage.Constant, 0),
+		ConstantHistory: make([]*storage.ConstantHistory, 0),
 		Bridges:         make([]*storage.Bridge, 0),
 		Transfers:       make([]*storage.Transfer, 0),
 		Prices:          make([]storage.Price, 0),
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package indexer
+
+import (
+	"context"
+	"time"
+
+	internalStorage "github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/postgres"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const reindexPageSize = 100
+
+// Reindex - rolls back indexed data from the head down to `from` using rollback logic, parses and saves blocks from `from` to the head again and recalculates denormalized counters of affected addresses and rollups.
+// It is destructive: all data above `from - 1` is removed before blocks are indexed again. State totals are kept consistent incrementally: rollback subtracts the rolled-back blocks and saving adds the re-parsed ones.
+func (i *Indexer) Reindex(ctx context.Context, from types.Level) error {
+	var to types.Level
+	state, err := i.reindexRange(ctx, from, &to)
+	if err != nil {
+		return err
+	}
+
+	i.log.Info().
+		Uint64("from", uint64(from)).
+		Uint64("head", uint64(state.LastHeight)).
+		Msg("rolling back indexed data...")
+
+	if err := i.rollback.RollbackTo(ctx, from-1); err != nil {
+		return errors.Wrap(err, "rollback")
+	}
+
+	assets, err := makeBridgeAssetsMap(ctx, i.bridges)
+	if err != nil {
+		return errors.Wrap(err, "make bridge asset map")
+	}
+	i.parser.Init(ctx, assets)
+
+	for height := from; height <= to; height++ {
+		block, err := i.parseBlock(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		if _, err := i.storage.SaveBlock(ctx, block); err != nil {
+			return errors.Wrapf(err, "save block %d", height)
+		}
+	}
+
+	if err := i.recalculate(ctx, from); err != nil {
+		return errors.Wrap(err, "recalculate counters")
+	}
+
+	i.log.Info().
+		Uint64("from", uint64(from)).
+		Uint64("to", uint64(to)).
+		Msg("reindex is finished")
+	return nil
+}
+
+// ReindexDiff - parses blocks from `from` to `to` and compares them with indexed data. Database is not changed.
+func (i *Indexer) ReindexDiff(ctx context.Context, from, to types.Level) ([]Change, error) {
+	if _, err := i.reindexRange(ctx, from, &to); err != nil {
+		return nil, err
+	}
+
+	assets, err := makeBridgeAssetsMap(ctx, i.bridges)
+	if err != nil {
+		return nil, errors.Wrap(err, "make bridge asset map")
+	}
+	i.parser.Init(ctx, assets)
+
+	changes := make([]Change, 0)
+	for height := from; height <= to; height++ {
+		parsed, err := i.parseBlock(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+
+		stored, err := i.blocks.ByHeight(ctx, height, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "receive block %d", height)
+		}
+
+		txs, err := i.storedTxs(ctx, height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "receive txs of block %d", height)
+		}
+
+		actions, err := i.storedActions(ctx, height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "receive actions of block %d", height)
+		}
+
+		changes = append(changes, diffBlock(stored, txs, actions, parsed)...)
+	}
+	return changes, nil
+}
+
+func (i *Indexer) reindexRange(ctx context.Context, from types.Level, to *types.Level) (*internalStorage.State, error) {
+	state, err := loadState(ctx, i.states, i.cfg.Indexer.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "load state")
+	}
+	if state == nil {
+		return nil, errors.New("indexer state is not found: nothing to reindex")
+	}
+
+	if from < 1 {
+		return nil, errors.Errorf("invalid start height: %d. Genesis can't be reindexed", from)
+	}
+	if *to == 0 {
+		*to = state.LastHeight
+	}
+	if *to < from {
+		return nil, errors.Errorf("invalid range: %d > %d", from, *to)
+	}
+	if *to > state.LastHeight {
+		return nil, errors.Errorf("end of range %d is above indexed head %d", *to, state.LastHeight)
+	}
+	return state, nil
+}
+
+func (i *Indexer) parseBlock(ctx context.Context, height types.Level) (*internalStorage.Block, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	data, err := i.api.BlockData(requestCtx, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "receive block data %d", height)
+	}
+
+	block, err := i.parser.Parse(ctx, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse block %d", height)
+	}
+	return block, nil
+}
+
+func (i *Indexer) storedTxs(ctx context.Context, height types.Level) ([]internalStorage.Tx, error) {
+	txs := make([]internalStorage.Tx, 0)
+	for end := false; !end; {
+		data, err := i.txs.ByHeight(ctx, height, reindexPageSize, len(txs))
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, data...)
+		end = len(data) < reindexPageSize
+	}
+	return txs, nil
+}
+
+func (i *Indexer) storedActions(ctx context.Context, height types.Level) ([]internalStorage.ActionWithTx, error) {
+	actions := make([]internalStorage.ActionWithTx, 0)
+	for end := false; !end; {
+		data, err := i.actions.ByBlock(ctx, height, reindexPageSize, len(actions))
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, data...)
+		end = len(data) < reindexPageSize
+	}
+	return actions, nil
+}
+
+func (i *Indexer) recalculate(ctx context.Context, from types.Level) error {
+	tx, err := postgres.BeginTransaction(ctx, i.tx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	if err := tx.RecalculateAddresses(ctx, from); err != nil {
+		return tx.HandleError(ctx, errors.Wrap(err, "addresses"))
+	}
+	if err := tx.RecalculateRollups(ctx, from); err != nil {
+		return tx.HandleError(ctx, errors.Wrap(err, "rollups"))
+	}
+
+	return tx.Flush(ctx)
+}
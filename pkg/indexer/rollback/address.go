@@ -46,12 +46,10 @@ func rollbackAddress(
 	for i := range txs {
 		if addr, ok := addresses[txs[i].SignerId]; ok {
 			addr.SignedTxCount -= 1
-			addr.ActionsCount -= 1
 		} else {
-			addresses[addressActions[i].AddressId] = &storage.Address{
+			addresses[txs[i].SignerId] = &storage.Address{
 				Id:            txs[i].SignerId,
 				SignedTxCount: -1,
-				ActionsCount:  -1,
 			}
 		}
 	}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func rollbackConstants(
+	ctx context.Context,
+	tx storage.Transaction,
+	height types.Level,
+) ([]*storage.Constant, error) {
+	history, err := tx.RollbackConstantHistory(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, nil
+	}
+
+	restore, remove := restoredConstants(history)
+	if err := tx.UpdateConstants(ctx, restore...); err != nil {
+		return nil, errors.Wrap(err, "restore constants")
+	}
+	if err := tx.DeleteConstants(ctx, remove...); err != nil {
+		return nil, errors.Wrap(err, "delete constants")
+	}
+	return restore, nil
+}
+
+// restoredConstants - returns values of constants before the first change in the block. Constants which were created in the block have to be removed.
+func restoredConstants(history []storage.ConstantHistory) (restore []*storage.Constant, remove []*storage.Constant) {
+	first := make(map[string]*storage.ConstantHistory)
+	keys := make([]string, 0)
+	for i := range history {
+		key := history[i].Module.String() + ":" + history[i].Name
+		if item, ok := first[key]; ok && item.Id < history[i].Id {
+			continue
+		} else if !ok {
+			keys = append(keys, key)
+		}
+		first[key] = &history[i]
+	}
+
+	for _, key := range keys {
+		item := first[key]
+		constant := &storage.Constant{
+			Module: item.Module,
+			Name:   item.Name,
+		}
+		if item.PrevValue == nil {
+			remove = append(remove, constant)
+			continue
+		}
+		constant.Value = *item.PrevValue
+		restore = append(restore, constant)
+	}
+	return
+}
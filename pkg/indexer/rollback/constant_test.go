@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"testing"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/celenium-io/astria-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_restoredConstants(t *testing.T) {
+	prevBase := "10"
+	prevMultiplier := "1"
+	intermediate := "20"
+
+	history := []storage.ConstantHistory{
+		{Id: 3, Module: types.ModuleNameGeneric, Name: "transfer_base", Value: "30", PrevValue: &intermediate},
+		{Id: 2, Module: types.ModuleNameGeneric, Name: "transfer_base", Value: "20", PrevValue: &prevBase},
+		{Id: 4, Module: types.ModuleNameGeneric, Name: "transfer_multiplier", Value: "2", PrevValue: &prevMultiplier},
+		{Id: 5, Module: types.ModuleNameGeneric, Name: storage.AllowedFeeAssetConstant("nria"), Value: "true"},
+	}
+
+	restore, remove := restoredConstants(history)
+	require.Len(t, restore, 2)
+	require.Equal(t, "transfer_base", restore[0].Name)
+	require.Equal(t, "10", restore[0].Value)
+	require.Equal(t, "transfer_multiplier", restore[1].Name)
+	require.Equal(t, "1", restore[1].Value)
+
+	require.Len(t, remove, 1)
+	require.Equal(t, storage.AllowedFeeAssetConstant("nria"), remove[0].Name)
+}
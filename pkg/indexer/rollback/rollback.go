@@ -6,6 +6,8 @@ package rollback
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/celenium-io/astria-indexer/pkg/node"
 
@@ -35,11 +37,12 @@ const (
 //	                |----------------|
 type Module struct {
 	modules.BaseModule
-	tx        sdk.Transactable
-	state     storage.IState
-	blocks    storage.IBlock
-	node      node.Api
-	indexName string
+	tx          sdk.Transactable
+	state       storage.IState
+	blocks      storage.IBlock
+	node        node.Api
+	notificator storage.Notificator
+	indexName   string
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -49,15 +52,17 @@ func NewModule(
 	state storage.IState,
 	blocks storage.IBlock,
 	node node.Api,
+	notificator storage.Notificator,
 	cfg config.Indexer,
 ) Module {
 	module := Module{
-		BaseModule: modules.New("rollback"),
-		tx:         tx,
-		state:      state,
-		blocks:     blocks,
-		node:       node,
-		indexName:  cfg.Name,
+		BaseModule:  modules.New("rollback"),
+		tx:          tx,
+		state:       state,
+		blocks:      blocks,
+		node:        node,
+		notificator: notificator,
+		indexName:   cfg.Name,
 	}
 
 	module.CreateInput(InputName)
@@ -102,6 +107,8 @@ func (module *Module) Close() error {
 }
 
 func (module *Module) rollback(ctx context.Context) error {
+	event := new(storage.RollbackEvent)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -123,6 +130,9 @@ func (module *Module) rollback(ctx context.Context) error {
 				Msg("comparing hash...")
 
 			if bytes.Equal(lastBlock.Hash, nodeBlock.BlockID.Hash) {
+				if err := module.notifyEvent(ctx, event); err != nil {
+					return errors.Wrap(err, "notify rollback event")
+				}
 				return module.finish(ctx)
 			}
 
@@ -132,13 +142,77 @@ func (module *Module) rollback(ctx context.Context) error {
 				Hex("node_block_hash", nodeBlock.BlockID.Hash).
 				Msg("need rollback")
 
-			if err := module.rollbackBlock(ctx, lastBlock.Height); err != nil {
+			if event.Depth == 0 {
+				event.ToHeight = lastBlock.Height
+				event.OldHash = lastBlock.Hash
+				event.NewHash = nodeBlock.BlockID.Hash
+			}
+
+			if err := module.rollbackBlock(ctx, lastBlock.Height, event); err != nil {
 				return errors.Wrapf(err, "rollback block: %d", lastBlock.Height)
 			}
 		}
 	}
 }
 
+// RollbackTo - removes indexed blocks one by one starting from the head until the last saved block has height equals to `height`. Unlike signal-based rollback it doesn't compare hashes with the node.
+func (module *Module) RollbackTo(ctx context.Context, height types.Level) error {
+	event := new(storage.RollbackEvent)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastBlock, err := module.blocks.Last(ctx)
+		if err != nil {
+			return errors.Wrap(err, "receive last block from database")
+		}
+
+		if lastBlock.Height <= height {
+			if err := module.notifyEvent(ctx, event); err != nil {
+				return errors.Wrap(err, "notify rollback event")
+			}
+
+			log.Info().
+				Uint64("new_height", uint64(lastBlock.Height)).
+				Msg("roll backed to new height")
+			return nil
+		}
+
+		if event.Depth == 0 {
+			event.ToHeight = lastBlock.Height
+			event.OldHash = lastBlock.Hash
+		}
+
+		if err := module.rollbackBlock(ctx, lastBlock.Height, event); err != nil {
+			return errors.Wrapf(err, "rollback block: %d", lastBlock.Height)
+		}
+	}
+}
+
+// notifyEvent - notifies subscribers about removed blocks. The event is already stored by rollback transactions. Nothing is done if no block was removed.
+func (module *Module) notifyEvent(ctx context.Context, event *storage.RollbackEvent) error {
+	if event.Depth == 0 {
+		return nil
+	}
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "marshal rollback event")
+	}
+	if err := module.notificator.Notify(ctx, storage.ChannelRollback, string(raw)); err != nil {
+		return errors.Wrap(err, "notify")
+	}
+
+	log.Info().
+		Uint64("from_height", uint64(event.FromHeight)).
+		Uint64("to_height", uint64(event.ToHeight)).
+		Int64("depth", event.Depth).
+		Msg("rollback event is sent")
+	return nil
+}
+
 func (module *Module) finish(ctx context.Context) error {
 	newState, err := module.state.ByName(ctx, module.indexName)
 	if err != nil {
@@ -153,91 +227,149 @@ func (module *Module) finish(ctx context.Context) error {
 	return nil
 }
 
-func (module *Module) rollbackBlock(ctx context.Context, height types.Level) error {
+// rollbackResult - counts of entities removed by rollback of the block
+type rollbackResult struct {
+	txs       int64
+	actions   int64
+	addresses int64
+	rollups   int64
+	bridges   int64
+
+	constants []*storage.Constant // restored constants which should be sent to API
+}
+
+func (r rollbackResult) apply(event *storage.RollbackEvent, height types.Level) {
+	event.FromHeight = height
+	event.Depth += 1
+	event.TxCount += r.txs
+	event.ActionsCount += r.actions
+	event.AddressesCount += r.addresses
+	event.RollupsCount += r.rollups
+	event.BridgesCount += r.bridges
+}
+
+// saveEvent - applies result of the block rollback to the event and stores it. The event is created by the first removed block and updated by the next ones.
+func saveEvent(ctx context.Context, tx storage.Transaction, event *storage.RollbackEvent, result rollbackResult, height types.Level) error {
+	updated := *event
+	result.apply(&updated, height)
+	updated.Time = time.Now().UTC()
+
+	if updated.Id == 0 {
+		if err := tx.Add(ctx, &updated); err != nil {
+			return err
+		}
+	} else if err := tx.Update(ctx, &updated); err != nil {
+		return err
+	}
+
+	*event = updated
+	return nil
+}
+
+// rollbackBlock - removes the block and stores the rollback event updated with its result in the same transaction
+func (module *Module) rollbackBlock(ctx context.Context, height types.Level, event *storage.RollbackEvent) error {
 	tx, err := postgres.BeginTransaction(ctx, module.tx)
 	if err != nil {
 		return err
 	}
 	defer tx.Close(ctx)
 
-	if err := rollbackBlock(ctx, tx, height, module.indexName); err != nil {
+	result, err := rollbackBlock(ctx, tx, height, module.indexName, event)
+	if err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
+	for i := range result.constants {
+		raw, err := json.Marshal(result.constants[i])
+		if err != nil {
+			return errors.Wrap(err, "marshal constant")
+		}
+		if err := module.notificator.Notify(ctx, storage.ChannelConstant, string(raw)); err != nil {
+			return errors.Wrap(err, "notify constant")
+		}
+	}
+
 	return nil
 }
 
-func rollbackBlock(ctx context.Context, tx storage.Transaction, height types.Level, indexName string) error {
+func rollbackBlock(ctx context.Context, tx storage.Transaction, height types.Level, indexName string, event *storage.RollbackEvent) (rollbackResult, error) {
+	var result rollbackResult
+
 	if err := tx.RollbackBlock(ctx, height); err != nil {
-		return err
+		return result, err
 	}
 
 	blockStats, err := tx.RollbackBlockStats(ctx, height)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	txs, err := tx.RollbackTxs(ctx, height)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	actions, err := tx.RollbackActions(ctx, height)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	addressActions, err := tx.RollbackAddressActions(ctx, height)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	countDeletedAddresses, err := rollbackAddress(ctx, tx, height, addressActions, txs)
 	if err != nil {
-		return errors.Wrap(err, "address")
+		return result, errors.Wrap(err, "address")
 	}
 
 	countDeletedRollups, err := rollbackRollups(ctx, tx, height, actions)
 	if err != nil {
-		return errors.Wrap(err, "rollups")
+		return result, errors.Wrap(err, "rollups")
 	}
 
 	if err := tx.RollbackValidators(ctx, height); err != nil {
-		return err
+		return result, err
 	}
 
 	if err := tx.RollbackFees(ctx, height); err != nil {
-		return err
+		return result, err
 	}
 
 	if err := tx.RollbackDeposits(ctx, height); err != nil {
-		return err
+		return result, err
 	}
 
 	if err := tx.RollbackTransfers(ctx, height); err != nil {
-		return err
+		return result, err
 	}
 
 	if err := tx.RollbackBlockSignatures(ctx, height); err != nil {
-		return err
+		return result, err
 	}
 
 	deletedBridges, err := tx.RollbackBridges(ctx, height)
 	if err != nil {
-		return errors.Wrap(err, "bridges")
+		return result, errors.Wrap(err, "bridges")
 	}
 
 	if err := tx.RollbackPrices(ctx, height); err != nil {
-		return errors.Wrap(err, "prices")
+		return result, errors.Wrap(err, "prices")
+	}
+
+	constants, err := rollbackConstants(ctx, tx, height)
+	if err != nil {
+		return result, errors.Wrap(err, "constants")
 	}
 
 	newBlock, err := tx.LastBlock(ctx)
 	if err != nil {
-		return err
+		return result, err
 	}
 	state, err := tx.State(ctx, indexName)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	state.LastHeight = newBlock.Height
@@ -248,10 +380,22 @@ func rollbackBlock(ctx context.Context, tx storage.Transaction, height types.Lev
 	state.TotalRollups -= countDeletedRollups
 	state.TotalSupply = state.TotalSupply.Sub(blockStats.SupplyChange)
 	state.TotalBridges -= int64(deletedBridges)
+	state.TotalBytes -= blockStats.DataSize
 
 	if err := tx.Update(ctx, &state); err != nil {
-		return err
+		return result, err
+	}
+
+	result.txs = int64(len(txs))
+	result.actions = int64(len(actions))
+	result.addresses = int64(countDeletedAddresses)
+	result.rollups = countDeletedRollups
+	result.bridges = int64(deletedBridges)
+	result.constants = constants
+
+	if err := saveEvent(ctx, tx, event, result, height); err != nil {
+		return result, errors.Wrap(err, "rollback event")
 	}
 
-	return tx.Flush(ctx)
+	return result, tx.Flush(ctx)
 }
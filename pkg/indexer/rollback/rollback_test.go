@@ -277,6 +277,35 @@ func Test_rollbackBlock(t *testing.T) {
 			Return(nil).
 			Times(1)
 
+		prevValue := "10"
+		tx.EXPECT().
+			RollbackConstantHistory(ctx, height).
+			Return([]storage.ConstantHistory{
+				{
+					Id:        1,
+					Height:    height,
+					Module:    types.ModuleNameGeneric,
+					Name:      "transfer_base",
+					Value:     "12",
+					PrevValue: &prevValue,
+				},
+			}, nil).
+			Times(1)
+
+		tx.EXPECT().
+			UpdateConstants(ctx, &storage.Constant{
+				Module: types.ModuleNameGeneric,
+				Name:   "transfer_base",
+				Value:  "10",
+			}).
+			Return(nil).
+			Times(1)
+
+		tx.EXPECT().
+			DeleteConstants(ctx).
+			Return(nil).
+			Times(1)
+
 		lastBlock := storage.Block{
 			Height:         height - 1,
 			Time:           blockTime.Add(-time.Minute),
@@ -316,13 +345,53 @@ func Test_rollbackBlock(t *testing.T) {
 			MaxTimes(1).
 			MinTimes(1)
 
+		tx.EXPECT().
+			Add(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, model any) error {
+				event, ok := model.(*storage.RollbackEvent)
+				require.True(t, ok)
+				require.EqualValues(t, 1, event.Depth)
+				require.EqualValues(t, height, event.FromHeight)
+				require.EqualValues(t, 1, event.TxCount)
+				event.Id = 1
+				return nil
+			}).
+			MaxTimes(1).
+			MinTimes(1)
+
 		tx.EXPECT().
 			Flush(ctx).
 			Return(nil).
 			MaxTimes(1).
 			MinTimes(1)
 
-		err := rollbackBlock(ctx, tx, height, indexName)
+		event := &storage.RollbackEvent{ToHeight: height}
+		result, err := rollbackBlock(ctx, tx, height, indexName, event)
 		require.NoError(t, err)
+		require.EqualValues(t, 1, result.txs)
+		require.EqualValues(t, 3, result.actions)
+		require.EqualValues(t, 0, result.addresses)
+		require.EqualValues(t, 1, result.rollups)
+		require.EqualValues(t, 0, result.bridges)
+		require.Len(t, result.constants, 1)
+		require.Equal(t, "10", result.constants[0].Value)
+		require.EqualValues(t, 1, event.Id)
+		require.EqualValues(t, 1, event.Depth)
+		require.EqualValues(t, 3, event.ActionsCount)
 	})
 }
+
+func Test_rollbackResultApply(t *testing.T) {
+	event := new(storage.RollbackEvent)
+
+	rollbackResult{txs: 2, actions: 5, addresses: 1}.apply(event, 100)
+	rollbackResult{txs: 1, actions: 1, rollups: 1, bridges: 1}.apply(event, 99)
+
+	require.EqualValues(t, 99, event.FromHeight)
+	require.EqualValues(t, 2, event.Depth)
+	require.EqualValues(t, 3, event.TxCount)
+	require.EqualValues(t, 6, event.ActionsCount)
+	require.EqualValues(t, 1, event.AddressesCount)
+	require.EqualValues(t, 1, event.RollupsCount)
+	require.EqualValues(t, 1, event.BridgesCount)
+}
@@ -70,21 +70,33 @@ func rollbackRollups(
 }
 
 func updateRollups(updates map[uint64]*storage.Rollup, rollupId uint64, action storage.Action) error {
-	if action.Type != storageTypes.ActionTypeRollupDataSubmission {
+	var (
+		size        int64
+		bridgeCount int64
+	)
+	switch action.Type {
+	case storageTypes.ActionTypeRollupDataSubmission:
+		actionSize, err := getActionSize(action)
+		if err != nil {
+			return err
+		}
+		size = actionSize
+	case storageTypes.ActionTypeInitBridgeAccount:
+		bridgeCount = 1
+	default:
 		return errors.Errorf("invalid action type: %s", action.Type)
 	}
-	size, err := getActionSize(action)
-	if err != nil {
-		return err
-	}
+
 	if update, ok := updates[rollupId]; ok {
 		update.ActionsCount -= 1
 		update.Size -= size
+		update.BridgeCount -= bridgeCount
 	} else {
 		updates[rollupId] = &storage.Rollup{
 			Id:           rollupId,
 			Size:         -size,
 			ActionsCount: -1,
+			BridgeCount:  -bridgeCount,
 		}
 	}
 
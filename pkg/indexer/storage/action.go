@@ -59,6 +59,9 @@ func saveAction(
 			} else {
 				return errors.Errorf("unknown payer id: %s", actions[i].Fee.Payer.Hash)
 			}
+			if actions[i].RollupAction != nil {
+				actions[i].Fee.RollupId = actions[i].RollupAction.RollupId
+			}
 			fees = append(fees, actions[i].Fee)
 		}
 
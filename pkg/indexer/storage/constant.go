@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/celenium-io/astria-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// fillPrevConstantValues - sets value before the change to every history item. Changes are ordered as they were applied in the block.
+func fillPrevConstantValues(
+	ctx context.Context,
+	tx storage.Transaction,
+	history []*storage.ConstantHistory,
+) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	keys := make([]*storage.Constant, len(history))
+	for i := range history {
+		keys[i] = &storage.Constant{
+			Module: history[i].Module,
+			Name:   history[i].Name,
+		}
+	}
+
+	current, err := tx.GetConstants(ctx, keys...)
+	if err != nil {
+		return errors.Wrap(err, "receiving current constants")
+	}
+
+	values := make(map[string]string, len(current))
+	for i := range current {
+		values[constantKey(current[i].Module.String(), current[i].Name)] = current[i].Value
+	}
+
+	for i := range history {
+		key := constantKey(history[i].Module.String(), history[i].Name)
+		if value, ok := values[key]; ok {
+			history[i].PrevValue = &value
+		}
+		values[key] = history[i].Value
+	}
+	return nil
+}
+
+func saveConstantHistory(
+	ctx context.Context,
+	tx storage.Transaction,
+	history []*storage.ConstantHistory,
+) error {
+	for i := range history {
+		if history[i].Action != nil {
+			history[i].ActionId = history[i].Action.Id
+		}
+	}
+	return tx.SaveConstantHistory(ctx, history...)
+}
+
+func constantKey(module, name string) string {
+	return module + ":" + name
+}
@@ -85,7 +85,7 @@ func (module *Module) listen(ctx context.Context) {
 				continue
 			}
 
-			state, err := module.saveBlock(ctx, block)
+			state, err := module.SaveBlock(ctx, block)
 			if err != nil {
 				module.Log.Err(err).
 					Uint64("height", uint64(block.Height)).
@@ -108,7 +108,8 @@ func (module *Module) Close() error {
 	return nil
 }
 
-func (module *Module) saveBlock(ctx context.Context, block *storage.Block) (storage.State, error) {
+// SaveBlock - saves block with all its data in a single database transaction and returns the updated state
+func (module *Module) SaveBlock(ctx context.Context, block *storage.Block) (storage.State, error) {
 	start := time.Now()
 	module.Log.Info().Uint64("height", uint64(block.Height)).Msg("saving block...")
 	tx, err := postgres.BeginTransaction(ctx, module.storage)
@@ -163,6 +164,10 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, err
 	}
 
+	if err := fillPrevConstantValues(ctx, tx, block.ConstantHistory); err != nil {
+		return state, err
+	}
+
 	if err := tx.UpdateConstants(ctx, block.Constants...); err != nil {
 		return state, err
 	}
@@ -214,6 +219,10 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return state, err
 	}
 
+	if err := saveConstantHistory(ctx, tx, block.ConstantHistory); err != nil {
+		return state, errors.Wrap(err, "can't save constant history")
+	}
+
 	if err := module.saveBlockSignatures(ctx, tx, block.BlockSignatures, block.Height); err != nil {
 		return state, err
 	}
@@ -21,4 +21,7 @@ type Api interface {
 	BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error)
 	BlockDataGet(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error)
 	GetAssetInfo(ctx context.Context, asset string) (types.DenomMetadataResponse, error)
+	Balances(ctx context.Context, address string, height pkgTypes.Level) (types.AbciQueryResponse, error)
+	Nonce(ctx context.Context, address string, height pkgTypes.Level) (types.AbciQueryResponse, error)
+	BridgeAccountInfo(ctx context.Context, address string, height pkgTypes.Level) (types.AbciQueryResponse, error)
 }
@@ -44,6 +44,45 @@ func (m *MockApi) EXPECT() *MockApiMockRecorder {
 	return m.recorder
 }
 
+// Balances mocks base method.
+func (m *MockApi) Balances(ctx context.Context, address string, height types0.Level) (types.AbciQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balances", ctx, address, height)
+	ret0, _ := ret[0].(types.AbciQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balances indicates an expected call of Balances.
+func (mr *MockApiMockRecorder) Balances(ctx, address, height any) *MockApiBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balances", reflect.TypeOf((*MockApi)(nil).Balances), ctx, address, height)
+	return &MockApiBalancesCall{Call: call}
+}
+
+// MockApiBalancesCall wrap *gomock.Call
+type MockApiBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApiBalancesCall) Return(arg0 types.AbciQueryResponse, arg1 error) *MockApiBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApiBalancesCall) Do(f func(context.Context, string, types0.Level) (types.AbciQueryResponse, error)) *MockApiBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApiBalancesCall) DoAndReturn(f func(context.Context, string, types0.Level) (types.AbciQueryResponse, error)) *MockApiBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Block mocks base method.
 func (m *MockApi) Block(ctx context.Context, level types0.Level) (types0.ResultBlock, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +239,45 @@ func (c *MockApiBlockResultsCall) DoAndReturn(f func(context.Context, types0.Lev
 	return c
 }
 
+// BridgeAccountInfo mocks base method.
+func (m *MockApi) BridgeAccountInfo(ctx context.Context, address string, height types0.Level) (types.AbciQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BridgeAccountInfo", ctx, address, height)
+	ret0, _ := ret[0].(types.AbciQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BridgeAccountInfo indicates an expected call of BridgeAccountInfo.
+func (mr *MockApiMockRecorder) BridgeAccountInfo(ctx, address, height any) *MockApiBridgeAccountInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BridgeAccountInfo", reflect.TypeOf((*MockApi)(nil).BridgeAccountInfo), ctx, address, height)
+	return &MockApiBridgeAccountInfoCall{Call: call}
+}
+
+// MockApiBridgeAccountInfoCall wrap *gomock.Call
+type MockApiBridgeAccountInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApiBridgeAccountInfoCall) Return(arg0 types.AbciQueryResponse, arg1 error) *MockApiBridgeAccountInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApiBridgeAccountInfoCall) Do(f func(context.Context, string, types0.Level) (types.AbciQueryResponse, error)) *MockApiBridgeAccountInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApiBridgeAccountInfoCall) DoAndReturn(f func(context.Context, string, types0.Level) (types.AbciQueryResponse, error)) *MockApiBridgeAccountInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Genesis mocks base method.
 func (m *MockApi) Genesis(ctx context.Context) (types.Genesis, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +395,45 @@ func (c *MockApiHeadCall) DoAndReturn(f func(context.Context) (types0.ResultBloc
 	return c
 }
 
+// Nonce mocks base method.
+func (m *MockApi) Nonce(ctx context.Context, address string, height types0.Level) (types.AbciQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nonce", ctx, address, height)
+	ret0, _ := ret[0].(types.AbciQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Nonce indicates an expected call of Nonce.
+func (mr *MockApiMockRecorder) Nonce(ctx, address, height any) *MockApiNonceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nonce", reflect.TypeOf((*MockApi)(nil).Nonce), ctx, address, height)
+	return &MockApiNonceCall{Call: call}
+}
+
+// MockApiNonceCall wrap *gomock.Call
+type MockApiNonceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApiNonceCall) Return(arg0 types.AbciQueryResponse, arg1 error) *MockApiNonceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApiNonceCall) Do(f func(context.Context, string, types0.Level) (types.AbciQueryResponse, error)) *MockApiNonceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApiNonceCall) DoAndReturn(f func(context.Context, string, types0.Level) (types.AbciQueryResponse, error)) *MockApiNonceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Status mocks base method.
 func (m *MockApi) Status(ctx context.Context) (types.Status, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celenium-io/astria-indexer/pkg/node/types"
+	pkgTypes "github.com/celenium-io/astria-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (api *API) Balances(ctx context.Context, address string, height pkgTypes.Level) (types.AbciQueryResponse, error) {
+	return api.abciQuery(ctx, fmt.Sprintf("accounts/balance/%s", address), height)
+}
+
+func (api *API) Nonce(ctx context.Context, address string, height pkgTypes.Level) (types.AbciQueryResponse, error) {
+	return api.abciQuery(ctx, fmt.Sprintf("accounts/nonce/%s", address), height)
+}
+
+func (api *API) BridgeAccountInfo(ctx context.Context, address string, height pkgTypes.Level) (types.AbciQueryResponse, error) {
+	return api.abciQuery(ctx, fmt.Sprintf("bridge/account_info/%s", address), height)
+}
+
+func (api *API) abciQuery(ctx context.Context, path string, height pkgTypes.Level) (types.AbciQueryResponse, error) {
+	args := make(map[string]string)
+	args["path"] = fmt.Sprintf(`"%s"`, path)
+	if height > 0 {
+		args["height"] = height.String()
+	}
+
+	var gbr types.Response[types.AbciQueryResponse]
+	if err := api.get(ctx, pathAbciQuery, args, &gbr); err != nil {
+		return gbr.Result, errors.Wrap(err, "api.get")
+	}
+
+	if gbr.Error != nil {
+		return gbr.Result, errors.Wrapf(types.ErrRequest, "request %d error: %s", gbr.Id, gbr.Error.Error())
+	}
+
+	if gbr.Result.Response.Code != 0 {
+		return gbr.Result, errors.Wrapf(types.ErrRequest, "abci query %s error: code=%d log=%s", path, gbr.Result.Response.Code, gbr.Result.Response.Log)
+	}
+
+	return gbr.Result, nil
+}
@@ -19,3 +19,6 @@ type DenomMetadata struct {
 type DenomMetadataResponse struct {
 	Response DenomMetadata `json:"response"`
 }
+
+// AbciQueryResponse - response of `abci_query` request
+type AbciQueryResponse = DenomMetadataResponse